@@ -27,6 +27,32 @@ import (
 type selectionAlgorithm interface {
 	// SelectBeacons selects the `n` best beacons from the provided slice of beacons.
 	SelectBeacons(ctx context.Context, beacons []Beacon, resultSize int) []Beacon
+	// ExplainBeacons selects the same beacons as SelectBeacons and annotates
+	// each selected beacon with the reason it was selected.
+	ExplainBeacons(ctx context.Context, beacons []Beacon, resultSize int) []Selection
+}
+
+// SelectionReason describes why a beacon was selected.
+type SelectionReason string
+
+const (
+	// SelectedCandidate indicates that the beacon was selected, because there
+	// were not more candidate beacons than the best set size.
+	SelectedCandidate SelectionReason = "candidate"
+	// SelectedShortest indicates that the beacon was selected, because it is
+	// among the shortest candidate beacons.
+	SelectedShortest SelectionReason = "shortest"
+	// SelectedDiverse indicates that the beacon was selected, because it is
+	// the most diverse candidate beacon compared to the shortest beacon.
+	SelectedDiverse SelectionReason = "diverse"
+)
+
+// Selection is a beacon that was selected by a policy, together with the
+// reason it was selected.
+type Selection struct {
+	Beacon Beacon
+	Policy PolicyType
+	Reason SelectionReason
 }
 
 // baseAlgo implements a very simple selection algorithm that optimizes for
@@ -38,23 +64,50 @@ type baseAlgo struct{}
 // beacons. The last beacon is either the most diverse beacon from the remaining
 // beacons, if the diversity exceeds what has already been served. Or the
 // shortest remaining beacon, otherwise.
-func (a baseAlgo) SelectBeacons(_ context.Context, beacons []Beacon, resultSize int) []Beacon {
+func (a baseAlgo) SelectBeacons(ctx context.Context, beacons []Beacon, resultSize int) []Beacon {
 	if len(beacons) <= resultSize {
 		return beacons
 	}
+	selections := a.ExplainBeacons(ctx, beacons, resultSize)
+	result := make([]Beacon, 0, len(selections))
+	for _, s := range selections {
+		result = append(result, s.Beacon)
+	}
+	return result
+}
 
-	result := make([]Beacon, resultSize-1, resultSize)
-	copy(result, beacons[:resultSize-1])
-	_, diversity := a.selectMostDiverse(result, result[0])
+// ExplainBeacons selects the same beacons as SelectBeacons. The k-1 shortest
+// beacons are selected as shortest. The last beacon is selected as diverse if
+// it is the most diverse beacon from the remaining beacons, and as shortest
+// otherwise.
+func (a baseAlgo) ExplainBeacons(
+	_ context.Context,
+	beacons []Beacon,
+	resultSize int,
+) []Selection {
+
+	if len(beacons) <= resultSize {
+		result := make([]Selection, 0, len(beacons))
+		for _, b := range beacons {
+			result = append(result, Selection{Beacon: b, Reason: SelectedCandidate})
+		}
+		return result
+	}
+
+	result := make([]Selection, 0, resultSize)
+	for _, b := range beacons[:resultSize-1] {
+		result = append(result, Selection{Beacon: b, Reason: SelectedShortest})
+	}
+	_, diversity := a.selectMostDiverse(beacons[:resultSize-1], beacons[0])
 
 	// Check if we find a more diverse beacon in the rest.
-	mostDiverseRest, diversityRest := a.selectMostDiverse(beacons[resultSize-1:], result[0])
+	mostDiverseRest, diversityRest := a.selectMostDiverse(beacons[resultSize-1:], beacons[0])
 	if diversityRest > diversity {
-		return append(result, mostDiverseRest)
+		return append(result, Selection{Beacon: mostDiverseRest, Reason: SelectedDiverse})
 	}
 	// If the most diverse beacon was already served, serve shortest from the
 	// rest.
-	return append(result, beacons[resultSize-1])
+	return append(result, Selection{Beacon: beacons[resultSize-1], Reason: SelectedShortest})
 }
 
 // selectMostDiverse selects the most diverse beacon compared to the provided best beacon from all
@@ -98,6 +151,20 @@ func (a chainsAvailableAlgo) SelectBeacons(
 	beacons []Beacon,
 	resultSize int,
 ) []Beacon {
+	return baseAlgo{}.SelectBeacons(ctx, a.withChain(ctx, beacons), resultSize)
+}
+
+func (a chainsAvailableAlgo) ExplainBeacons(
+	ctx context.Context,
+	beacons []Beacon,
+	resultSize int,
+) []Selection {
+	return baseAlgo{}.ExplainBeacons(ctx, a.withChain(ctx, beacons), resultSize)
+}
+
+// withChain returns the beacons for which all the required certificate chains
+// are available.
+func (a chainsAvailableAlgo) withChain(ctx context.Context, beacons []Beacon) []Beacon {
 	withChain := make([]Beacon, 0, len(beacons))
 	for _, b := range beacons {
 		err := segverifier.VerifySegment(ctx, a.verifier, nil, b.Segment)
@@ -115,5 +182,5 @@ func (a chainsAvailableAlgo) SelectBeacons(
 			a.logThrottled.Set(id, struct{}{}, cache.DefaultExpiration)
 		}
 	}
-	return baseAlgo{}.SelectBeacons(ctx, withChain, resultSize)
+	return withChain
 }
@@ -116,6 +116,25 @@ func (s *Store) getBeacons(ctx context.Context, policy *Policy) ([]Beacon, error
 	return s.algo.SelectBeacons(ctx, beacons, policy.BestSetSize), nil
 }
 
+// SelectedBeacons returns the beacons that are currently selected by the
+// propagation and registration policies, together with the reason each beacon
+// was selected.
+func (s *Store) SelectedBeacons(ctx context.Context) ([]Selection, error) {
+	var selections []Selection
+	for _, policy := range []*Policy{&s.policies.Prop, &s.policies.UpReg, &s.policies.DownReg} {
+		beacons, err := s.db.CandidateBeacons(ctx, policy.CandidateSetSize,
+			UsageFromPolicyType(policy.Type), 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, sel := range s.algo.ExplainBeacons(ctx, beacons, policy.BestSetSize) {
+			sel.Policy = policy.Type
+			selections = append(selections, sel)
+		}
+	}
+	return selections, nil
+}
+
 // MaxExpTime returns the segment maximum expiration time for the given policy.
 func (s *Store) MaxExpTime(policyType PolicyType) uint8 {
 	switch policyType {
@@ -129,6 +148,11 @@ func (s *Store) MaxExpTime(policyType PolicyType) uint8 {
 	return DefaultMaxExpTime
 }
 
+// Policies returns the policies that are currently in effect.
+func (s *Store) Policies() []Policy {
+	return []Policy{s.policies.Prop, s.policies.UpReg, s.policies.DownReg}
+}
+
 // CoreStore provides abstracted access to the beacon database in a core AS. The
 // store helps inserting beacons and revocations, and selects the best beacons
 // for given purposes based on the configured policies. It should not be used in
@@ -194,6 +218,31 @@ func (s *CoreStore) getBeacons(ctx context.Context, policy *Policy) ([]Beacon, e
 	return beacons, nil
 }
 
+// SelectedBeacons returns the beacons that are currently selected by the
+// propagation and registration policies, together with the reason each beacon
+// was selected. The beacons are selected per origin AS.
+func (s *CoreStore) SelectedBeacons(ctx context.Context) ([]Selection, error) {
+	srcs, err := s.db.BeaconSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var selections []Selection
+	for _, policy := range []*Policy{&s.policies.Prop, &s.policies.CoreReg} {
+		for _, src := range srcs {
+			beacons, err := s.db.CandidateBeacons(ctx, policy.CandidateSetSize,
+				UsageFromPolicyType(policy.Type), src)
+			if err != nil {
+				return nil, err
+			}
+			for _, sel := range s.algo.ExplainBeacons(ctx, beacons, policy.BestSetSize) {
+				sel.Policy = policy.Type
+				selections = append(selections, sel)
+			}
+		}
+	}
+	return selections, nil
+}
+
 // MaxExpTime returns the segment maximum expiration time for the given policy.
 func (s *CoreStore) MaxExpTime(policyType PolicyType) uint8 {
 	switch policyType {
@@ -205,6 +254,11 @@ func (s *CoreStore) MaxExpTime(policyType PolicyType) uint8 {
 	return DefaultMaxExpTime
 }
 
+// Policies returns the policies that are currently in effect.
+func (s *CoreStore) Policies() []Policy {
+	return []Policy{s.policies.Prop, s.policies.CoreReg}
+}
+
 // baseStore is the basis for the beacon store.
 type baseStore struct {
 	db     DB
@@ -275,3 +275,69 @@ func testSegment(g *graph.Graph, ifIDs []uint16) *seg.PathSegment {
 	pseg.ASEntries = pseg.ASEntries[:len(pseg.ASEntries)-1]
 	return pseg
 }
+
+func TestStorePolicies(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	db := mock_beacon.NewMockDB(mctrl)
+
+	store, err := beacon.NewBeaconStore(beacon.Policies{}, db)
+	require.NoError(t, err)
+	var types []beacon.PolicyType
+	for _, p := range store.Policies() {
+		types = append(types, p.Type)
+	}
+	require.Equal(t, []beacon.PolicyType{
+		beacon.PropPolicy, beacon.UpRegPolicy, beacon.DownRegPolicy,
+	}, types)
+
+	coreStore, err := beacon.NewCoreBeaconStore(beacon.CorePolicies{}, db)
+	require.NoError(t, err)
+	types = nil
+	for _, p := range coreStore.Policies() {
+		types = append(types, p.Type)
+	}
+	require.Equal(t, []beacon.PolicyType{beacon.PropPolicy, beacon.CoreRegPolicy}, types)
+}
+
+func TestStoreSelectedBeacons(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	g := graph.NewDefaultGraph(mctrl)
+
+	stub := graph.If_210_X_220_X
+	beacons := []beacon.Beacon{
+		testBeacon(g, graph.If_130_A_110_X, graph.If_110_X_210_X, stub),
+		// Share the last link between 110 and 210.
+		testBeacon(g, graph.If_130_B_120_A, graph.If_120_A_110_X, graph.If_110_X_210_X, stub),
+		// Share no link.
+		testBeacon(g, graph.If_130_B_120_A, graph.If_120_B_220_X, graph.If_220_X_210_X, stub),
+	}
+	db := mock_beacon.NewMockDB(mctrl)
+	policies := beacon.Policies{
+		Prop:    beacon.Policy{BestSetSize: 2},
+		UpReg:   beacon.Policy{BestSetSize: 2},
+		DownReg: beacon.Policy{BestSetSize: 3},
+	}
+	store, err := beacon.NewBeaconStore(policies, db)
+	require.NoError(t, err)
+	db.EXPECT().CandidateBeacons(
+		gomock.Any(), gomock.Any(), gomock.Any(), addr.IA(0),
+	).Return(beacons, nil).Times(3)
+
+	selections, err := store.SelectedBeacons(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []beacon.Selection{
+		{Beacon: beacons[0], Policy: beacon.PropPolicy, Reason: beacon.SelectedShortest},
+		{Beacon: beacons[2], Policy: beacon.PropPolicy, Reason: beacon.SelectedDiverse},
+		{Beacon: beacons[0], Policy: beacon.UpRegPolicy, Reason: beacon.SelectedShortest},
+		{Beacon: beacons[2], Policy: beacon.UpRegPolicy, Reason: beacon.SelectedDiverse},
+		{Beacon: beacons[0], Policy: beacon.DownRegPolicy, Reason: beacon.SelectedCandidate},
+		{Beacon: beacons[1], Policy: beacon.DownRegPolicy, Reason: beacon.SelectedCandidate},
+		{Beacon: beacons[2], Policy: beacon.DownRegPolicy, Reason: beacon.SelectedCandidate},
+	}, selections)
+
+	db.EXPECT().CandidateBeacons(
+		gomock.Any(), gomock.Any(), gomock.Any(), addr.IA(0),
+	).Return(nil, errors.New("FAIL"))
+	_, err = store.SelectedBeacons(context.Background())
+	require.Error(t, err)
+}
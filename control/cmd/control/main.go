@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 	promgrpc "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/spf13/cobra"
@@ -687,12 +688,10 @@ func realMain(ctx context.Context) error {
 	cleanup.Add(func() error { tcpServer.GracefulStop(); return nil })
 
 	if globalCfg.API.Addr != "" {
-		r := chi.NewRouter()
-		r.Use(cors.Handler(cors.Options{
-			AllowedOrigins: []string{"*"},
-		}))
-		r.Get("/", api.ServeSpecInteractive)
-		r.Get("/openapi.json", api.ServeSpecJSON)
+		cryptoAgileAlgorithms, err := globalCfg.API.SignatureAlgorithms()
+		if err != nil {
+			return serrors.Wrap("parsing crypto agile algorithms", err)
+		}
 		server := api.Server{
 			SegmentsServer: segapi.Server{
 				Segments: pathDB,
@@ -700,13 +699,51 @@ func realMain(ctx context.Context) error {
 			CPPKIServer: cppkiapi.Server{
 				TrustDB: trustDB,
 			},
-			Beacons:  beaconDB,
-			CA:       chainBuilder,
-			Config:   service.NewConfigStatusPage(globalCfg).Handler,
-			Info:     service.NewInfoStatusPage().Handler,
-			LogLevel: service.NewLogLevelStatusPage().Handler,
-			Signer:   signer,
-			Topology: topo.HandleHTTP,
+			Beacons: beaconDB,
+			BeaconPolicy: &beaconPolicyProvider{
+				Store:                beaconStore,
+				OriginationInterval:  globalCfg.BS.OriginationInterval.Duration,
+				PropagationInterval:  globalCfg.BS.PropagationInterval.Duration,
+				RegistrationInterval: globalCfg.BS.RegistrationInterval.Duration,
+			},
+			BeaconSelector: beaconStore,
+			CA:             chainBuilder,
+			Config:         service.NewConfigStatusPage(globalCfg).Handler,
+			Info:           service.NewInfoStatusPage().Handler,
+			LogLevel:       service.NewLogLevelStatusPage().Handler,
+			Signer:         signer,
+			Topology:       topo.HandleHTTP,
+			TrustDB:        trustDB,
+			Interfaces:     topo.InterfaceInfoMap,
+			Reload: func(context.Context) ([]string, error) {
+				return topo.Reload()
+			},
+			LogLevels: func() (string, []string) {
+				return log.ConsoleLevel.Level(), log.ConsoleLevel.Levels()
+			},
+			SecurityHeaders:         globalCfg.API.SecurityHeaders,
+			StrictTransportSecurity: globalCfg.API.StrictTransportSecurity,
+			Requests:                libmetrics.NewPromCounter(metrics.MgmtAPIRequestsTotal),
+			RequestDuration: libmetrics.NewPromHistogram(
+				metrics.MgmtAPIRequestDurationSeconds,
+			),
+			MaxConcurrentRequests:   globalCfg.API.MaxConcurrentRequests,
+			ConcurrencyQueueTimeout: globalCfg.API.ConcurrencyQueueTimeout.Duration,
+			QueryTimeout:            globalCfg.API.QueryTimeout.Duration,
+			CryptoAgileAlgorithms:   cryptoAgileAlgorithms,
+			SignerExpiryWarning:     globalCfg.API.SignerExpiryWarning.Duration,
+			HealthHistorySize:       globalCfg.API.HealthHistorySize,
+			MaxBeaconHops:           globalCfg.API.MaxBeaconHops,
+			MaxRequestBodySize:      globalCfg.API.MaxRequestBodySize,
+			MaxBeaconCount:          globalCfg.API.MaxBeaconCount,
+			CertificateExpiryWindow: globalCfg.API.CertificateExpiryWindow.Duration,
+			TopOrigins:              globalCfg.API.TopOrigins,
+			BeaconScoreWeights: api.BeaconScoreWeights{
+				Hops:      globalCfg.API.BeaconScoreWeights.Hops,
+				Validity:  globalCfg.API.BeaconScoreWeights.Validity,
+				Latency:   globalCfg.API.BeaconScoreWeights.Latency,
+				Bandwidth: globalCfg.API.BeaconScoreWeights.Bandwidth,
+			},
 			Healther: &healther{
 				Signer:   signer,
 				TrustDB:  trustDB,
@@ -714,6 +751,25 @@ func realMain(ctx context.Context) error {
 				CAHealth: caHealthCached,
 			},
 		}
+		r := chi.NewRouter()
+		r.Use(cors.Handler(cors.Options{
+			AllowedOrigins: []string{"*"},
+		}))
+		r.Use(server.AddSecurityHeaders)
+		r.Use(middleware.RequestID)
+		r.Use(server.InstrumentHandlers)
+		r.Use(api.RecoverPanic)
+		r.Use(server.LimitConcurrency)
+		r.Use(server.LimitRequestBody)
+		r.Use(api.DecompressRequestBody)
+		r.Use((&api.IdempotencyCache{}).Middleware)
+		cacheTTLs := make(map[string]time.Duration, len(globalCfg.API.CacheTTLs))
+		for path, ttl := range globalCfg.API.CacheTTLs {
+			cacheTTLs[path] = ttl.Duration
+		}
+		r.Use((&api.ResponseCache{TTLs: cacheTTLs}).Middleware)
+		r.Get("/", api.ServeSpecInteractive)
+		r.Get("/openapi.json", api.ServeSpecJSON)
 		log.Info("Exposing API", "addr", globalCfg.API.Addr)
 		s := http.Server{
 			Addr:    globalCfg.API.Addr,
@@ -914,9 +970,18 @@ func (h *healther) GetSignerHealth(ctx context.Context) api.SignerHealthData {
 			SignerMissingDetail: err.Error(),
 		}
 	}
+	validities := make([]cppki.Validity, 0, len(signers))
+	for _, s := range signers {
+		validities = append(validities, cppki.Validity{
+			NotBefore: s.ChainValidity.NotBefore,
+			NotAfter:  s.Expiration,
+		})
+	}
 	return api.SignerHealthData{
 		Expiration: signer.Expiration,
 		InGrace:    signer.InGrace,
+		Validities: validities,
+		Chain:      signer.Chain,
 	}
 }
 
@@ -945,6 +1010,22 @@ func (h *healther) GetCAHealth(ctx context.Context) (api.CAHealthStatus, bool) {
 	return api.Unavailable, false
 }
 
+type beaconPolicyProvider struct {
+	Store                cs.Store
+	OriginationInterval  time.Duration
+	PropagationInterval  time.Duration
+	RegistrationInterval time.Duration
+}
+
+func (p *beaconPolicyProvider) BeaconPolicy() api.BeaconPolicyData {
+	return api.BeaconPolicyData{
+		OriginationInterval:  p.OriginationInterval,
+		PropagationInterval:  p.PropagationInterval,
+		RegistrationInterval: p.RegistrationInterval,
+		Policies:             p.Store.Policies(),
+	}
+}
+
 func adaptTopology(topo *topology.Loader) snet.Topology {
 	start, end := topo.PortRange()
 	return snet.Topology{
@@ -0,0 +1,194 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/private/util"
+	"github.com/scionproto/scion/pkg/scrypto/signed"
+	"github.com/scionproto/scion/private/config"
+	api "github.com/scionproto/scion/private/mgmtapi"
+)
+
+var _ config.Config = (*APIConfig)(nil)
+
+// APIConfig is the configuration of the management API of the control service.
+// It extends the common management API configuration with the options that
+// only apply to the control service.
+type APIConfig struct {
+	api.Config
+	// SecurityHeaders enables the standard security headers on all responses.
+	SecurityHeaders bool `toml:"security_headers,omitempty"`
+	// StrictTransportSecurity is the value of the Strict-Transport-Security
+	// header that is set if security headers are enabled. If it is empty, the
+	// header is not set.
+	StrictTransportSecurity string `toml:"strict_transport_security,omitempty"`
+	// MaxConcurrentRequests is the maximum number of requests that are handled
+	// concurrently. If it is zero, the number of requests is not limited.
+	MaxConcurrentRequests int `toml:"max_concurrent_requests,omitempty"`
+	// ConcurrencyQueueTimeout is the duration a request waits for a slot if
+	// the maximum number of requests is in flight. If it is zero, such
+	// requests are rejected immediately.
+	ConcurrencyQueueTimeout util.DurWrap `toml:"concurrency_queue_timeout,omitempty"`
+	// CacheTTLs maps the paths of API operations, as they are listed in the
+	// spec, e.g., "/trcs", to the duration for which their responses are
+	// cached. Operations without a TTL are not cached.
+	CacheTTLs map[string]util.DurWrap `toml:"cache_ttls,omitempty"`
+	// QueryTimeout bounds the database queries of the beacon and segment
+	// endpoints. If it is zero, the queries are only bounded by the request.
+	QueryTimeout util.DurWrap `toml:"query_timeout,omitempty"`
+	// CryptoAgileAlgorithms is the allowlist of signature algorithms that are
+	// considered modern, e.g., "ECDSA-SHA384". If it is empty, beacons are not
+	// annotated with whether they are crypto agile.
+	CryptoAgileAlgorithms []string `toml:"crypto_agile_algorithms,omitempty"`
+	// SignerExpiryWarning is the remaining validity below which the signer
+	// health check is degraded. If it is zero, a default of 6h is used.
+	SignerExpiryWarning util.DurWrap `toml:"signer_expiry_warning,omitempty"`
+	// HealthHistorySize is the number of health check status transitions that
+	// are retained. If it is zero, a default of 256 is used.
+	HealthHistorySize int `toml:"health_history_size,omitempty"`
+	// MaxBeaconHops is the maximum number of AS entries of a listed beacon.
+	// If it is zero, a default of 64 is used.
+	MaxBeaconHops int `toml:"max_beacon_hops,omitempty"`
+	// MaxRequestBodySize is the maximum size of a request body in bytes. If it
+	// is zero, a default of 1MiB is used.
+	MaxRequestBodySize int64 `toml:"max_request_body_size,omitempty"`
+	// MaxBeaconCount is the number of stored beacons above which the beacon
+	// store health check is degraded. If it is zero, a default of 100000 is
+	// used.
+	MaxBeaconCount int `toml:"max_beacon_count,omitempty"`
+	// CertificateExpiryWindow is the remaining validity below which the AS
+	// certificates in the trust database are considered close to expiration.
+	// If it is zero, a default of 72h is used.
+	CertificateExpiryWindow util.DurWrap `toml:"certificate_expiry_window,omitempty"`
+	// TopOrigins is the number of origin ASes that are listed in the beacon
+	// statistics unless the client requests a different number. If it is
+	// zero, a default of 10 is used.
+	TopOrigins int `toml:"top_origins,omitempty"`
+	// BeaconScoreWeights are the weights of the quality score that is attached
+	// to beacons on request. If all weights are zero, every component is
+	// weighted equally.
+	BeaconScoreWeights BeaconScoreWeights `toml:"beacon_score_weights,omitempty"`
+}
+
+// BeaconScoreWeights are the weights of the components of the beacon quality
+// score.
+type BeaconScoreWeights struct {
+	Hops      float64 `toml:"hops,omitempty"`
+	Validity  float64 `toml:"validity,omitempty"`
+	Latency   float64 `toml:"latency,omitempty"`
+	Bandwidth float64 `toml:"bandwidth,omitempty"`
+}
+
+func (cfg *APIConfig) InitDefaults() {}
+
+func (cfg *APIConfig) Validate() error {
+	if cfg.MaxConcurrentRequests < 0 {
+		return serrors.New("max_concurrent_requests must not be negative",
+			"value", cfg.MaxConcurrentRequests)
+	}
+	if cfg.ConcurrencyQueueTimeout.Duration < 0 {
+		return serrors.New("concurrency_queue_timeout must not be negative",
+			"value", cfg.ConcurrencyQueueTimeout)
+	}
+	if cfg.QueryTimeout.Duration < 0 {
+		return serrors.New("query_timeout must not be negative",
+			"value", cfg.QueryTimeout)
+	}
+	if cfg.SignerExpiryWarning.Duration < 0 {
+		return serrors.New("signer_expiry_warning must not be negative",
+			"value", cfg.SignerExpiryWarning)
+	}
+	if cfg.HealthHistorySize < 0 {
+		return serrors.New("health_history_size must not be negative",
+			"value", cfg.HealthHistorySize)
+	}
+	if cfg.MaxBeaconHops < 0 {
+		return serrors.New("max_beacon_hops must not be negative",
+			"value", cfg.MaxBeaconHops)
+	}
+	if cfg.MaxRequestBodySize < 0 {
+		return serrors.New("max_request_body_size must not be negative",
+			"value", cfg.MaxRequestBodySize)
+	}
+	if cfg.MaxBeaconCount < 0 {
+		return serrors.New("max_beacon_count must not be negative",
+			"value", cfg.MaxBeaconCount)
+	}
+	if cfg.CertificateExpiryWindow.Duration < 0 {
+		return serrors.New("certificate_expiry_window must not be negative",
+			"value", cfg.CertificateExpiryWindow)
+	}
+	if cfg.TopOrigins < 0 {
+		return serrors.New("top_origins must not be negative", "value", cfg.TopOrigins)
+	}
+	if w := cfg.BeaconScoreWeights; w.Hops < 0 || w.Validity < 0 || w.Latency < 0 ||
+		w.Bandwidth < 0 {
+		return serrors.New("beacon_score_weights must not be negative",
+			"hops", w.Hops, "validity", w.Validity, "latency", w.Latency,
+			"bandwidth", w.Bandwidth)
+	}
+	for path, ttl := range cfg.CacheTTLs {
+		if !strings.HasPrefix(path, "/") {
+			return serrors.New("cache_ttls path must start with /", "path", path)
+		}
+		if ttl.Duration < 0 {
+			return serrors.New("cache_ttls TTL must not be negative",
+				"path", path, "ttl", ttl)
+		}
+	}
+	if _, err := cfg.SignatureAlgorithms(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SignatureAlgorithms parses the names of the crypto agile signature
+// algorithms. The names are compared case-insensitively.
+func (cfg *APIConfig) SignatureAlgorithms() ([]signed.SignatureAlgorithm, error) {
+	var algos []signed.SignatureAlgorithm
+	for _, name := range cfg.CryptoAgileAlgorithms {
+		i := slices.IndexFunc(signatureAlgorithms, func(algo signed.SignatureAlgorithm) bool {
+			return strings.EqualFold(algo.String(), name)
+		})
+		if i < 0 {
+			return nil, serrors.New("unknown signature algorithm in crypto_agile_algorithms",
+				"name", name)
+		}
+		algos = append(algos, signatureAlgorithms[i])
+	}
+	return algos, nil
+}
+
+// signatureAlgorithms are the signature algorithms that can be configured as
+// crypto agile.
+var signatureAlgorithms = []signed.SignatureAlgorithm{
+	signed.ECDSAWithSHA256,
+	signed.ECDSAWithSHA384,
+	signed.ECDSAWithSHA512,
+}
+
+func (cfg *APIConfig) Sample(dst io.Writer, path config.Path, ctx config.CtxMap) {
+	cfg.Config.Sample(dst, path, ctx)
+	config.WriteString(dst, apiSample)
+}
+
+func (cfg *APIConfig) ConfigName() string {
+	return "api"
+}
@@ -25,7 +25,6 @@ import (
 	"github.com/scionproto/scion/pkg/private/util"
 	"github.com/scionproto/scion/private/config"
 	"github.com/scionproto/scion/private/env"
-	api "github.com/scionproto/scion/private/mgmtapi"
 	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
 	"github.com/scionproto/scion/private/storage"
 	trustengine "github.com/scionproto/scion/private/trust/config"
@@ -54,7 +53,7 @@ type Config struct {
 	Features    env.Features       `toml:"features,omitempty"`
 	Logging     log.Config         `toml:"log,omitempty"`
 	Metrics     env.Metrics        `toml:"metrics,omitempty"`
-	API         api.Config         `toml:"api,omitempty"`
+	API         APIConfig          `toml:"api,omitempty"`
 	Tracing     env.Tracing        `toml:"tracing,omitempty"`
 	BeaconDB    storage.DBConfig   `toml:"beacon_db,omitempty"`
 	TrustDB     storage.DBConfig   `toml:"trust_db,omitempty"`
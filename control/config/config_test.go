@@ -17,11 +17,14 @@ package config
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/scionproto/scion/pkg/log/logtest"
+	"github.com/scionproto/scion/pkg/scrypto/signed"
 	"github.com/scionproto/scion/private/env/envtest"
 	"github.com/scionproto/scion/private/mgmtapi/jwtauth"
 	apitest "github.com/scionproto/scion/private/mgmtapi/mgmtapitest"
@@ -39,8 +42,20 @@ func TestConfigSample(t *testing.T) {
 	CheckTestConfig(t, &cfg, idSample)
 }
 
+func TestAPIConfigSignatureAlgorithms(t *testing.T) {
+	cfg := APIConfig{CryptoAgileAlgorithms: []string{"ecdsa-sha384", "ECDSA-SHA512"}}
+	require.NoError(t, cfg.Validate())
+	algos, err := cfg.SignatureAlgorithms()
+	require.NoError(t, err)
+	assert.Equal(t, []signed.SignatureAlgorithm{signed.ECDSAWithSHA384, signed.ECDSAWithSHA512},
+		algos)
+
+	cfg.CryptoAgileAlgorithms = append(cfg.CryptoAgileAlgorithms, "RSA")
+	assert.Error(t, cfg.Validate())
+}
+
 func InitTestConfig(cfg *Config) {
-	apitest.InitConfig(&cfg.API)
+	InitTestAPIConfig(&cfg.API)
 	envtest.InitTest(&cfg.General, &cfg.Metrics, &cfg.Tracing, nil)
 	logtest.InitTestLogging(&cfg.Logging)
 	InitTestBSConfig(&cfg.BS)
@@ -48,6 +63,43 @@ func InitTestConfig(cfg *Config) {
 	InitTestCA(&cfg.CA)
 }
 
+func InitTestAPIConfig(cfg *APIConfig) {
+	apitest.InitConfig(&cfg.Config)
+	cfg.SecurityHeaders = true
+	cfg.StrictTransportSecurity = "garbage"
+	cfg.MaxConcurrentRequests = 42
+	cfg.ConcurrencyQueueTimeout.Duration = time.Hour
+	cfg.QueryTimeout.Duration = time.Hour
+	cfg.CryptoAgileAlgorithms = []string{"garbage"}
+	cfg.SignerExpiryWarning.Duration = time.Hour
+	cfg.HealthHistorySize = 42
+	cfg.MaxBeaconHops = 42
+	cfg.MaxRequestBodySize = 42
+	cfg.MaxBeaconCount = 42
+	cfg.CertificateExpiryWindow.Duration = time.Hour
+	cfg.TopOrigins = 42
+	cfg.BeaconScoreWeights = BeaconScoreWeights{Hops: 42, Validity: 42, Latency: 42, Bandwidth: 42}
+}
+
+func CheckTestAPIConfig(t *testing.T, cfg *APIConfig) {
+	apitest.CheckConfig(t, &cfg.Config)
+	assert.False(t, cfg.SecurityHeaders)
+	assert.Empty(t, cfg.StrictTransportSecurity)
+	assert.Zero(t, cfg.MaxConcurrentRequests)
+	assert.Zero(t, cfg.ConcurrencyQueueTimeout.Duration)
+	assert.Empty(t, cfg.CacheTTLs)
+	assert.Zero(t, cfg.QueryTimeout.Duration)
+	assert.Empty(t, cfg.CryptoAgileAlgorithms)
+	assert.Zero(t, cfg.SignerExpiryWarning.Duration)
+	assert.Zero(t, cfg.HealthHistorySize)
+	assert.Zero(t, cfg.MaxBeaconHops)
+	assert.Zero(t, cfg.MaxRequestBodySize)
+	assert.Zero(t, cfg.MaxBeaconCount)
+	assert.Zero(t, cfg.CertificateExpiryWindow.Duration)
+	assert.Zero(t, cfg.TopOrigins)
+	assert.Zero(t, cfg.BeaconScoreWeights)
+}
+
 func InitTestBSConfig(cfg *BSConfig) {
 	InitTestPolicies(&cfg.Policies)
 }
@@ -60,7 +112,7 @@ func InitTestPolicies(cfg *Policies) {
 }
 
 func CheckTestConfig(t *testing.T, cfg *Config, id string) {
-	apitest.CheckConfig(t, &cfg.API)
+	CheckTestAPIConfig(t, &cfg.API)
 	envtest.CheckTest(t, &cfg.General, &cfg.Metrics, &cfg.Tracing, nil, id)
 	logtest.CheckTestLogging(t, &cfg.Logging, id)
 	storagetest.CheckTestTrustDBConfig(t, &cfg.TrustDB, id)
@@ -16,6 +16,71 @@ package config
 
 const idSample = "cs-1"
 
+const apiSample = `
+# Whether to set the standard security headers, e.g., X-Content-Type-Options,
+# on all responses of the API. (default false)
+security_headers = false
+# The value of the Strict-Transport-Security header, e.g., "max-age=31536000".
+# It is only set if the security headers are enabled. Only set it if the API
+# is served behind a TLS terminating proxy. If it is empty, the header is not
+# set. (default "")
+strict_transport_security = ""
+# The maximum number of requests that are handled concurrently. Requests beyond
+# the limit are rejected with status 503. Health, readiness and liveness probes
+# are not limited. If it is 0, the number of requests is not limited.
+# (default 0)
+max_concurrent_requests = 0
+# The duration a request waits for one of the concurrently handled requests to
+# finish if the limit is reached. If it is 0, such requests are rejected
+# immediately. (default "0s")
+concurrency_queue_timeout = "0s"
+# The duration for which the responses of read operations of the API are
+# cached, keyed by the path of the operation relative to the base URL of the
+# API, as it is listed in the spec, e.g., { "/ca" = "10s", "/trcs" = "10s" }.
+# Operations without a TTL are not cached. (default {})
+cache_ttls = {}
+# The timeout of the database queries of the beacon and segment endpoints. A
+# query that times out is answered with status 503. If it is 0, the queries are
+# only bounded by the request. (default "0s")
+query_timeout = "0s"
+# The signature algorithms that are considered modern, e.g.,
+# ["ECDSA-SHA384", "ECDSA-SHA512"]. Beacons whose AS entries are all signed
+# with one of them are marked as crypto agile. If it is empty, beacons are not
+# annotated. (default [])
+crypto_agile_algorithms = []
+# The remaining validity of the AS certificate of the signer below which the
+# signer health check is degraded. It should be tuned to the lifetime of the AS
+# certificates. If it is 0, a default of 6h is used. (default "0s")
+signer_expiry_warning = "0s"
+# The number of status transitions of the health checks that are retained and
+# listed by /health/history. If it is 0, a default of 256 is used. (default 0)
+health_history_size = 0
+# The maximum number of AS entries of a beacon that is listed by /beacons.
+# Larger beacons are omitted and reported as malformed. If it is 0, a default
+# of 64 is used. (default 0)
+max_beacon_hops = 0
+# The maximum size of a request body in bytes. Larger requests are rejected with
+# status 413. The limit also applies to the decompressed body of compressed
+# requests. If it is 0, a default of 1MiB is used. (default 0)
+max_request_body_size = 0
+# The number of stored beacons above which the beacon store health check is
+# degraded. A steadily growing beacon store indicates that expired beacons are
+# not cleaned up. If it is 0, a default of 100000 is used. (default 0)
+max_beacon_count = 0
+# The remaining validity below which the AS certificates in the trust database
+# are considered close to expiration, and the certificate expiry health check
+# is degraded. If it is 0, a default of 72h is used. (default "0s")
+certificate_expiry_window = "0s"
+# The number of origin ASes with the most beacons that are listed by
+# /beacons/stats, unless the client requests a different number with the top
+# parameter. If it is 0, a default of 10 is used. (default 0)
+top_origins = 0
+# The weights of the components of the beacon quality score that is attached to
+# beacons by /beacons on request. A component with weight 0 does not count. If
+# all weights are 0, every component is weighted equally. (default all 0)
+beacon_score_weights = { hops = 0.0, validity = 0.0, latency = 0.0, bandwidth = 0.0 }
+`
+
 const psSample = `
 # The time after which segments for a destination are refetched. (default 5m)
 query_interval = "5m"
@@ -16,13 +16,27 @@ package mgmtapi
 
 import (
 	"bytes"
+	"cmp"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
@@ -30,22 +44,55 @@ import (
 	"github.com/scionproto/scion/control/beacon"
 	cstrust "github.com/scionproto/scion/control/trust"
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/metrics"
 	"github.com/scionproto/scion/pkg/private/serrors"
+	"github.com/scionproto/scion/pkg/scrypto"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	"github.com/scionproto/scion/pkg/scrypto/signed"
 	seg "github.com/scionproto/scion/pkg/segment"
+	"github.com/scionproto/scion/pkg/segment/extensions/staticinfo"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/pkg/slayers/path"
 	"github.com/scionproto/scion/private/ca/renewal"
+	"github.com/scionproto/scion/private/env"
 	api "github.com/scionproto/scion/private/mgmtapi"
 	cppkiapi "github.com/scionproto/scion/private/mgmtapi/cppki/api"
 	healthapi "github.com/scionproto/scion/private/mgmtapi/health/api"
 	segapi "github.com/scionproto/scion/private/mgmtapi/segments/api"
+	"github.com/scionproto/scion/private/pathdb/query"
 	"github.com/scionproto/scion/private/storage"
 	beaconstorage "github.com/scionproto/scion/private/storage/beacon"
+	truststorage "github.com/scionproto/scion/private/storage/trust"
+	"github.com/scionproto/scion/private/topology"
 	"github.com/scionproto/scion/private/trust"
 )
 
 type BeaconStore interface {
 	GetBeacons(context.Context, *beaconstorage.QueryParams) ([]beaconstorage.Beacon, error)
 	DeleteBeacon(ctx context.Context, idPrefix string) error
+	DeleteBeacons(ctx context.Context, params *beaconstorage.QueryParams) (int, error)
+	Stats(ctx context.Context, params *beaconstorage.QueryParams) (beaconstorage.Stats, error)
+	CountBeacons(ctx context.Context) (int, error)
+}
+
+// BeaconPolicyProvider provides the beaconing policy that is currently in effect.
+type BeaconPolicyProvider interface {
+	BeaconPolicy() BeaconPolicyData
+}
+
+// BeaconPolicyData is used to extract the active beaconing policy.
+type BeaconPolicyData struct {
+	OriginationInterval  time.Duration
+	PropagationInterval  time.Duration
+	RegistrationInterval time.Duration
+	Policies             []beacon.Policy
+}
+
+// BeaconSelector previews the selection of beacons by the beaconing policies.
+type BeaconSelector interface {
+	// SelectedBeacons returns the beacons that are currently selected by the
+	// policies, together with the reason each beacon was selected.
+	SelectedBeacons(ctx context.Context) ([]beacon.Selection, error)
 }
 
 type Healther interface {
@@ -60,6 +107,12 @@ type SignerHealthData struct {
 	SignerMissingDetail string
 	Expiration          time.Time
 	InGrace             bool
+	// Validities contains the validity periods of all generated signers. It
+	// is used to detect intervals that are not covered by any signer.
+	Validities []cppki.Validity
+	// Chain is the certificate chain of the active signer. If it is set, the
+	// chain is verified against the active TRCs in the trust database.
+	Chain []*x509.Certificate
 }
 
 // TRCHealthData is used to extract the relevant TRC data for the TRC health check.
@@ -83,6 +136,8 @@ type Server struct {
 	SegmentsServer segapi.Server
 	CPPKIServer    cppkiapi.Server
 	Beacons        BeaconStore
+	BeaconPolicy   BeaconPolicyProvider
+	BeaconSelector BeaconSelector
 	CA             renewal.ChainBuilder
 	Config         http.HandlerFunc
 	Info           http.HandlerFunc
@@ -91,9 +146,92 @@ type Server struct {
 	Topology       http.HandlerFunc
 	TrustDB        storage.TrustDB
 	Healther       Healther
+	// Reload reloads the hot-reloadable configuration and describes the
+	// applied changes. If it is nil, reloading is not supported.
+	Reload func(context.Context) ([]string, error)
+	// LogLevels returns the current logging level and the levels that can be
+	// set. If it is nil, the logging levels are not reported.
+	LogLevels func() (string, []string)
+	// HealthHistorySize is the number of health check status transitions that
+	// are retained. If it is not positive, a default size is used.
+	HealthHistorySize int
+	// CryptoAgileAlgorithms is the allowlist of signature algorithms that are
+	// considered modern. If it is empty, beacons are not annotated with
+	// whether they are crypto agile.
+	CryptoAgileAlgorithms []signed.SignatureAlgorithm
+	// Interfaces returns the interfaces of the local AS. It is used to describe
+	// the link through which a beacon was received. If it is nil, beacons are
+	// not annotated with the link.
+	Interfaces func() map[iface.ID]topology.IFInfo
+	// MaxBeaconHops is the maximum number of AS entries of a beacon in a
+	// beacon listing. Larger beacons are omitted and reported as malformed.
+	// If it is not positive, a default maximum is used.
+	MaxBeaconHops int
+	// TopOrigins is the number of origin ASes that are listed in the beacon
+	// statistics unless the client requests a different number. If it is not
+	// positive, a default number is used.
+	TopOrigins int
+	// BeaconScoreWeights are the weights of the quality score that is attached
+	// to beacons on request. If all weights are zero, default weights are
+	// used.
+	BeaconScoreWeights BeaconScoreWeights
+	// MaxRequestBodySize is the maximum size of a request body in bytes. Larger
+	// requests are rejected with status 413. If it is not positive, a default
+	// limit is used.
+	MaxRequestBodySize int64
+	// MaxConcurrentRequests is the maximum number of requests that are handled
+	// concurrently. See LimitConcurrency. If it is not positive, the number of
+	// concurrent requests is not limited.
+	MaxConcurrentRequests int
+	// ConcurrencyQueueTimeout is the duration a request waits for one of the
+	// concurrently handled requests to finish if the limit is reached. If it is
+	// not positive, such requests are rejected immediately.
+	ConcurrencyQueueTimeout time.Duration
+	// MaxBeaconCount is the number of stored beacons above which the beacon
+	// store health check is degraded. A steadily growing beacon store
+	// indicates that expired beacons are not cleaned up. If it is not
+	// positive, a default threshold is used.
+	MaxBeaconCount int
+	// CertificateExpiryWindow is the remaining validity below which the AS
+	// certificates in the trust database are considered close to expiration.
+	// If it is not positive, a default window is used.
+	CertificateExpiryWindow time.Duration
+	// SignerExpiryWarning is the remaining validity below which the signer is
+	// considered close to expiration, and the signer health check is
+	// degraded. It should be tuned to the lifetime of the AS certificates. If
+	// it is zero, a default of 6h is used.
+	SignerExpiryWarning time.Duration
+	// QueryTimeout bounds the database queries of the beacon and segment
+	// handlers. A query that exceeds it is answered with status 503. If it is
+	// zero, the queries are only bounded by the request.
+	QueryTimeout time.Duration
+	// SecurityHeaders enables the standard security headers on all responses.
+	// See AddSecurityHeaders.
+	SecurityHeaders bool
+	// StrictTransportSecurity is the value of the Strict-Transport-Security
+	// header that is set if security headers are enabled, e.g.,
+	// "max-age=31536000". If it is empty, the header is not set.
+	StrictTransportSecurity string
+	// Requests counts the handled requests per handler and status class. If
+	// it is not initialized, nothing is reported. See InstrumentHandlers.
+	Requests metrics.Counter
+	// RequestDuration observes the time in seconds it takes to handle a
+	// request, per handler and status class. If it is not initialized,
+	// nothing is reported.
+	RequestDuration metrics.Histogram
 
 	// nowProvider can be set during tests to control the current time.
 	nowProvider func() time.Time
+	// healthPollInterval can be set during tests to control how often the
+	// health checks are re-evaluated while waiting for a change.
+	healthPollInterval time.Duration
+	// healthHistory records the status transitions of the health checks.
+	healthHistory healthHistory
+	// beaconCount caches the number of stored beacons for the health checks.
+	beaconCount healthCache[int]
+	// chains caches the currently valid certificate chains for the health
+	// checks.
+	chains healthCache[[][]*x509.Certificate]
 }
 
 // UnpackBeaconUsages extracts the Usage's bits as snake case string constants for the API.
@@ -116,62 +254,8 @@ func UnpackBeaconUsages(u beacon.Usage) []string {
 
 // GetBeacons gets the stored in the BeaconDB.
 func (s *Server) GetBeacons(w http.ResponseWriter, r *http.Request, params GetBeaconsParams) {
-	q := beaconstorage.QueryParams{}
-	var errs serrors.List
-	if params.StartIsdAs != nil {
-		if ia, err := addr.ParseIA(*params.StartIsdAs); err == nil {
-			q.StartsAt = []addr.IA{ia}
-		} else {
-			errs = append(errs, serrors.Wrap("parsing start_isd_as", err))
-		}
-	}
-	if params.Usages != nil {
-		var usage beacon.Usage
-		for _, usageFlag := range *params.Usages {
-			switch usageFlag {
-			case CoreRegistration:
-				usage |= beacon.UsageCoreReg
-			case DownRegistration:
-				usage |= beacon.UsageDownReg
-			case Propagation:
-				usage |= beacon.UsageProp
-			case UpRegistration:
-				usage |= beacon.UsageUpReg
-			default:
-				errs = append(errs, serrors.New(
-					"unknown value for parameter",
-					"usage",
-					usageFlag,
-				))
-			}
-		}
-		q.Usages = []beacon.Usage{usage}
-	}
-
-	if params.IngressInterface != nil {
-		if *params.IngressInterface < 0 || *params.IngressInterface > 65535 {
-			errs = append(errs, serrors.New(
-				"value for parameter out of range",
-				"ingress_interface",
-				*params.IngressInterface,
-			))
-		}
-		q.IngressInterfaces = []uint16{uint16(*params.IngressInterface)}
-	}
-	switch {
-	case (params.All != nil) && *params.All:
-		q.ValidAt = time.Time{}
-	case params.ValidAt != nil:
-		q.ValidAt = *params.ValidAt
-	default:
-		q.ValidAt = time.Now()
-	}
-	sortFn, err := sortFactory(params.Sort)
+	bq, err := s.parseBeaconQuery(params)
 	if err != nil {
-		errs = append(errs, err)
-	}
-
-	if err := errs.ToError(); err != nil {
 		ErrorResponse(w, Problem{
 			Detail: api.StringRef(err.Error()),
 			Status: http.StatusBadRequest,
@@ -180,199 +264,200 @@ func (s *Server) GetBeacons(w http.ResponseWriter, r *http.Request, params GetBe
 		})
 		return
 	}
-	results, err := s.Beacons.GetBeacons(r.Context(), &q)
+	if params.Explain != nil && *params.Explain {
+		explainBeaconQuery(w, bq, params)
+		return
+	}
+	q, startPrefix, signedWith := bq.query, bq.startPrefix, bq.signedWith
+	expiredOnly := bq.expiredOnly
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	results, err := s.Beacons.GetBeacons(ctx, &q)
 	if err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "error getting beacons",
-			Type:   api.StringRef(api.InternalError),
-		})
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
 		return
 	}
 
+	loopsOnly := params.LoopsOnly != nil && *params.LoopsOnly
+	now := s.now()
+	if !bq.snapshot.IsZero() {
+		now = bq.snapshot
+	}
+	var interfaces map[iface.ID]topology.IFInfo
+	if s.Interfaces != nil {
+		interfaces = s.Interfaces()
+	}
+	var names map[Hop]string
+	if params.Names != nil && *params.Names {
+		names = interfaceNames(interfaces)
+	}
+	agileAlgos := s.CryptoAgileAlgorithms
+	scoreWeights := s.BeaconScoreWeights
+	maxHops := s.MaxBeaconHops
+	if maxHops <= 0 {
+		maxHops = defaultMaxBeaconHops
+	}
+	// The beacons are collected before they are written, because they are
+	// deduplicated, sorted and paginated as a whole.
 	rep := make([]*Beacon, 0, len(results))
+	warnings := bq.warnings
+	// The segments are only kept if they are needed to render GeoJSON.
+	var segments map[string]*seg.PathSegment
+	geoJSON := api.AcceptsMediaType(r, GeoJSONContentType)
+	if geoJSON {
+		segments = make(map[string]*seg.PathSegment, len(results))
+	}
 	for _, result := range results {
 		s := result.Beacon.Segment
+		if expiredOnly && !s.MinExpiry().Before(now) {
+			continue
+		}
+		if startPrefix != "" && !strings.HasPrefix(s.FirstIA().String(), startPrefix) {
+			continue
+		}
+		var algos *[]string
+		if signedWith != "" {
+			segAlgos := signatureAlgorithms(s)
+			if !slices.Contains(segAlgos, signedWith) {
+				continue
+			}
+			algos = &segAlgos
+		}
+		if len(s.ASEntries) > maxHops {
+			warnings = append(warnings, fmt.Sprintf(
+				"beacon %s is malformed: %d AS entries exceed the maximum of %d",
+				segapi.SegID(s), len(s.ASEntries), maxHops,
+			))
+			continue
+		}
 		var usage BeaconUsages
 		for _, name := range UnpackBeaconUsages(result.Usage) {
 			usage = append(usage, BeaconUsage(name))
 		}
-		var hops []Hop
-		for i, as := range s.ASEntries {
-			if i != 0 {
-				hops = append(hops, Hop{
-					Interface: int(as.HopEntry.HopField.ConsIngress),
-					IsdAs:     as.Local.String(),
-				})
+		// A beacon that visits an AS more than once contains a loop.
+		visited := make(map[addr.IA]struct{}, len(s.ASEntries))
+		loop := false
+		for _, as := range s.ASEntries {
+			if _, ok := visited[as.Local]; ok {
+				loop = true
 			}
-			hops = append(hops, Hop{
-				Interface: int(as.HopEntry.HopField.ConsEgress),
-				IsdAs:     as.Local.String(),
-			})
+			visited[as.Local] = struct{}{}
 		}
-		rep = append(rep, &Beacon{
-			Usages:           usage,
-			IngressInterface: int(result.Beacon.InIfID),
-			Id:               segapi.SegID(s),
-			LastUpdated:      result.LastUpdated,
-			Timestamp:        s.Info.Timestamp.UTC(),
-			Expiration:       s.MinExpiry().UTC(),
-			Hops:             hops,
-		})
-	}
-	// Sort the results.
-	sorter := sortFn(rep)
-	if params.Desc != nil && *params.Desc {
-		sorter = sort.Reverse(sorter)
-	}
-	sort.Sort(sorter)
-	enc := json.NewEncoder(w)
-	enc.SetIndent("", "    ")
-	if err := enc.Encode(map[string][]*Beacon{"beacons": rep}); err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "unable to marshal response",
-			Type:   api.StringRef(api.InternalError),
-		})
-		return
-	}
-}
-
-type sortWrapper struct {
-	beacons []*Beacon
-	less    func(a, b *Beacon) bool
-}
-
-func (w sortWrapper) Len() int           { return len(w.beacons) }
-func (w sortWrapper) Less(i, j int) bool { return w.less(w.beacons[i], w.beacons[j]) }
-func (w sortWrapper) Swap(i, j int)      { w.beacons[i], w.beacons[j] = w.beacons[j], w.beacons[i] }
-
-// sortFactory returns a function that wraps a list of beacons in a sortWrapper.
-// The returned sortWrapper implements the sort.Interface with a less function that depends on
-// the provided sortParam.
-func sortFactory(sortParam *GetBeaconsParamsSort) (func(b []*Beacon) sort.Interface, error) {
-	by := "last_updated"
-	if sortParam != nil {
-		by = string(*sortParam)
-	}
-	var less func(a, b *Beacon) bool
-	switch by {
-	case "expiration_time":
-		less = func(a, b *Beacon) bool {
-			return a.Expiration.Before(b.Expiration)
+		if loopsOnly && !loop {
+			continue
 		}
-	case "info_time":
-		less = func(a, b *Beacon) bool {
-			return a.Timestamp.Before(b.Timestamp)
+		hops := beaconHops(s, false)
+		for i, hop := range hops {
+			if name, ok := names[Hop{Interface: hop.Interface, IsdAs: hop.IsdAs}]; ok {
+				hops[i].Name = &name
+			}
 		}
-	case "start_isd_as":
-		less = func(a, b *Beacon) bool {
-			if len(a.Hops) == 0 || len(b.Hops) == 0 {
-				return len(a.Hops) < len(b.Hops)
+		var mtu *int
+		if bq.minMTU != nil {
+			segMTU := pathMTU(s)
+			if segMTU < *bq.minMTU {
+				continue
 			}
-			return a.Hops[0].IsdAs < b.Hops[0].IsdAs
+			mtu = &segMTU
 		}
-	case "last_updated":
-		less = func(a, b *Beacon) bool {
-			return a.LastUpdated.Before(b.LastUpdated)
+		b := &Beacon{
+			Usages:              usage,
+			IngressInterface:    int(result.Beacon.InIfID),
+			Id:                  segapi.SegID(s),
+			LastUpdated:         result.LastUpdated,
+			Timestamp:           s.Info.Timestamp.UTC(),
+			Expiration:          s.MinExpiry().UTC(),
+			Hops:                hops,
+			SignatureAlgorithms: algos,
+			RegisteredVia:       registeredVia(interfaces, result.Beacon.InIfID),
+			Mtu:                 mtu,
 		}
-	case "ingress_interface":
-		less = func(a, b *Beacon) bool {
-			return a.IngressInterface < b.IngressInterface
+		if warnings := segapi.ParseWarnings(s); len(warnings) != 0 {
+			b.ParseWarnings = &warnings
 		}
-	default:
-		return nil, serrors.New("unknown query parameter", "sort", by)
-	}
-	return func(b []*Beacon) sort.Interface {
-		return sortWrapper{
-			beacons: b,
-			less:    less,
+		if len(agileAlgos) != 0 {
+			agile := cryptoAgile(s, agileAlgos)
+			b.CryptoAgile = &agile
+		}
+		if bq.expandClass {
+			if class := beaconClass(result.Usage, s); class != "" {
+				b.Class = &class
+			}
+		}
+		if params.Score != nil && *params.Score {
+			score := beaconScore(s, now, scoreWeights)
+			b.QualityScore = &score
+		}
+		rep = append(rep, b)
+		if geoJSON {
+			segments[b.Id] = s
 		}
-	}, nil
-}
-func (s *Server) GetBeacon(w http.ResponseWriter, r *http.Request, segmentId SegmentID) {
-	id, err := hex.DecodeString(segmentId)
-	if err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusBadRequest,
-			Title:  "error decoding segment id",
-			Type:   api.StringRef(api.BadRequest),
-		})
-		return
 	}
-	q := beaconstorage.QueryParams{
-		SegIDs: [][]byte{id},
+	if bq.dedupeHops {
+		rep = dedupeByHops(rep)
 	}
-	results, err := s.Beacons.GetBeacons(r.Context(), &q)
-	if err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "error getting beacons",
-			Type:   api.StringRef(api.InternalError),
-		})
-		return
+	positions := bq.order.sort(rep)
+	// The page is cut from the sorted beacons. A cursor continues after the
+	// position of the last beacon of the previous page, such that beacons that
+	// are added, refreshed or removed in between do not shift the page.
+	var totalCount *int
+	var nextCursor *string
+	if bq.paginated {
+		total := len(rep)
+		start := min(bq.offset, total)
+		if bq.cursor != nil {
+			start = sort.Search(total, func(i int) bool {
+				return bq.order.compare(positions[i], bq.cursor.beaconPosition) > 0
+			})
+		}
+		end := total
+		if bq.limit > 0 {
+			end = min(start+bq.limit, total)
+		}
+		if end < total {
+			next := beaconCursor{
+				At:             now.UTC(),
+				Sort:           bq.order.by,
+				Desc:           bq.order.reverse,
+				beaconPosition: positions[end-1],
+			}
+			token := next.token()
+			nextCursor = &token
+		}
+		rep = rep[start:end]
+		totalCount = &total
 	}
-	if len(results) == 0 {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(fmt.Sprintf(
-				"no beacon matched provided segment ID: %s",
-				segmentId,
-			)),
-			Status: http.StatusBadRequest,
-			Title:  "malformed query parameter",
-			Type:   api.StringRef(api.BadRequest),
-		})
+	if geoJSON {
+		writeGeoJSON(w, rep, segments)
 		return
 	}
-	if len(results) > 1 {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(fmt.Sprintf(
-				"%d beacons matched provided segment ID: %s",
-				len(results),
-				segmentId,
-			)),
-			Status: http.StatusBadRequest,
-			Title:  "malformed query parameter",
-			Type:   api.StringRef(api.BadRequest),
-		})
+	if api.AcceptsCBOR(r) {
+		writeCBOR(w, struct {
+			Beacons    []*Beacon `json:"beacons"`
+			TotalCount *int      `json:"total_count,omitempty"`
+			NextCursor *string   `json:"next_cursor,omitempty"`
+			Warnings   []string  `json:"warnings,omitempty"`
+		}{Beacons: rep, TotalCount: totalCount, NextCursor: nextCursor, Warnings: warnings})
 		return
 	}
-	seg := results[0].Beacon.Segment
-	var usage BeaconUsages
-	for _, name := range UnpackBeaconUsages(results[0].Usage) {
-		usage = append(usage, BeaconUsage(name))
-	}
-	var hops []Hop
-	for i, as := range seg.ASEntries {
-		if i != 0 {
-			hops = append(hops, Hop{
-				Interface: int(as.HopEntry.HopField.ConsIngress),
-				IsdAs:     as.Local.String(),
+	if api.AcceptsMediaType(r, NDJSONContentType) {
+		if totalCount != nil {
+			w.Header().Set("X-Total-Count", strconv.Itoa(*totalCount))
+		}
+		w.Header().Set("Content-Type", NDJSONContentType)
+		written, err := writeBeaconsNDJSON(w, rep, totalCount, nextCursor, warnings)
+		if err != nil && !written {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "unable to marshal response",
+				Type:   api.StringRef(api.InternalError),
 			})
 		}
-		hops = append(hops, Hop{
-			Interface: int(as.HopEntry.HopField.ConsEgress),
-			IsdAs:     as.Local.String(),
-		})
-	}
-	res := map[string]Beacon{
-		"beacon": {
-			Usages:           usage,
-			IngressInterface: int(results[0].Beacon.InIfID),
-			Id:               segapi.SegID(seg),
-			LastUpdated:      results[0].LastUpdated,
-			Timestamp:        seg.Info.Timestamp.UTC(),
-			Expiration:       seg.MinExpiry().UTC(),
-			Hops:             hops,
-		},
+		return
 	}
-	enc := json.NewEncoder(w)
-	enc.SetIndent("", "    ")
-	if err := enc.Encode(res); err != nil {
+	written, err := writeBeacons(w, rep, totalCount, nextCursor, warnings)
+	if err != nil && !written {
 		ErrorResponse(w, Problem{
 			Detail: api.StringRef(err.Error()),
 			Status: http.StatusInternalServerError,
@@ -383,196 +468,3820 @@ func (s *Server) GetBeacon(w http.ResponseWriter, r *http.Request, segmentId Seg
 	}
 }
 
-func (s *Server) DeleteBeacon(w http.ResponseWriter, r *http.Request, segmentId SegmentID) {
-	if segmentId == "" {
-		ErrorResponse(w, Problem{
-			Status: http.StatusBadRequest,
-			Title:  "segment ID is required",
-			Type:   api.StringRef(api.BadRequest),
-		})
-		return
+// writeGeoJSON writes the beacons as GeoJSON feature collection. The segments
+// are indexed by the ID of the beacons.
+func writeGeoJSON(w http.ResponseWriter, beacons []*Beacon, segments map[string]*seg.PathSegment) {
+	rep := GeoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: []GeoJSONFeature{},
 	}
-	if err := s.Beacons.DeleteBeacon(r.Context(), segmentId); err != nil {
+	for _, b := range beacons {
+		rep.Features = append(rep.Features, beaconFeatures(b.Id, segments[b.Id])...)
+	}
+	w.Header().Set("Content-Type", GeoJSONContentType)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
 		ErrorResponse(w, Problem{
 			Detail: api.StringRef(err.Error()),
 			Status: http.StatusInternalServerError,
-			Title:  "unable to delete beacon",
+			Title:  "unable to marshal response",
 			Type:   api.StringRef(api.InternalError),
 		})
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
 }
 
-func (s *Server) GetBeaconBlob(w http.ResponseWriter, r *http.Request, segmentId SegmentID) {
-	w.Header().Set("Content-Type", "application/x-pem-file")
+// beaconFeatures returns a point feature for every hop of the segment that has
+// geo coordinates in the static info extension, preceded by a line feature that
+// connects them if there are at least two. If no hop has geo coordinates, nil is
+// returned.
+func beaconFeatures(id SegmentID, s *seg.PathSegment) []GeoJSONFeature {
+	var points []GeoJSONFeature
+	var line [][]float64
+	for i, as := range s.ASEntries {
+		if as.Extensions.StaticInfo == nil {
+			continue
+		}
+		ifIDs := []uint16{as.HopEntry.HopField.ConsEgress}
+		if i != 0 {
+			ifIDs = []uint16{as.HopEntry.HopField.ConsIngress, as.HopEntry.HopField.ConsEgress}
+		}
+		for _, ifID := range ifIDs {
+			coords, ok := as.Extensions.StaticInfo.Geo[iface.ID(ifID)]
+			if ifID == 0 || !ok {
+				continue
+			}
+			pos := []float64{geoDegrees(coords.Longitude), geoDegrees(coords.Latitude)}
+			ia, ifIDInt := as.Local.String(), int(ifID)
+			props := BeaconFeatureProperties{
+				BeaconId:  id,
+				IsdAs:     &ia,
+				Interface: &ifIDInt,
+			}
+			if coords.Address != "" {
+				props.Address = api.StringRef(coords.Address)
+			}
+			points = append(points, GeoJSONFeature{
+				Type:       "Feature",
+				Geometry:   GeoJSONGeometry{Type: "Point", Coordinates: pos},
+				Properties: props,
+			})
+			line = append(line, pos)
+		}
+	}
+	if len(line) < 2 {
+		return points
+	}
+	return append([]GeoJSONFeature{{
+		Type:       "Feature",
+		Geometry:   GeoJSONGeometry{Type: "LineString", Coordinates: line},
+		Properties: BeaconFeatureProperties{BeaconId: id},
+	}}, points...)
+}
 
-	id, err := hex.DecodeString(segmentId)
-	if err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusBadRequest,
-			Title:  "error decoding segment id",
-			Type:   api.StringRef(api.BadRequest),
-		})
-		return
+// geoDegrees converts a coordinate of the static info extension to the
+// shortest decimal representation of its single precision value, such that no
+// spurious digits are introduced by the conversion to double precision.
+func geoDegrees(v float32) float64 {
+	f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'f', -1, 32), 64)
+	return f
+}
+
+// registeredVia describes the neighboring AS and the local interface through
+// which a beacon was received. If the interface is unknown, nil is returned.
+func registeredVia(interfaces map[iface.ID]topology.IFInfo, ifID uint16) *string {
+	info, ok := interfaces[iface.ID(ifID)]
+	if !ok {
+		return nil
 	}
-	q := beaconstorage.QueryParams{
-		SegIDs: [][]byte{id},
+	desc := fmt.Sprintf("%s via interface %d (%s)", info.IA, ifID, info.LinkType)
+	return &desc
+}
+
+// interfaceNames maps the interfaces of neighboring ASes to the names of the
+// local interfaces they are linked to. Interfaces with unknown remote interface
+// ID are omitted.
+func interfaceNames(interfaces map[iface.ID]topology.IFInfo) map[Hop]string {
+	names := make(map[Hop]string, len(interfaces))
+	for ifID, info := range interfaces {
+		if info.RemoteIfID == 0 {
+			continue
+		}
+		name := fmt.Sprintf("interface %d (%s)", ifID, info.LinkType)
+		if info.BRName != "" {
+			name = info.BRName + " " + name
+		}
+		names[Hop{Interface: int(info.RemoteIfID), IsdAs: info.IA.String()}] = name
 	}
-	results, err := s.Beacons.GetBeacons(r.Context(), &q)
+	return names
+}
+
+// neighborInterfaces resolves the neighbor ISD-AS to the IDs of the interfaces
+// that connect to it. It fails if the neighbor is not configured.
+func neighborInterfaces(
+	interfaces map[iface.ID]topology.IFInfo,
+	neighbor string,
+) ([]uint16, error) {
+
+	ia, err := addr.ParseIA(neighbor)
 	if err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "error getting beacons",
-			Type:   api.StringRef(api.InternalError),
-		})
-		return
+		return nil, err
 	}
-	if len(results) == 0 {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(fmt.Sprintf(
-				"no beacon matched provided segment ID: %s",
-				segmentId,
-			)),
-			Status: http.StatusBadRequest,
-			Title:  "malformed query parameter",
-			Type:   api.StringRef(api.BadRequest),
-		})
-		return
+	var ifIDs []uint16
+	for ifID, info := range interfaces {
+		if info.IA == ia {
+			ifIDs = append(ifIDs, uint16(ifID))
+		}
 	}
-	if len(results) > 1 {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(fmt.Sprintf(
-				"%d beacons matched provided segment ID: %s",
-				len(results),
-				segmentId,
-			)),
-			Status: http.StatusBadRequest,
-			Title:  "malformed query parameter",
-			Type:   api.StringRef(api.BadRequest),
-		})
-		return
+	if len(ifIDs) == 0 {
+		return nil, serrors.New("not a configured neighbor", "isd_as", ia)
 	}
-	var buf bytes.Buffer
-	segment := results[0].Beacon.Segment
-	bytes, err := proto.Marshal(seg.PathSegmentToPB(segment))
-	if err != nil {
+	slices.Sort(ifIDs)
+	return ifIDs, nil
+}
+
+// writeCBOR writes the CBOR encoded response for clients that negotiated CBOR
+// instead of JSON.
+func writeCBOR(w http.ResponseWriter, rep any) {
+	if err := api.WriteCBOR(w, rep); err != nil {
 		ErrorResponse(w, Problem{
 			Detail: api.StringRef(err.Error()),
 			Status: http.StatusInternalServerError,
-			Title:  "unable to marshal beacon",
+			Title:  "unable to marshal response",
 			Type:   api.StringRef(api.InternalError),
 		})
-		return
-	}
-	b := &pem.Block{
-		Type:  "PATH SEGMENT",
-		Bytes: bytes,
 	}
-	if err := pem.Encode(&buf, b); err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "unable to marshal response",
+}
+
+// beaconQuery is the interpretation of the query parameters of the beacon
+// listing.
+type beaconQuery struct {
+	query       beaconstorage.QueryParams
+	startPrefix string
+	signedWith  string
+	expiredOnly bool
+	expandClass bool
+	dedupeHops  bool
+	minMTU      *int
+	// paginated is set if a limit, an offset or a cursor is requested. The
+	// limit is 0 if no limit is requested.
+	paginated bool
+	limit     int
+	offset    int
+	// cursor is the position after which the page starts. It is nil if no
+	// cursor is requested.
+	cursor *beaconCursor
+	// snapshot is the time the query is pinned to. It is zero if the query is
+	// neither pinned to a snapshot nor by a cursor.
+	snapshot time.Time
+	order    beaconOrder
+	// warnings describe aspects of the query that are valid but possibly
+	// unintended.
+	warnings []string
+}
+
+// parseBeaconUsages combines the usages into the usage mask that beacons must
+// match. Unknown usages are reported in the returned list.
+func parseBeaconUsages(usages BeaconUsages) (beacon.Usage, serrors.List) {
+	var usage beacon.Usage
+	var errs serrors.List
+	for _, usageFlag := range usages {
+		switch usageFlag {
+		case CoreRegistration:
+			usage |= beacon.UsageCoreReg
+		case DownRegistration:
+			usage |= beacon.UsageDownReg
+		case Propagation:
+			usage |= beacon.UsageProp
+		case UpRegistration:
+			usage |= beacon.UsageUpReg
+		default:
+			errs = append(errs, serrors.New(
+				"unknown value for parameter",
+				"usage",
+				usageFlag,
+			))
+		}
+	}
+	return usage, errs
+}
+
+// maxBeaconPageSize is the largest number of beacons that can be requested
+// per page of a beacon listing.
+const maxBeaconPageSize = 1000
+
+// validAtFutureTolerance is the duration by which valid_at can be in the
+// future without being treated as a future query. It accounts for clock skew
+// between the client and the server.
+const validAtFutureTolerance = time.Minute
+
+// parseBeaconQuery interprets the query parameters of the beacon listing. All
+// malformed parameters are reported together in the returned error.
+func (s *Server) parseBeaconQuery(params GetBeaconsParams) (beaconQuery, error) {
+	q := beaconstorage.QueryParams{}
+	var errs serrors.List
+	var warnings []string
+	var snapshot time.Time
+	if params.Snapshot != nil {
+		if t, err := parseSnapshotToken(*params.Snapshot); err != nil {
+			errs = append(errs, serrors.Wrap("parsing snapshot", err))
+		} else if t.After(s.now()) {
+			errs = append(errs, serrors.New("snapshot is in the future",
+				"snapshot", t.UTC().Format(time.RFC3339Nano)))
+		} else {
+			snapshot = t
+			warnings = append(warnings, "snapshot reads are best-effort, beacons that "+
+				"were refreshed since the snapshot are listed with their current content "+
+				"and beacons that were removed since are not listed")
+		}
+	}
+	// The order is needed to check the cursor, its error is reported together
+	// with the other sort parameters below.
+	order, orderErr := parseBeaconOrder(params.Sort, params.Desc != nil && *params.Desc)
+	var cursor *beaconCursor
+	if params.Cursor != nil {
+		c, err := parseBeaconCursor(*params.Cursor)
+		switch {
+		case err != nil:
+			errs = append(errs, serrors.Wrap("parsing cursor", err))
+		case params.Offset != nil:
+			errs = append(errs, serrors.New("cursor and offset are mutually exclusive"))
+		case orderErr == nil && (c.Sort != order.by || c.Desc != order.reverse ||
+			len(c.Keys) != len(order.fields)):
+			errs = append(errs, serrors.New("cursor does not match the sort order",
+				"cursor_sort", c.Sort, "cursor_desc", c.Desc))
+		case !snapshot.IsZero() && !snapshot.Equal(c.At):
+			errs = append(errs, serrors.New("cursor and snapshot are pinned to different times",
+				"cursor", c.At.UTC().Format(time.RFC3339Nano),
+				"snapshot", snapshot.UTC().Format(time.RFC3339Nano)))
+		case c.At.After(s.now()):
+			errs = append(errs, serrors.New("cursor is in the future",
+				"cursor", c.At.UTC().Format(time.RFC3339Nano)))
+		default:
+			// The cursor pins the listing to the time of its first page.
+			cursor = &c
+			snapshot = c.At
+		}
+	}
+	var startPrefix string
+	if params.StartIsdAs != nil {
+		if strings.Contains(*params.StartIsdAs, "*") {
+			if prefix, err := parseIAPattern(*params.StartIsdAs); err == nil {
+				startPrefix = prefix
+			} else {
+				errs = append(errs, serrors.Wrap("parsing start_isd_as", err))
+			}
+		} else if ia, err := addr.ParseIA(*params.StartIsdAs); err == nil {
+			q.StartsAt = []addr.IA{ia}
+		} else {
+			errs = append(errs, serrors.Wrap("parsing start_isd_as", err))
+		}
+	}
+	if params.StartIsd != nil {
+		switch {
+		case params.StartIsdAs != nil:
+			errs = append(errs, serrors.New(
+				"start_isd and start_isd_as are mutually exclusive"))
+		case *params.StartIsd < 1 || *params.StartIsd > int(addr.MaxISD):
+			errs = append(errs, serrors.New(
+				"value for parameter out of range",
+				"start_isd",
+				*params.StartIsd,
+			))
+		default:
+			// The AS wildcard matches all beacons whose first hop is in the ISD.
+			q.StartsAt = []addr.IA{addr.MustIAFrom(addr.ISD(*params.StartIsd), 0)}
+		}
+	}
+	if params.Usages != nil {
+		usage, usageErrs := parseBeaconUsages(*params.Usages)
+		errs = append(errs, usageErrs...)
+		q.Usages = []beacon.Usage{usage}
+	}
+
+	if params.IngressInterface != nil {
+		if *params.IngressInterface < 0 || *params.IngressInterface > 65535 {
+			errs = append(errs, serrors.New(
+				"value for parameter out of range",
+				"ingress_interface",
+				*params.IngressInterface,
+			))
+		}
+		q.IngressInterfaces = []uint16{uint16(*params.IngressInterface)}
+	}
+	var interfaces map[iface.ID]topology.IFInfo
+	if s.Interfaces != nil {
+		interfaces = s.Interfaces()
+	}
+	if params.FromNeighbor != nil {
+		if params.IngressInterface != nil {
+			errs = append(errs, serrors.New(
+				"from_neighbor and ingress_interface are mutually exclusive"))
+		} else if ifIDs, err := neighborInterfaces(interfaces, *params.FromNeighbor); err == nil {
+			q.IngressInterfaces = ifIDs
+		} else {
+			errs = append(errs, serrors.Wrap("parsing from_neighbor", err))
+		}
+	}
+	expiredOnly := params.ExpiredOnly != nil && *params.ExpiredOnly
+	switch {
+	case expiredOnly:
+		if (params.All != nil && *params.All) || params.ValidAt != nil {
+			errs = append(errs, serrors.New(
+				"expired_only must not be combined with all or valid_at",
+			))
+		}
+		// Expired beacons are selected after querying all beacons.
+		q.ValidAt = time.Time{}
+	case (params.All != nil) && *params.All:
+		if params.ValidAt != nil {
+			errs = append(errs, serrors.New("all and valid_at are mutually exclusive"))
+		}
+		q.ValidAt = time.Time{}
+	case params.ValidAt != nil:
+		q.ValidAt = *params.ValidAt
+		if q.ValidAt.After(s.now().Add(validAtFutureTolerance)) {
+			if params.FutureOk == nil || !*params.FutureOk {
+				errs = append(errs, serrors.New(
+					"valid_at is in the future, set future_ok=true to confirm",
+					"valid_at", q.ValidAt.UTC().Format(time.RFC3339),
+				))
+			}
+			warnings = append(warnings, fmt.Sprintf(
+				"valid_at %s is in the future, only beacons that are still valid then "+
+					"are listed", q.ValidAt.UTC().Format(time.RFC3339),
+			))
+		}
+	case !snapshot.IsZero():
+		q.ValidAt = snapshot
+	default:
+		q.ValidAt = time.Now()
+	}
+	if orderErr != nil {
+		errs = append(errs, orderErr)
+	}
+	var signedWith string
+	if params.SignedWith != nil {
+		if algo, err := parseSignatureAlgorithm(*params.SignedWith); err == nil {
+			signedWith = algo.String()
+		} else {
+			errs = append(errs, err)
+		}
+	}
+	var expandClass bool
+	if params.Expand != nil {
+		for _, field := range strings.Split(*params.Expand, ",") {
+			switch field = strings.TrimSpace(field); field {
+			case "class":
+				expandClass = true
+			default:
+				errs = append(errs, serrors.New(
+					"unknown value for parameter",
+					"expand",
+					field,
+				))
+			}
+		}
+	}
+	var dedupeHops bool
+	if params.Dedupe != nil {
+		switch *params.Dedupe {
+		case "hops":
+			dedupeHops = true
+		default:
+			errs = append(errs, serrors.New(
+				"unknown value for parameter",
+				"dedupe",
+				*params.Dedupe,
+			))
+		}
+	}
+	if params.MinMtu != nil && *params.MinMtu < 0 {
+		errs = append(errs, serrors.New(
+			"value for parameter out of range",
+			"min_mtu",
+			*params.MinMtu,
+		))
+	}
+	var limit, offset int
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxBeaconPageSize {
+			errs = append(errs, serrors.New(
+				"value for parameter out of range",
+				"limit", *params.Limit,
+				"max", maxBeaconPageSize,
+			))
+		}
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		if *params.Offset < 0 {
+			errs = append(errs, serrors.New(
+				"value for parameter out of range",
+				"offset",
+				*params.Offset,
+			))
+		}
+		offset = *params.Offset
+	}
+
+	return beaconQuery{
+		query:       q,
+		startPrefix: startPrefix,
+		signedWith:  signedWith,
+		expiredOnly: expiredOnly,
+		expandClass: expandClass,
+		dedupeHops:  dedupeHops,
+		minMTU:      params.MinMtu,
+		paginated:   params.Limit != nil || params.Offset != nil || params.Cursor != nil,
+		limit:       limit,
+		offset:      offset,
+		cursor:      cursor,
+		snapshot:    snapshot,
+		order:       order,
+		warnings:    warnings,
+	}, errs.ToError()
+}
+
+// ValidateBeaconsQuery validates the query parameters of the beacon listing
+// without executing the query. It returns the normalized query.
+func (s *Server) ValidateBeaconsQuery(
+	w http.ResponseWriter,
+	r *http.Request,
+	params ValidateBeaconsQueryParams,
+) {
+
+	bq, err := s.parseBeaconQuery(GetBeaconsParams(params))
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameters",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(describeBeaconQuery(bq, GetBeaconsParams(params))); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// explainBeaconQuery writes the interpretation of the beacon query parameters
+// instead of the matching beacons.
+func explainBeaconQuery(w http.ResponseWriter, bq beaconQuery, params GetBeaconsParams) {
+	rep := describeBeaconQuery(bq, params)
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(map[string]BeaconQueryExplanation{"explain": rep}); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// describeBeaconQuery describes how the beacon query parameters are
+// interpreted.
+func describeBeaconQuery(bq beaconQuery, params GetBeaconsParams) BeaconQueryExplanation {
+	q, startPrefix, signedWith := bq.query, bq.startPrefix, bq.signedWith
+	rep := BeaconQueryExplanation{
+		StartIsdAs:        make([]string, 0, len(q.StartsAt)),
+		IngressInterfaces: make([]int, 0, len(q.IngressInterfaces)),
+		UsageMasks:        make([]int, 0, len(q.Usages)),
+		Sort:              "last_updated",
+		Desc:              params.Desc != nil && *params.Desc,
+	}
+	for _, ia := range q.StartsAt {
+		rep.StartIsdAs = append(rep.StartIsdAs, ia.String())
+	}
+	for _, ifID := range q.IngressInterfaces {
+		rep.IngressInterfaces = append(rep.IngressInterfaces, int(ifID))
+	}
+	for _, usage := range q.Usages {
+		rep.UsageMasks = append(rep.UsageMasks, int(usage))
+	}
+	if !q.ValidAt.IsZero() {
+		validAt := q.ValidAt.UTC()
+		rep.ValidAt = &validAt
+	}
+	if params.Sort != nil {
+		rep.Sort = *params.Sort
+	}
+	if startPrefix != "" {
+		rep.StartIsdAsPrefix = api.StringRef(startPrefix)
+	}
+	if signedWith != "" {
+		rep.SignedWith = api.StringRef(signedWith)
+	}
+	if params.LoopsOnly != nil && *params.LoopsOnly {
+		rep.LoopsOnly = params.LoopsOnly
+	}
+	if params.ExpiredOnly != nil && *params.ExpiredOnly {
+		rep.ExpiredOnly = params.ExpiredOnly
+	}
+	if bq.dedupeHops {
+		rep.Dedupe = api.StringRef("hops")
+	}
+	rep.MinMtu = bq.minMTU
+	if !bq.snapshot.IsZero() {
+		snapshot := bq.snapshot.UTC()
+		rep.Snapshot = &snapshot
+	}
+	return rep
+}
+
+// GetSnapshot returns a token that pins beacon queries to the current time.
+func (s *Server) GetSnapshot(w http.ResponseWriter, r *http.Request) {
+	now := s.now()
+	rep := Snapshot{
+		Token:   snapshotToken(now),
+		TakenAt: now.UTC(),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// snapshotToken encodes the snapshot time as opaque token. The token is the
+// URL-safe base64 encoding of the Unix time in nanoseconds.
+func snapshotToken(t time.Time) string {
+	var raw [8]byte
+	binary.BigEndian.PutUint64(raw[:], uint64(t.UnixNano()))
+	return base64.RawURLEncoding.EncodeToString(raw[:])
+}
+
+// parseSnapshotToken decodes the snapshot time from a token created by
+// snapshotToken.
+func parseSnapshotToken(token string) (time.Time, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, serrors.Wrap("decoding token", err)
+	}
+	if len(raw) != 8 {
+		return time.Time{}, serrors.New("invalid token length", "length", len(raw))
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(raw))), nil
+}
+
+// pathMTU computes the path MTU of the segment, i.e., the smallest MTU of the AS
+// entries and of the links between them. The first AS entry has no ingress link,
+// its ingress MTU is 0 and ignored.
+func pathMTU(s *seg.PathSegment) int {
+	var mtu int
+	for i, as := range s.ASEntries {
+		if i == 0 || as.MTU < mtu {
+			mtu = as.MTU
+		}
+		if as.HopEntry.IngressMTU != 0 && as.HopEntry.IngressMTU < mtu {
+			mtu = as.HopEntry.IngressMTU
+		}
+	}
+	return mtu
+}
+
+// beaconHops lists the hops of the beacon, i.e., the ingress and egress
+// interfaces of the AS entries. A hop is annotated with the MTU of the link at
+// its interface and with the latency and bandwidth that the static info
+// extension announces for it, if they are known. If includePeers is set, the
+// peering interfaces of the peer entries are listed after the ingress
+// interface of their AS entry, and every hop is tagged with its type.
+func beaconHops(s *seg.PathSegment, includePeers bool) []Hop {
+	var hops []Hop
+	add := func(hop Hop, typ HopType) {
+		if includePeers {
+			hop.Type = &typ
+		}
+		hops = append(hops, hop)
+	}
+	for i, as := range s.ASEntries {
+		hf := as.HopEntry.HopField
+		info := as.Extensions.StaticInfo
+		if i != 0 {
+			hop := Hop{
+				Interface: int(hf.ConsIngress),
+				IsdAs:     as.Local.String(),
+			}
+			if mtu := as.HopEntry.IngressMTU; mtu != 0 {
+				hop.Mtu = &mtu
+			}
+			if info != nil {
+				hop.Latency, hop.Bandwidth = announcedHopMetadata(
+					info.Latency.Intra, info.Bandwidth.Intra, hf.ConsIngress)
+			}
+			add(hop, Ingress)
+		}
+		if includePeers {
+			for _, peer := range as.PeerEntries {
+				add(peerHop(as.Local, peer, info), Peer)
+			}
+		}
+		hop := Hop{
+			Interface: int(hf.ConsEgress),
+			IsdAs:     as.Local.String(),
+		}
+		// The link at the egress interface is the ingress link of the next AS
+		// entry, which announces its MTU.
+		if i+1 < len(s.ASEntries) {
+			if mtu := s.ASEntries[i+1].HopEntry.IngressMTU; mtu != 0 {
+				hop.Mtu = &mtu
+			}
+		}
+		if info != nil {
+			hop.Latency, hop.Bandwidth = announcedHopMetadata(
+				info.Latency.Inter, info.Bandwidth.Inter, hf.ConsEgress)
+		}
+		typ := Egress
+		if i == 0 {
+			typ = Core
+		}
+		add(hop, typ)
+	}
+	return hops
+}
+
+// peerHop describes the local interface of the peering link of a peer entry.
+// The static info extension announces the latency and bandwidth of peering
+// links like the ones of the egress links.
+func peerHop(local addr.IA, peer seg.PeerEntry, info *staticinfo.Extension) Hop {
+	ifID := peer.HopField.ConsIngress
+	peerIA := peer.Peer.String()
+	peerInterface := int(peer.PeerInterface)
+	hop := Hop{
+		Interface:     int(ifID),
+		IsdAs:         local.String(),
+		PeerIsdAs:     &peerIA,
+		PeerInterface: &peerInterface,
+	}
+	if mtu := peer.PeerMTU; mtu != 0 {
+		hop.Mtu = &mtu
+	}
+	if info != nil {
+		hop.Latency, hop.Bandwidth = announcedHopMetadata(
+			info.Latency.Inter, info.Bandwidth.Inter, ifID)
+	}
+	return hop
+}
+
+// announcedHopMetadata looks up the latency in milliseconds and the bandwidth
+// in Kbit/s that are announced for the interface. Values that are not
+// announced are nil.
+func announcedHopMetadata(
+	latencies map[iface.ID]time.Duration,
+	bandwidths map[iface.ID]uint64,
+	ifID uint16,
+) (*float64, *int) {
+
+	if ifID == 0 {
+		return nil, nil
+	}
+	var latency *float64
+	if l, ok := latencies[iface.ID(ifID)]; ok {
+		ms := float64(l) / float64(time.Millisecond)
+		latency = &ms
+	}
+	var bandwidth *int
+	if b, ok := bandwidths[iface.ID(ifID)]; ok {
+		kbps := int(b)
+		bandwidth = &kbps
+	}
+	return latency, bandwidth
+}
+
+// dedupeByHops collapses the beacons with the same sequence of hops. Of every
+// group, the most recently updated beacon is kept and annotated with the number
+// of collapsed duplicates. The order of the first occurrences is preserved.
+func dedupeByHops(beacons []*Beacon) []*Beacon {
+	kept := make([]*Beacon, 0, len(beacons))
+	index := make(map[string]int, len(beacons))
+	for _, b := range beacons {
+		key := hopsKey(b.Hops)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(kept)
+			b.DuplicateCount = new(int)
+			kept = append(kept, b)
+			continue
+		}
+		cur := kept[i]
+		count := *cur.DuplicateCount + 1
+		if b.LastUpdated.After(cur.LastUpdated) ||
+			(b.LastUpdated.Equal(cur.LastUpdated) && b.Id < cur.Id) {
+			kept[i] = b
+		}
+		kept[i].DuplicateCount = &count
+	}
+	return kept
+}
+
+// hopsKey returns a key that is equal for beacons with the same sequence of
+// hops.
+func hopsKey(hops []Hop) string {
+	var key strings.Builder
+	for _, hop := range hops {
+		fmt.Fprintf(&key, "%s#%d ", hop.IsdAs, hop.Interface)
+	}
+	return key.String()
+}
+
+// beaconClass classifies the beacon as core or non-core. Beacons that may be
+// registered are classified by their usages. Otherwise, the structure of the
+// segment is used: only core beacons cross ISD boundaries, and only non-core
+// beacons carry peer entries. If neither is conclusive, the empty string is
+// returned.
+func beaconClass(usage beacon.Usage, s *seg.PathSegment) string {
+	switch {
+	case usage&beacon.UsageCoreReg != 0:
+		return "core"
+	case usage&(beacon.UsageUpReg|beacon.UsageDownReg) != 0:
+		return "non_core"
+	}
+	for _, as := range s.ASEntries {
+		if len(as.PeerEntries) != 0 {
+			return "non_core"
+		}
+		if as.Local.ISD() != s.FirstIA().ISD() {
+			return "core"
+		}
+	}
+	return ""
+}
+
+// SegmentTextContentType is the media type of the human-readable text
+// representation of a path segment, as it is used in log messages.
+const SegmentTextContentType = "text/vnd.scion.segment"
+
+// GeoJSONContentType is the media type of GeoJSON documents (RFC 7946).
+const GeoJSONContentType = "application/geo+json"
+
+// queryContext derives the context of a database query from the request
+// context. If a query timeout is configured, the context expires after it.
+func (s *Server) queryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.QueryTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, s.QueryTimeout)
+}
+
+// NDJSONContentType is the media type of newline delimited JSON, i.e., a
+// sequence of JSON objects that are separated by newlines.
+const NDJSONContentType = "application/x-ndjson"
+
+// defaultMaxBeaconHops is the maximum number of AS entries of a listed beacon
+// if no maximum is configured.
+const defaultMaxBeaconHops = 64
+
+// beaconFlushInterval is the number of beacons after which the response is
+// flushed to the client.
+const beaconFlushInterval = 64
+
+// writeBeaconsNDJSON writes the beacons as newline delimited JSON to the
+// writer, one beacon per line. If the total count or the next cursor is set or
+// there are warnings, they are written as {"total_count": n, "next_cursor":
+// "...", "warnings": [...]} on a final line, which clients can tell apart from
+// the beacons by its lack of an id. Like writeBeacons, it flushes the
+// response every beaconFlushInterval beacons and reports whether anything was
+// written to w.
+func writeBeaconsNDJSON(
+	w http.ResponseWriter,
+	beacons []*Beacon,
+	totalCount *int,
+	nextCursor *string,
+	warnings []string,
+) (bool, error) {
+	rc := http.NewResponseController(w)
+	for i, b := range beacons {
+		raw, err := json.Marshal(b)
+		if err != nil {
+			return i > 0, err
+		}
+		if _, err := w.Write(append(raw, '\n')); err != nil {
+			return true, err
+		}
+		if (i+1)%beaconFlushInterval == 0 {
+			// Not all writers support flushing, in which case the response
+			// is simply buffered by the server.
+			_ = rc.Flush()
+		}
+	}
+	if totalCount == nil && nextCursor == nil && len(warnings) == 0 {
+		return len(beacons) > 0, nil
+	}
+	raw, err := json.Marshal(struct {
+		TotalCount *int     `json:"total_count,omitempty"`
+		NextCursor *string  `json:"next_cursor,omitempty"`
+		Warnings   []string `json:"warnings,omitempty"`
+	}{TotalCount: totalCount, NextCursor: nextCursor, Warnings: warnings})
+	if err != nil {
+		return len(beacons) > 0, err
+	}
+	_, err = w.Write(append(raw, '\n'))
+	return true, err
+}
+
+// writeBeacons writes the beacons as {"beacons": [...]} to the writer. If the
+// total count is set, it is appended as {"total_count": n}, if the next cursor
+// is set, it is appended as {"next_cursor": "..."}, and if there are warnings,
+// they are appended as {"warnings": [...]}. The output is identical
+// to encoding the whole response with an indenting json.Encoder, but the
+// beacons are encoded and written one at a time, such that the encoded
+// response is not buffered as a whole. The beacons themselves are collected
+// by the caller, because they are sorted and paginated as a whole, so the
+// memory use still grows with the number of beacons. The returned flag
+// indicates whether anything was written to w, in which case an error can no
+// longer be reported to the client.
+func writeBeacons(
+	w http.ResponseWriter,
+	beacons []*Beacon,
+	totalCount *int,
+	nextCursor *string,
+	warnings []string,
+) (bool, error) {
+	var rawWarnings []byte
+	if len(warnings) != 0 {
+		var err error
+		if rawWarnings, err = json.MarshalIndent(warnings, "    ", "    "); err != nil {
+			return false, err
+		}
+	}
+	if len(beacons) == 0 {
+		if _, err := io.WriteString(w, "{\n    \"beacons\": []"); err != nil {
+			return true, err
+		}
+	}
+	rc := http.NewResponseController(w)
+	for i, b := range beacons {
+		raw, err := json.MarshalIndent(b, "        ", "    ")
+		if err != nil {
+			return i > 0, err
+		}
+		prefix := ",\n        "
+		if i == 0 {
+			prefix = "{\n    \"beacons\": [\n        "
+		}
+		if _, err := io.WriteString(w, prefix); err != nil {
+			return true, err
+		}
+		if _, err := w.Write(raw); err != nil {
+			return true, err
+		}
+		if (i+1)%beaconFlushInterval == 0 {
+			// Not all writers support flushing, in which case the response
+			// is simply buffered by the server.
+			_ = rc.Flush()
+		}
+	}
+	if len(beacons) != 0 {
+		if _, err := io.WriteString(w, "\n    ]"); err != nil {
+			return true, err
+		}
+	}
+	if totalCount != nil {
+		if _, err := fmt.Fprintf(w, ",\n    \"total_count\": %d", *totalCount); err != nil {
+			return true, err
+		}
+	}
+	if nextCursor != nil {
+		raw, err := json.Marshal(*nextCursor)
+		if err != nil {
+			return true, err
+		}
+		if _, err := fmt.Fprintf(w, ",\n    \"next_cursor\": %s", raw); err != nil {
+			return true, err
+		}
+	}
+	if rawWarnings != nil {
+		if _, err := io.WriteString(w, ",\n    \"warnings\": "); err != nil {
+			return true, err
+		}
+		if _, err := w.Write(rawWarnings); err != nil {
+			return true, err
+		}
+	}
+	_, err := io.WriteString(w, "\n}\n")
+	return true, err
+}
+
+// iaPattern matches ISD-AS wildcard patterns, i.e., a prefix of the string
+// representation of an ISD-AS that is terminated by a single '*'.
+var iaPattern = regexp.MustCompile(`^[0-9]+(-[0-9a-f:]*)?\*$`)
+
+// parseIAPattern parses an ISD-AS wildcard pattern and returns the prefix that
+// the string representation of matching ISD-AS identifiers starts with.
+func parseIAPattern(pattern string) (string, error) {
+	pattern = strings.ToLower(pattern)
+	if !iaPattern.MatchString(pattern) {
+		return "", serrors.New("malformed ISD-AS pattern", "pattern", pattern)
+	}
+	return strings.TrimSuffix(pattern, "*"), nil
+}
+
+// parseSignatureAlgorithm parses the name of a supported signature algorithm.
+// The comparison is case-insensitive.
+func parseSignatureAlgorithm(name string) (signed.SignatureAlgorithm, error) {
+	for _, algo := range []signed.SignatureAlgorithm{
+		signed.ECDSAWithSHA256,
+		signed.ECDSAWithSHA384,
+		signed.ECDSAWithSHA512,
+	} {
+		if strings.EqualFold(algo.String(), name) {
+			return algo, nil
+		}
+	}
+	return 0, serrors.New("unknown signature algorithm", "signed_with", name)
+}
+
+// signatureAlgorithms returns the names of the signature algorithms used to
+// sign the AS entries of the segment. The signatures are not verified.
+func signatureAlgorithms(s *seg.PathSegment) []string {
+	algos := make([]string, 0, len(s.ASEntries))
+	for _, entry := range s.ASEntries {
+		hdr, err := signed.ExtractUnverifiedHeader(entry.Signed)
+		if err != nil || hdr.SignatureAlgorithm == signed.UnknownSignatureAlgorithm {
+			algos = append(algos, "unknown")
+			continue
+		}
+		algos = append(algos, hdr.SignatureAlgorithm.String())
+	}
+	return algos
+}
+
+// cryptoAgile reports whether all AS entries of the segment are signed with
+// one of the allowed signature algorithms. The signatures are not verified.
+func cryptoAgile(s *seg.PathSegment, allowed []signed.SignatureAlgorithm) bool {
+	for _, entry := range s.ASEntries {
+		hdr, err := signed.ExtractUnverifiedHeader(entry.Signed)
+		if err != nil || !slices.Contains(allowed, hdr.SignatureAlgorithm) {
+			return false
+		}
+	}
+	return true
+}
+
+// beaconSortKey is the value of a beacon field that the beacon listing is
+// sorted by. Times and numbers are stored in Int, strings in Str. The keys are
+// part of the listing cursor, hence they are plain values.
+type beaconSortKey struct {
+	Int int64  `json:"i,omitempty"`
+	Str string `json:"s,omitempty"`
+}
+
+func (k beaconSortKey) compare(o beaconSortKey) int {
+	if c := cmp.Compare(k.Int, o.Int); c != 0 {
+		return c
+	}
+	return strings.Compare(k.Str, o.Str)
+}
+
+// beaconSortKeys maps the supported sort fields to the key that orders
+// beacons ascending by that field.
+var beaconSortKeys = map[string]func(b *Beacon) beaconSortKey{
+	"expiration": func(b *Beacon) beaconSortKey {
+		return beaconSortKey{Int: b.Expiration.UnixNano()}
+	},
+	"timestamp": func(b *Beacon) beaconSortKey {
+		return beaconSortKey{Int: b.Timestamp.UnixNano()}
+	},
+	// Beacons without hops are ordered before all others.
+	"start_isd_as": func(b *Beacon) beaconSortKey {
+		if len(b.Hops) == 0 {
+			return beaconSortKey{}
+		}
+		return beaconSortKey{Int: 1, Str: b.Hops[0].IsdAs}
+	},
+	"last_updated": func(b *Beacon) beaconSortKey {
+		return beaconSortKey{Int: b.LastUpdated.UnixNano()}
+	},
+	"ingress_interface": func(b *Beacon) beaconSortKey {
+		return beaconSortKey{Int: int64(b.IngressInterface)}
+	},
+	"isd_count": func(b *Beacon) beaconSortKey {
+		return beaconSortKey{Int: int64(distinctISDs(b))}
+	},
+	"hop_count": func(b *Beacon) beaconSortKey {
+		return beaconSortKey{Int: int64(len(b.Hops))}
+	},
+	// Shorthand for expiration:desc, which is convenient for the UI.
+	"expiration_time_desc": func(b *Beacon) beaconSortKey {
+		return beaconSortKey{Int: -b.Expiration.UnixNano()}
+	},
+	// Names that were accepted before the fields were aligned with the API
+	// specification.
+	"expiration_time": func(b *Beacon) beaconSortKey {
+		return beaconSortKey{Int: b.Expiration.UnixNano()}
+	},
+	"info_time": func(b *Beacon) beaconSortKey {
+		return beaconSortKey{Int: b.Timestamp.UnixNano()}
+	},
+}
+
+// distinctISDs returns the number of distinct ISDs the hops of the beacon
+// traverse.
+func distinctISDs(b *Beacon) int {
+	isds := make(map[string]struct{})
+	for _, hop := range b.Hops {
+		isd, _, _ := strings.Cut(hop.IsdAs, "-")
+		isds[isd] = struct{}{}
+	}
+	return len(isds)
+}
+
+// beaconPosition is the position of a beacon in a beacon order, i.e., its sort
+// keys and its segment ID.
+type beaconPosition struct {
+	Keys []beaconSortKey `json:"keys"`
+	ID   string          `json:"id"`
+}
+
+// beaconOrder is the order of the beacon listing. Beacons are ordered by the
+// sort keys of the fields, where later fields break ties of earlier ones.
+// Remaining ties are broken by the segment ID, such that the order does not
+// depend on the order in which the beacons are loaded from the storage, and
+// such that every beacon has a distinct position that a cursor can refer to.
+type beaconOrder struct {
+	// by is the sort parameter the order is parsed from.
+	by     string
+	fields []func(b *Beacon) beaconSortKey
+	// desc is set for the fields that are ordered descending.
+	desc []bool
+	// reverse is set if the whole order, including the tie break by the
+	// segment ID, is reversed.
+	reverse bool
+}
+
+// parseBeaconOrder parses the sort parameter, a comma-separated list of
+// field[:direction] tokens, into the order of the beacon listing. If reverse
+// is set, the whole order is reversed.
+func parseBeaconOrder(sortParam *string, reverse bool) (beaconOrder, error) {
+	by := "last_updated"
+	if sortParam != nil {
+		by = *sortParam
+	}
+	o := beaconOrder{by: by, reverse: reverse}
+	for _, token := range strings.Split(by, ",") {
+		field, direction, _ := strings.Cut(token, ":")
+		key, ok := beaconSortKeys[field]
+		if !ok {
+			return beaconOrder{}, serrors.New("unknown query parameter", "sort", field)
+		}
+		switch direction {
+		case "", "asc":
+			o.desc = append(o.desc, false)
+		case "desc":
+			o.desc = append(o.desc, true)
+		default:
+			return beaconOrder{}, serrors.New("unknown sort direction", "sort", token)
+		}
+		o.fields = append(o.fields, key)
+	}
+	return o, nil
+}
+
+// position returns the position of the beacon in the order.
+func (o beaconOrder) position(b *Beacon) beaconPosition {
+	keys := make([]beaconSortKey, 0, len(o.fields))
+	for _, key := range o.fields {
+		keys = append(keys, key(b))
+	}
+	return beaconPosition{Keys: keys, ID: b.Id}
+}
+
+// compare compares two positions in the order. Both positions must have a key
+// for every field of the order.
+func (o beaconOrder) compare(a, b beaconPosition) int {
+	c := 0
+	for i := range o.fields {
+		if c = a.Keys[i].compare(b.Keys[i]); c != 0 {
+			if o.desc[i] {
+				c = -c
+			}
+			break
+		}
+	}
+	if c == 0 {
+		c = strings.Compare(a.ID, b.ID)
+	}
+	if o.reverse {
+		return -c
+	}
+	return c
+}
+
+// sort sorts the beacons in place and returns their positions in the same
+// order. The sort keys are computed once per beacon, because some of them
+// are not cheap to compute.
+func (o beaconOrder) sort(beacons []*Beacon) []beaconPosition {
+	type entry struct {
+		beacon   *Beacon
+		position beaconPosition
+	}
+	entries := make([]entry, 0, len(beacons))
+	for _, b := range beacons {
+		entries = append(entries, entry{beacon: b, position: o.position(b)})
+	}
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		return o.compare(a.position, b.position)
+	})
+	positions := make([]beaconPosition, 0, len(entries))
+	for i, e := range entries {
+		beacons[i] = e.beacon
+		positions = append(positions, e.position)
+	}
+	return positions
+}
+
+// beaconCursor is the position after which the next page of the beacon
+// listing starts. It is handed to clients as an opaque token. The position
+// consists of the sort keys and the ID of the last beacon of the previous
+// page, such that beacons that are added, refreshed or removed in between do
+// not shift the pages.
+type beaconCursor struct {
+	// At is the time the listing is pinned to, i.e., the time of its first
+	// page.
+	At time.Time `json:"at"`
+	// Sort and Desc identify the order that the position refers to.
+	Sort string `json:"sort"`
+	Desc bool   `json:"desc,omitempty"`
+	beaconPosition
+}
+
+// token encodes the cursor as opaque token. The token is the URL-safe base64
+// encoding of the JSON encoded cursor.
+func (c beaconCursor) token() string {
+	// The cursor consists of plain values only, hence encoding cannot fail.
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// parseBeaconCursor decodes a cursor from a token created by
+// beaconCursor.token.
+func parseBeaconCursor(token string) (beaconCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return beaconCursor{}, serrors.Wrap("decoding token", err)
+	}
+	var c beaconCursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return beaconCursor{}, serrors.Wrap("decoding token", err)
+	}
+	return c, nil
+}
+
+// parseSortOrder parses a comma-separated list of field[:direction] tokens
+// into a single comparison function, where later fields break ties of earlier
+// ones. The fields are looked up in the comparators, which order ascending.
+func parseSortOrder[T any](
+	by string,
+	comparators map[string]func(a, b T) int,
+) (func(a, b T) int, error) {
+	var keys []func(a, b T) int
+	for _, token := range strings.Split(by, ",") {
+		field, direction, _ := strings.Cut(token, ":")
+		compare, ok := comparators[field]
+		if !ok {
+			return nil, serrors.New("unknown query parameter", "sort", field)
+		}
+		switch direction {
+		case "", "asc":
+		case "desc":
+			asc := compare
+			compare = func(a, b T) int { return asc(b, a) }
+		default:
+			return nil, serrors.New("unknown sort direction", "sort", token)
+		}
+		keys = append(keys, compare)
+	}
+	return func(a, b T) int {
+		for _, compare := range keys {
+			if c := compare(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}, nil
+}
+
+func (s *Server) GetBeacon(
+	w http.ResponseWriter,
+	r *http.Request,
+	segmentId SegmentID,
+	params GetBeaconParams,
+) {
+
+	if err := validateSegmentIDPrefix(segmentId); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "error decoding segment id",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	q := beaconstorage.QueryParams{
+		SegIDPrefix: segmentId,
+	}
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	results, err := s.Beacons.GetBeacons(ctx, &q)
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+		return
+	}
+	if len(results) == 0 {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(fmt.Sprintf(
+				"no beacon matched provided segment ID: %s",
+				segmentId,
+			)),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameter",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	if len(results) > 1 {
+		writeBeaconCandidates(w, results)
+		return
+	}
+	// The ETag does not depend on the representation, hence a matching
+	// conditional request is answered without rendering the beacon.
+	etag := beaconETag(results[0])
+	if api.ETagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Vary", "Accept")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	includeBlob := params.IncludeBlob != nil && *params.IncludeBlob
+	includePeers := params.IncludePeers != nil && *params.IncludePeers
+	body, contentType, err := s.renderBeacon(r, results[0], includeBlob, includePeers)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Vary", "Accept")
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write(body)
+}
+
+// writeBeaconCandidates writes the response to a segment ID prefix that matches
+// several beacons, i.e., the sorted list of their segment IDs with status 300,
+// such that the client can choose among the candidates.
+func writeBeaconCandidates(w http.ResponseWriter, results []beaconstorage.Beacon) {
+	rep := BeaconCandidates{SegmentIds: make([]SegmentID, 0, len(results))}
+	for _, result := range results {
+		rep.SegmentIds = append(rep.SegmentIds, segapi.SegID(result.Beacon.Segment))
+	}
+	sort.Strings(rep.SegmentIds)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusMultipleChoices)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	// no point in catching error here, the status code has already been written.
+	_ = enc.Encode(rep)
+}
+
+// beaconETag computes the ETag of a stored beacon. It covers the segment ID,
+// the usage and the last update time of the beacon, but not the representation
+// of the beacon description. Hence, it is a weak ETag that is shared by all
+// representations and changes whenever the beacon is updated. It is cheap to
+// compute, such that conditional requests are answered without rendering the
+// beacon.
+func beaconETag(result beaconstorage.Beacon) string {
+	raw := result.Beacon.Segment.ID()
+	raw = binary.BigEndian.AppendUint16(raw, uint16(result.Usage))
+	raw = binary.BigEndian.AppendUint64(raw, uint64(result.LastUpdated.UnixNano()))
+	return "W/" + api.ContentETag(raw)
+}
+
+// renderBeacon serializes the beacon description in the representation that is
+// negotiated by the Accept header of the request. If includeBlob is set, the
+// JSON and CBOR representations include the protobuf encoded segment. If
+// includePeers is set, their hops include the peer entries. It returns the
+// serialized beacon and its content type.
+func (s *Server) renderBeacon(
+	r *http.Request,
+	result beaconstorage.Beacon,
+	includeBlob bool,
+	includePeers bool,
+) ([]byte, string, error) {
+
+	seg := result.Beacon.Segment
+	var usage BeaconUsages
+	for _, name := range UnpackBeaconUsages(result.Usage) {
+		usage = append(usage, BeaconUsage(name))
+	}
+	hops := beaconHops(seg, includePeers)
+	b := Beacon{
+		Usages:           usage,
+		IngressInterface: int(result.Beacon.InIfID),
+		Id:               segapi.SegID(seg),
+		LastUpdated:      result.LastUpdated,
+		Timestamp:        seg.Info.Timestamp.UTC(),
+		Expiration:       seg.MinExpiry().UTC(),
+		Hops:             hops,
+	}
+	if s.Interfaces != nil {
+		b.RegisteredVia = registeredVia(s.Interfaces(), result.Beacon.InIfID)
+	}
+	if warnings := segapi.ParseWarnings(seg); len(warnings) != 0 {
+		b.ParseWarnings = &warnings
+	}
+	if len(s.CryptoAgileAlgorithms) != 0 {
+		agile := cryptoAgile(seg, s.CryptoAgileAlgorithms)
+		b.CryptoAgile = &agile
+	}
+	res := BeaconGetResponseJson{Beacon: b}
+	var buf bytes.Buffer
+	var err error
+	if includeBlob {
+		var raw []byte
+		if raw, err = beacon.PackBeacon(seg); err != nil {
+			return nil, "", err
+		}
+		res.Raw = &raw
+	}
+	contentType := "application/json"
+	switch {
+	case api.AcceptsMediaType(r, SegmentTextContentType):
+		contentType = SegmentTextContentType
+		buf.WriteString(seg.String() + "\n")
+	case api.AcceptsMediaType(r, segapi.ProtobufContentType):
+		contentType = segapi.ProtobufContentType
+		var raw []byte
+		if raw, err = beacon.PackBeacon(seg); err == nil {
+			buf.Write(raw)
+		}
+	case api.AcceptsCBOR(r):
+		contentType = api.CBORContentType
+		var raw []byte
+		if raw, err = api.MarshalCBOR(res); err == nil {
+			buf.Write(raw)
+		}
+	default:
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "    ")
+		err = enc.Encode(res)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), contentType, nil
+}
+
+// decodeSegmentID decodes a hex-encoded segment ID. The ID can be a prefix of
+// the full segment ID, but IDs that are longer than a full segment ID cannot
+// match any beacon and are rejected before querying the beacon store.
+func decodeSegmentID(segmentId SegmentID) ([]byte, error) {
+	id, err := hex.DecodeString(segmentId)
+	if err != nil {
+		return nil, err
+	}
+	if len(id) > sha256.Size {
+		return nil, serrors.New("invalid segment ID length",
+			"length", len(id), "max", sha256.Size)
+	}
+	return id, nil
+}
+
+// validateSegmentIDPrefix checks that the segment ID is a hex encoded prefix
+// of a segment ID. Unlike decodeSegmentID, it accepts prefixes that end in half
+// a byte.
+func validateSegmentIDPrefix(segmentId SegmentID) error {
+	if len(segmentId) > hex.EncodedLen(sha256.Size) {
+		return serrors.New("invalid segment ID length",
+			"length", len(segmentId), "max", hex.EncodedLen(sha256.Size))
+	}
+	for i, c := range segmentId {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return serrors.New("invalid hex character in segment ID",
+				"position", i, "character", string(c))
+		}
+	}
+	return nil
+}
+
+// DeleteBeacons deletes all beacons that match the filters. To guard against
+// emptying the beacon store by accident, a request without any filter is
+// rejected unless it sets all=true.
+func (s *Server) DeleteBeacons(w http.ResponseWriter, r *http.Request, params DeleteBeaconsParams) {
+	q, err := parseBeaconDeletion(params)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameters",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	deleted, err := s.Beacons.DeleteBeacons(ctx, q)
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "unable to delete beacons")))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(BeaconDeletion{DeletedCount: deleted}); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// parseBeaconDeletion interprets the filters of a bulk beacon deletion. All
+// malformed parameters are reported together in the returned error. The
+// returned parameters are nil if all beacons are deleted.
+func parseBeaconDeletion(params DeleteBeaconsParams) (*beaconstorage.QueryParams, error) {
+	q := beaconstorage.QueryParams{}
+	var errs serrors.List
+	filtered := false
+	if params.StartIsdAs != nil {
+		filtered = true
+		if ia, err := addr.ParseIA(*params.StartIsdAs); err != nil {
+			errs = append(errs, serrors.Wrap("parsing start_isd_as", err))
+		} else if ia.ISD() == 0 || ia.AS() == 0 {
+			errs = append(errs, serrors.New("start_isd_as must not contain wildcards",
+				"start_isd_as", *params.StartIsdAs))
+		} else {
+			q.StartsAt = []addr.IA{ia}
+		}
+	}
+	if params.Usages != nil {
+		filtered = true
+		usage, usageErrs := parseBeaconUsages(*params.Usages)
+		errs = append(errs, usageErrs...)
+		q.Usages = []beacon.Usage{usage}
+	}
+	if params.IngressInterface != nil {
+		filtered = true
+		if *params.IngressInterface < 0 || *params.IngressInterface > 65535 {
+			errs = append(errs, serrors.New(
+				"value for parameter out of range",
+				"ingress_interface",
+				*params.IngressInterface,
+			))
+		}
+		q.IngressInterfaces = []uint16{uint16(*params.IngressInterface)}
+	}
+	if params.ValidAt != nil {
+		filtered = true
+		q.ValidAt = *params.ValidAt
+	}
+	all := params.All != nil && *params.All
+	switch {
+	case all && filtered:
+		errs = append(errs, serrors.New("all must not be combined with other filters"))
+	case !all && !filtered:
+		errs = append(errs, serrors.New(
+			"at least one filter is required, set all=true to delete all beacons"))
+	}
+	if err := errs.ToError(); err != nil {
+		return nil, err
+	}
+	if all {
+		return nil, nil
+	}
+	return &q, nil
+}
+
+func (s *Server) DeleteBeacon(w http.ResponseWriter, r *http.Request, segmentId SegmentID) {
+	if segmentId == "" {
+		ErrorResponse(w, Problem{
+			Status: http.StatusBadRequest,
+			Title:  "segment ID is required",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		if !s.beaconMatches(w, r, segmentId, ifMatch) {
+			return
+		}
+	}
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	if err := s.Beacons.DeleteBeacon(ctx, segmentId); err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "unable to delete beacon")))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// beaconMatches checks the If-Match precondition of a beacon deletion. It
+// reports whether the ETag of the current beacon matches. Otherwise, the error
+// response has already been written.
+func (s *Server) beaconMatches(
+	w http.ResponseWriter,
+	r *http.Request,
+	segmentId SegmentID,
+	ifMatch string,
+) bool {
+
+	if err := validateSegmentIDPrefix(segmentId); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "error decoding segment id",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return false
+	}
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	results, err := s.Beacons.GetBeacons(ctx, &beaconstorage.QueryParams{
+		SegIDPrefix: segmentId,
+	})
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+		return false
+	}
+	if len(results) > 1 {
+		writeBeaconCandidates(w, results)
+		return false
+	}
+	if len(results) == 1 {
+		if api.ETagMatches(ifMatch, beaconETag(results[0])) {
+			return true
+		}
+	}
+	ErrorResponse(w, Problem{
+		Detail: api.StringRef("the beacon does not exist or changed since the ETag was issued"),
+		Status: http.StatusPreconditionFailed,
+		Title:  "precondition failed",
+		Type:   api.StringRef(api.BadRequest),
+	})
+	return false
+}
+
+// GetBeaconBlob gets a beacon (specified by its ID) as a pem encoded blob. If
+// the client only accepts protobuf, the raw protobuf message is returned.
+func (s *Server) GetBeaconBlob(w http.ResponseWriter, r *http.Request, segmentId SegmentID) {
+	contentType, ok := api.NegotiateMediaType(
+		r, segapi.PEMContentType, segapi.ProtobufContentType)
+	if !ok {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(fmt.Sprintf(
+				"supported media types are %s and %s",
+				segapi.PEMContentType, segapi.ProtobufContentType,
+			)),
+			Status: http.StatusNotAcceptable,
+			Title:  "unsupported media type",
+			Type:   api.StringRef(api.NotAcceptable),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+
+	id, err := decodeSegmentID(segmentId)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "error decoding segment id",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	q := beaconstorage.QueryParams{
+		SegIDs: [][]byte{id},
+	}
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	results, err := s.Beacons.GetBeacons(ctx, &q)
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+		return
+	}
+	if len(results) == 0 {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(fmt.Sprintf(
+				"no beacon matched provided segment ID: %s",
+				segmentId,
+			)),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameter",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	if len(results) > 1 {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(fmt.Sprintf(
+				"%d beacons matched provided segment ID: %s",
+				len(results),
+				segmentId,
+			)),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameter",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	var buf bytes.Buffer
+	segment := results[0].Beacon.Segment
+	bytes, err := proto.Marshal(seg.PathSegmentToPB(segment))
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal beacon",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	if contentType == segapi.ProtobufContentType {
+		_, _ = w.Write(bytes)
+		return
+	}
+	b := &pem.Block{
+		Type:  "PATH SEGMENT",
+		Bytes: bytes,
+	}
+	if err := pem.Encode(&buf, b); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	_, _ = w.Write(buf.Bytes())
+}
+
+// GetBeaconSegments lists the segments in the path database that were
+// registered from the beacon.
+func (s *Server) GetBeaconSegments(w http.ResponseWriter, r *http.Request, segmentId SegmentID) {
+	id, err := decodeSegmentID(segmentId)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "error decoding segment id",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	results, err := s.Beacons.GetBeacons(ctx, &beaconstorage.QueryParams{
+		SegIDs: [][]byte{id},
+	})
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+		return
+	}
+	if len(results) != 1 {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(fmt.Sprintf(
+				"%d beacons matched provided segment ID: %s",
+				len(results),
+				segmentId,
+			)),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameter",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	beacon := results[0].Beacon.Segment
+	segments, err := s.SegmentsServer.Segments.Get(ctx, &query.Params{
+		StartsAt: []addr.IA{beacon.FirstIA()},
+	})
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting segments")))
+		return
+	}
+	rep := []SegmentBrief{}
+	for _, res := range segments {
+		if !registeredFrom(res.Seg, beacon) {
+			continue
+		}
+		rep = append(rep, SegmentBrief{
+			Id:         segapi.SegID(res.Seg),
+			StartIsdAs: res.Seg.FirstIA().String(),
+			EndIsdAs:   res.Seg.LastIA().String(),
+			Length:     len(res.Seg.ASEntries),
+		})
+	}
+	slices.SortFunc(rep, func(a, b SegmentBrief) int { return cmp.Compare(a.Id, b.Id) })
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// registeredFrom indicates whether the segment was registered from the beacon,
+// i.e., whether the segment extends the beacon by at least one AS entry and
+// its leading AS entries carry the same hops as the beacon.
+func registeredFrom(segment, beacon *seg.PathSegment) bool {
+	if len(segment.ASEntries) <= len(beacon.ASEntries) {
+		return false
+	}
+	for i, b := range beacon.ASEntries {
+		s := segment.ASEntries[i]
+		if s.Local != b.Local ||
+			s.HopEntry.HopField.ConsIngress != b.HopEntry.HopField.ConsIngress ||
+			s.HopEntry.HopField.ConsEgress != b.HopEntry.HopField.ConsEgress {
+			return false
+		}
+	}
+	return true
+}
+
+// GetBeaconPolicy gets the beaconing policy that is currently in effect.
+func (s *Server) GetBeaconPolicy(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	p := s.BeaconPolicy.BeaconPolicy()
+	rep := BeaconingPolicy{
+		OriginationInterval:         p.OriginationInterval.String(),
+		OriginationIntervalSeconds:  durationSeconds(p.OriginationInterval),
+		PropagationInterval:         p.PropagationInterval.String(),
+		PropagationIntervalSeconds:  durationSeconds(p.PropagationInterval),
+		RegistrationInterval:        p.RegistrationInterval.String(),
+		RegistrationIntervalSeconds: durationSeconds(p.RegistrationInterval),
+		Policies:                    make([]BeaconPolicy, 0, len(p.Policies)),
+	}
+	for _, policy := range p.Policies {
+		maxExpTime := beacon.DefaultMaxExpTime
+		if policy.MaxExpTime != nil {
+			maxExpTime = *policy.MaxExpTime
+		}
+		filter := BeaconPolicyFilter{
+			AllowIsdLoop:  policy.Filter.AllowIsdLoop == nil || *policy.Filter.AllowIsdLoop,
+			AsBlacklist:   make([]string, 0, len(policy.Filter.AsBlackList)),
+			IsdBlacklist:  make([]int, 0, len(policy.Filter.IsdBlackList)),
+			MaxHopsLength: policy.Filter.MaxHopsLength,
+		}
+		for _, as := range policy.Filter.AsBlackList {
+			filter.AsBlacklist = append(filter.AsBlacklist, as.String())
+		}
+		for _, isd := range policy.Filter.IsdBlackList {
+			filter.IsdBlacklist = append(filter.IsdBlacklist, int(isd))
+		}
+		maxExpiration := path.ExpTimeToDuration(maxExpTime)
+		rep.Policies = append(rep.Policies, BeaconPolicy{
+			BestSetSize:              policy.BestSetSize,
+			CandidateSetSize:         policy.CandidateSetSize,
+			Filter:                   filter,
+			MaxExpirationTime:        maxExpiration.String(),
+			MaxExpirationTimeSeconds: durationSeconds(maxExpiration),
+			Type:                     string(policy.Type),
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// defaultBeaconSLAWindow is the window within which a beacon must have been
+// updated to be fresh if no window is requested.
+const defaultBeaconSLAWindow = 15 * time.Minute
+
+// GetBeaconSla reports the fraction of the currently valid beacons that were
+// updated within the requested window, grouped by origin or usage.
+func (s *Server) GetBeaconSla(w http.ResponseWriter, r *http.Request, params GetBeaconSlaParams) {
+	var errs serrors.List
+	window := defaultBeaconSLAWindow
+	if params.Window != nil {
+		d, err := time.ParseDuration(*params.Window)
+		if err == nil && d <= 0 {
+			err = serrors.New("window must be positive", "window", d)
+		}
+		if err != nil {
+			errs = append(errs, serrors.Wrap("parsing window", err))
+		}
+		window = d
+	}
+	groupBy := Origin
+	if params.GroupBy != nil {
+		switch *params.GroupBy {
+		case Origin, Usage:
+			groupBy = *params.GroupBy
+		default:
+			errs = append(errs, serrors.New(
+				"unknown value for parameter",
+				"group_by",
+				*params.GroupBy,
+			))
+		}
+	}
+	if err := errs.ToError(); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameters",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	now := s.now()
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	results, err := s.Beacons.GetBeacons(ctx, &beaconstorage.QueryParams{
+		ValidAt: now,
+	})
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+		return
+	}
+
+	rep := BeaconSLAReport{
+		Window:        window.String(),
+		WindowSeconds: durationSeconds(window),
+		GroupBy:       string(groupBy),
+		Groups:        []BeaconSLAGroup{},
+	}
+	groups := make(map[string]*BeaconSLAGroup)
+	freshSince := now.Add(-window)
+	for _, result := range results {
+		fresh := !result.LastUpdated.Before(freshSince)
+		rep.Total++
+		if fresh {
+			rep.Fresh++
+		}
+		var names []string
+		if groupBy == Usage {
+			names = UnpackBeaconUsages(result.Usage)
+		} else {
+			names = []string{result.Beacon.Segment.FirstIA().String()}
+		}
+		for _, name := range names {
+			group, ok := groups[name]
+			if !ok {
+				group = &BeaconSLAGroup{Group: name}
+				groups[name] = group
+			}
+			group.Total++
+			if fresh {
+				group.Fresh++
+			}
+		}
+	}
+	rep.Stale = rep.Total - rep.Fresh
+	if rep.Total != 0 {
+		compliance := float32(float64(rep.Fresh) / float64(rep.Total))
+		rep.Compliance = &compliance
+	}
+	for _, group := range groups {
+		group.Stale = group.Total - group.Fresh
+		group.FreshRatio = float32(float64(group.Fresh) / float64(group.Total))
+		rep.Groups = append(rep.Groups, *group)
+	}
+	slices.SortFunc(rep.Groups, func(a, b BeaconSLAGroup) int {
+		return strings.Compare(a.Group, b.Group)
+	})
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// defaultTopOrigins is the number of origin ASes that are listed in the beacon
+// statistics if no number is configured.
+const defaultTopOrigins = 10
+
+// GetBeaconStats counts the currently valid beacons per usage, per ingress
+// interface and per origin AS. The counts are aggregated by the beacon store,
+// the beacons themselves are not loaded.
+func (s *Server) GetBeaconStats(
+	w http.ResponseWriter,
+	r *http.Request,
+	params GetBeaconStatsParams,
+) {
+
+	top := s.TopOrigins
+	if top <= 0 {
+		top = defaultTopOrigins
+	}
+	if params.Top != nil {
+		if *params.Top < 0 {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(serrors.New(
+					"value for parameter out of range",
+					"top",
+					*params.Top,
+				).Error()),
+				Status: http.StatusBadRequest,
+				Title:  "malformed query parameters",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		}
+		top = *params.Top
+	}
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	stats, err := s.Beacons.Stats(ctx, &beaconstorage.QueryParams{
+		ValidAt: s.now(),
+	})
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacon statistics")))
+		return
+	}
+
+	usages := make(map[string]int)
+	for usage, count := range stats.Usages {
+		for _, name := range UnpackBeaconUsages(usage) {
+			usages[name] += count
+		}
+	}
+	origins := stats.Origins
+	rep := BeaconStats{
+		Total:           stats.Total,
+		Usages:          make([]BeaconUsageCount, 0, len(usages)),
+		Interfaces:      make([]BeaconInterfaceCount, 0, len(stats.IngressInterfaces)),
+		DistinctOrigins: len(origins),
+		TopOrigins:      make([]BeaconOriginCount, 0, min(top, len(origins))),
+	}
+	if stats.Total > 0 {
+		oldest, newest := stats.Oldest.UTC(), stats.Newest.UTC()
+		rep.Oldest, rep.Newest = &oldest, &newest
+	}
+	for usage, count := range usages {
+		rep.Usages = append(rep.Usages, BeaconUsageCount{Usage: BeaconUsage(usage), Count: count})
+	}
+	slices.SortFunc(rep.Usages, func(a, b BeaconUsageCount) int {
+		return strings.Compare(string(a.Usage), string(b.Usage))
+	})
+	for ifID, count := range stats.IngressInterfaces {
+		rep.Interfaces = append(rep.Interfaces, BeaconInterfaceCount{
+			IngressInterface: int(ifID),
+			Count:            count,
+		})
+	}
+	slices.SortFunc(rep.Interfaces, func(a, b BeaconInterfaceCount) int {
+		return cmp.Compare(a.IngressInterface, b.IngressInterface)
+	})
+	ias := make([]addr.IA, 0, len(origins))
+	for ia := range origins {
+		ias = append(ias, ia)
+	}
+	slices.SortFunc(ias, func(a, b addr.IA) int {
+		if c := cmp.Compare(origins[b], origins[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+	for _, ia := range ias[:min(top, len(ias))] {
+		rep.TopOrigins = append(rep.TopOrigins, BeaconOriginCount{
+			IsdAs: ia.String(),
+			Count: origins[ia],
+		})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// defaultHeartbeatSilence is the age of the most recent beacon of an origin
+// beyond which the origin is considered silent if no threshold is requested.
+const defaultHeartbeatSilence = 15 * time.Minute
+
+// GetBeaconHeartbeat reports the creation time of the most recent beacon per
+// origin AS. Expired beacons are taken into account, such that origins that
+// stopped beaconing are still listed and flagged as silent.
+func (s *Server) GetBeaconHeartbeat(
+	w http.ResponseWriter,
+	r *http.Request,
+	params GetBeaconHeartbeatParams,
+) {
+	silentAfter := defaultHeartbeatSilence
+	if params.SilentAfter != nil {
+		d, err := time.ParseDuration(*params.SilentAfter)
+		if err == nil && d <= 0 {
+			err = serrors.New("silent_after must be positive", "silent_after", d)
+		}
+		if err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(serrors.Wrap("parsing silent_after", err).Error()),
+				Status: http.StatusBadRequest,
+				Title:  "malformed query parameters",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		}
+		silentAfter = d
+	}
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	results, err := s.Beacons.GetBeacons(ctx, &beaconstorage.QueryParams{})
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+		return
+	}
+
+	latest := make(map[addr.IA]time.Time)
+	for _, result := range results {
+		origin := result.Beacon.Segment.FirstIA()
+		if ts := result.Beacon.Segment.Info.Timestamp; ts.After(latest[origin]) {
+			latest[origin] = ts
+		}
+	}
+	ias := make([]addr.IA, 0, len(latest))
+	for ia := range latest {
+		ias = append(ias, ia)
+	}
+	slices.Sort(ias)
+	now := s.now()
+	rep := BeaconHeartbeat{
+		SilentAfter:        silentAfter.String(),
+		SilentAfterSeconds: durationSeconds(silentAfter),
+		Origins:            make([]BeaconHeartbeatOrigin, 0, len(ias)),
+	}
+	for _, ia := range ias {
+		age := max(now.Sub(latest[ia]), 0)
+		rep.Origins = append(rep.Origins, BeaconHeartbeatOrigin{
+			IsdAs:           ia.String(),
+			LatestTimestamp: latest[ia],
+			AgeSeconds:      durationSeconds(age),
+			Silent:          age > silentAfter,
+		})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// defaultBeaconAgeBuckets are the upper boundaries of the age buckets if no
+// buckets are requested.
+var defaultBeaconAgeBuckets = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+	time.Hour,
+}
+
+// GetBeaconAgeHistogram counts the currently valid beacons per age bucket,
+// where the age is the time since the beacon was last updated.
+func (s *Server) GetBeaconAgeHistogram(
+	w http.ResponseWriter,
+	r *http.Request,
+	params GetBeaconAgeHistogramParams,
+) {
+	bounds := defaultBeaconAgeBuckets
+	if params.Buckets != nil {
+		var err error
+		if bounds, err = parseAgeBuckets(*params.Buckets); err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusBadRequest,
+				Title:  "malformed query parameters",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		}
+	}
+	now := s.now()
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	results, err := s.Beacons.GetBeacons(ctx, &beaconstorage.QueryParams{
+		ValidAt: now,
+	})
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+		return
+	}
+
+	counts := make([]int, len(bounds)+1)
+	for _, result := range results {
+		// A beacon that was updated in the future counts as fresh.
+		age := max(now.Sub(result.LastUpdated), 0)
+		i, _ := slices.BinarySearch(bounds, age)
+		counts[i]++
+	}
+	rep := BeaconAgeHistogram{
+		Total:   len(results),
+		Buckets: make([]BeaconAgeBucket, 0, len(bounds)),
+		Older:   counts[len(bounds)],
+	}
+	for i, bound := range bounds {
+		rep.Buckets = append(rep.Buckets, BeaconAgeBucket{
+			UpperBound:        bound.String(),
+			UpperBoundSeconds: durationSeconds(bound),
+			Count:             counts[i],
+		})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// parseAgeBuckets parses the upper boundaries of the age buckets. The list must
+// not be empty and the boundaries must be positive and strictly increasing.
+func parseAgeBuckets(raw []string) ([]time.Duration, error) {
+	if len(raw) == 0 {
+		return nil, serrors.New("no buckets specified")
+	}
+	bounds := make([]time.Duration, 0, len(raw))
+	for i, v := range raw {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, serrors.Wrap("parsing bucket", err, "index", i)
+		}
+		if d <= 0 {
+			return nil, serrors.New("bucket must be positive", "index", i, "bucket", d)
+		}
+		if i > 0 && d <= bounds[i-1] {
+			return nil, serrors.New("buckets must be strictly increasing",
+				"index", i, "bucket", d, "previous", bounds[i-1])
+		}
+		bounds = append(bounds, d)
+	}
+	return bounds, nil
+}
+
+// beaconActivityWindow is the interval in which received beacons count towards
+// the recent beaconing activity of an interface.
+const beaconActivityWindow = time.Hour
+
+// GetInterfaces lists the interfaces that are configured in the topology with
+// their beaconing activity, sorted by interface ID.
+func (s *Server) GetInterfaces(w http.ResponseWriter, r *http.Request) {
+	if s.Interfaces == nil {
+		ErrorResponse(w, Problem{
+			Status: http.StatusNotImplemented,
+			Title:  "topology not available",
+			Type:   api.StringRef(api.NotImplemented),
+		})
+		return
+	}
+	interfaces := s.Interfaces()
+	ifIDs := make([]uint16, 0, len(interfaces))
+	for ifID := range interfaces {
+		ifIDs = append(ifIDs, uint16(ifID))
+	}
+	slices.Sort(ifIDs)
+
+	type activity struct {
+		last   time.Time
+		recent int
+	}
+	activities := make(map[uint16]*activity, len(ifIDs))
+	if len(ifIDs) != 0 {
+		// Beacons are considered regardless of their validity, such that the
+		// last activity is also reported for interfaces with only expired
+		// beacons.
+		ctx, cancel := s.queryContext(r.Context())
+		defer cancel()
+		results, err := s.Beacons.GetBeacons(ctx, &beaconstorage.QueryParams{
+			IngressInterfaces: ifIDs,
+		})
+		if err != nil {
+			ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+			return
+		}
+		recentSince := s.now().Add(-beaconActivityWindow)
+		for ifID, group := range beaconsByInterface(results) {
+			a := &activity{}
+			for _, result := range group {
+				if result.LastUpdated.After(a.last) {
+					a.last = result.LastUpdated
+				}
+				if !result.LastUpdated.Before(recentSince) {
+					a.recent++
+				}
+			}
+			activities[ifID] = a
+		}
+	}
+
+	rep := InterfacesResponse{
+		Interfaces: make([]InterfaceStatus, 0, len(ifIDs)),
+	}
+	for _, ifID := range ifIDs {
+		info := interfaces[iface.ID(ifID)]
+		row := InterfaceStatus{
+			InterfaceId:   int(ifID),
+			NeighborIsdAs: info.IA.String(),
+			LinkType:      info.LinkType.String(),
+			Mtu:           info.MTU,
+		}
+		if a, ok := activities[ifID]; ok {
+			last := a.last.UTC()
+			row.LastBeacon = &last
+			row.BeaconsLastHour = a.recent
+		}
+		rep.Interfaces = append(rep.Interfaces, row)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// beaconsByInterface groups the beacons by the interface on which they were
+// received.
+func beaconsByInterface(results []beaconstorage.Beacon) map[uint16][]beaconstorage.Beacon {
+	groups := make(map[uint16][]beaconstorage.Beacon)
+	for _, result := range results {
+		groups[result.Beacon.InIfID] = append(groups[result.Beacon.InIfID], result)
+	}
+	return groups
+}
+
+// GetBeaconCover selects a minimal set of currently valid beacons such that
+// every interface on which a valid beacon was received is covered. Since a
+// beacon covers exactly the interface on which it was received, the greedy
+// selection picks the most recently updated beacon of every interface.
+func (s *Server) GetBeaconCover(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	results, err := s.Beacons.GetBeacons(ctx, &beaconstorage.QueryParams{
+		ValidAt: s.now(),
+	})
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+		return
+	}
+	groups := beaconsByInterface(results)
+	ifIDs := make([]uint16, 0, len(groups))
+	for ifID := range groups {
+		ifIDs = append(ifIDs, ifID)
+	}
+	slices.Sort(ifIDs)
+
+	rep := BeaconCover{
+		SegmentIds:          make([]SegmentID, 0, len(ifIDs)),
+		UncoveredInterfaces: []int{},
+	}
+	for _, ifID := range ifIDs {
+		group := groups[ifID]
+		chosen := &group[0]
+		for i := range group[1:] {
+			if group[i+1].LastUpdated.After(chosen.LastUpdated) {
+				chosen = &group[i+1]
+			}
+		}
+		rep.SegmentIds = append(rep.SegmentIds, segapi.SegID(chosen.Beacon.Segment))
+	}
+	if s.Interfaces != nil {
+		for ifID := range s.Interfaces() {
+			if _, ok := groups[uint16(ifID)]; !ok {
+				rep.UncoveredInterfaces = append(rep.UncoveredInterfaces, int(ifID))
+			}
+		}
+		slices.Sort(rep.UncoveredInterfaces)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// GetSelectedBeacons runs the beacon selection of the beaconing policies over
+// the current candidate beacons and lists the selected beacons.
+func (s *Server) GetSelectedBeacons(w http.ResponseWriter, r *http.Request) {
+	if s.BeaconSelector == nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef("This instance does not support previewing the beacon selection"),
+			Status: http.StatusNotImplemented,
+			Title:  "beacon selection not supported",
+			Type:   api.StringRef(api.NotImplemented),
+		})
+		return
+	}
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	selections, err := s.BeaconSelector.SelectedBeacons(ctx)
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error selecting beacons")))
+		return
+	}
+	rep := make([]SelectedBeacon, 0, len(selections))
+	for _, sel := range selections {
+		rep = append(rep, SelectedBeacon{
+			Hops:             len(sel.Beacon.Segment.ASEntries),
+			Id:               segapi.SegID(sel.Beacon.Segment),
+			IngressInterface: int(sel.Beacon.InIfID),
+			Policy:           string(sel.Policy),
+			Reason:           SelectedBeaconReason(sel.Reason),
+			StartIsdAs:       sel.Beacon.Segment.FirstIA().String(),
+		})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// maxReconcileIDs is the maximum number of segment IDs that can be reconciled
+// with a single request.
+const maxReconcileIDs = 1000
+
+// ReconcileBeacons reports which of the expected beacons are present in the
+// beacon store and which are missing.
+func (s *Server) ReconcileBeacons(w http.ResponseWriter, r *http.Request) {
+	var req BeaconReconcileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		if requestTooLarge(w, err) {
+			return
+		}
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed request body",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	var errs serrors.List
+	if len(req.Ids) > maxReconcileIDs {
+		errs = append(errs, serrors.New("too many segment IDs",
+			"count", len(req.Ids), "max", maxReconcileIDs))
+	}
+	ids := make([][]byte, 0, len(req.Ids))
+	for _, raw := range req.Ids {
+		id, err := hex.DecodeString(raw)
+		if err == nil && len(id) != sha256.Size {
+			err = serrors.New("incomplete segment ID", "length", len(id), "expected", sha256.Size)
+		}
+		if err != nil {
+			errs = append(errs, serrors.Wrap("parsing segment ID", err, "id", raw))
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if err := errs.ToError(); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed request body",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+
+	rep := BeaconReconcileResult{
+		Present: []SegmentID{},
+		Missing: []SegmentID{},
+	}
+	if len(ids) > 0 {
+		ctx, cancel := s.queryContext(r.Context())
+		defer cancel()
+		results, err := s.Beacons.GetBeacons(ctx, &beaconstorage.QueryParams{
+			SegIDs: ids,
+		})
+		if err != nil {
+			ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+			return
+		}
+		stored := make(map[SegmentID]bool, len(results))
+		for _, result := range results {
+			stored[segapi.SegID(result.Beacon.Segment)] = true
+		}
+		seen := make(map[SegmentID]bool, len(ids))
+		for _, id := range ids {
+			segID := hex.EncodeToString(id)
+			if seen[segID] {
+				continue
+			}
+			seen[segID] = true
+			if stored[segID] {
+				rep.Present = append(rep.Present, segID)
+			} else {
+				rep.Missing = append(rep.Missing, segID)
+			}
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// GetBeaconAnomalies lists the stored beacons that violate an invariant of
+// their timestamps and expiration.
+func (s *Server) GetBeaconAnomalies(w http.ResponseWriter, r *http.Request) {
+	// Expired beacons are included, because a beacon that is never valid is
+	// one of the anomalies.
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	results, err := s.Beacons.GetBeacons(ctx, &beaconstorage.QueryParams{})
+	if err != nil {
+		ErrorResponse(w, Problem(api.QueryProblem(ctx, err, "error getting beacons")))
+		return
+	}
+	rep := []BeaconAnomaly{}
+	for _, result := range results {
+		s := result.Beacon.Segment
+		anomalies := beaconAnomalies(s, result.LastUpdated)
+		if len(anomalies) == 0 {
+			continue
+		}
+		rep = append(rep, BeaconAnomaly{
+			Id:          segapi.SegID(s),
+			Timestamp:   s.Info.Timestamp.UTC(),
+			Expiration:  s.MinExpiry().UTC(),
+			LastUpdated: result.LastUpdated,
+			Anomalies:   anomalies,
+		})
+	}
+	slices.SortFunc(rep, func(a, b BeaconAnomaly) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// futureTimestampTolerance is the duration by which the timestamp of a beacon
+// can be after the time the beacon was stored without being reported as an
+// anomaly. It accounts for clock skew between the origin and the local AS.
+const futureTimestampTolerance = time.Minute
+
+// beaconAnomalies returns the invariants of the timestamps and expiration that
+// the segment violates.
+func beaconAnomalies(s *seg.PathSegment, lastUpdated time.Time) []BeaconAnomalyKind {
+	var anomalies []BeaconAnomalyKind
+	timestamp := s.Info.Timestamp
+	minExpiry, maxExpiry := s.MinExpiry(), s.MaxExpiry()
+	if minExpiry.Before(timestamp) {
+		anomalies = append(anomalies, ExpiryBeforeTimestamp)
+	}
+	if maxExpiry.Before(minExpiry) {
+		anomalies = append(anomalies, NegativeInterval)
+	}
+	if !maxExpiry.After(timestamp) {
+		anomalies = append(anomalies, ZeroExpiry)
+	}
+	if timestamp.IsZero() || timestamp.Unix() == 0 {
+		anomalies = append(anomalies, ZeroTimestamp)
+	}
+	if timestamp.After(lastUpdated.Add(futureTimestampTolerance)) {
+		anomalies = append(anomalies, FutureTimestamp)
+	}
+	return anomalies
+}
+
+// durationSeconds represents a duration as whole seconds, rounded down. It
+// accompanies the human readable representation of durations in responses,
+// such that clients do not need to parse the output of time.Duration.String.
+func durationSeconds(d time.Duration) int {
+	return int(d / time.Second)
+}
+
+// GetSegments gets the stored in the PathDB.
+func (s *Server) GetSegments(w http.ResponseWriter,
+	r *http.Request, params GetSegmentsParams) {
+	p := segapi.GetSegmentsParams{
+		StartIsdAs: params.StartIsdAs,
+		EndIsdAs:   params.EndIsdAs,
+		GroupBy:    (*segapi.GetSegmentsParamsGroupBy)(params.GroupBy),
+	}
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	s.SegmentsServer.GetSegments(w, r.WithContext(ctx), p)
+}
+
+func (s *Server) GetSegment(w http.ResponseWriter, r *http.Request, id SegmentID) {
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	s.SegmentsServer.GetSegment(w, r.WithContext(ctx), id)
+}
+
+func (s *Server) DeleteSegment(w http.ResponseWriter, r *http.Request, id SegmentID) {
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	s.SegmentsServer.DeleteSegment(w, r.WithContext(ctx), id)
+}
+
+func (s *Server) GetSegmentBlob(w http.ResponseWriter, r *http.Request, id SegmentID) {
+	ctx, cancel := s.queryContext(r.Context())
+	defer cancel()
+	s.SegmentsServer.GetSegmentBlob(w, r.WithContext(ctx), id)
+}
+
+// GetCertificates lists the certificate chains.
+func (s *Server) GetCertificates(w http.ResponseWriter,
+	r *http.Request, params GetCertificatesParams) {
+	cppkiParams := cppkiapi.GetCertificatesParams{
+		IsdAs:             params.IsdAs,
+		ValidAt:           params.ValidAt,
+		All:               params.All,
+		IncludeSuperseded: params.IncludeSuperseded,
+		ExpiringBetween:   params.ExpiringBetween,
+	}
+	s.CPPKIServer.GetCertificates(w, r, cppkiParams)
+}
+
+// GetCertificate lists the certificate chain for a given ChainID.
+func (s *Server) GetCertificate(w http.ResponseWriter, r *http.Request, chainID ChainID) {
+	s.CPPKIServer.GetCertificate(w, r, chainID)
+}
+
+// GetCertificateBlob generates a certificate chain blob response encoded as PEM
+// for a given chainId.
+func (s *Server) GetCertificateBlob(w http.ResponseWriter, r *http.Request, chainID ChainID) {
+	s.CPPKIServer.GetCertificateBlob(w, r, chainID)
+}
+
+// GetCa gets the CA info.
+func (s *Server) GetCa(w http.ResponseWriter, r *http.Request, params GetCaParams) {
+	w.Header().Set("Content-Type", "application/json")
+	if s.CA.PolicyGen == nil {
+		caNotConfigured(w)
+		return
+	}
+	var timing *serverTiming
+	if params.Debug != nil && *params.Debug {
+		timing = &serverTiming{}
+		// The timings describe this request only and must not be replayed.
+		w.Header().Set("Cache-Control", "no-store")
+	}
+
+	start := time.Now()
+	p, err := s.CA.PolicyGen.Generate(r.Context())
+	timing.add(w, "policy", time.Since(start))
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "No active signer",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	start = time.Now()
+	ia, err := cppki.ExtractIA(p.Certificate.Subject)
+	timing.add(w, "ia", time.Since(start))
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "Unable to extract ISD-AS",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	rep := CA{
+		CertValidity: Validity{
+			NotAfter:  p.Certificate.NotAfter,
+			NotBefore: p.Certificate.NotBefore,
+		},
+		Policy: Policy{
+			ChainLifetime:        p.Validity.String(),
+			ChainLifetimeSeconds: durationSeconds(p.Validity),
+		},
+		Subject: Subject{
+			IsdAs: ia.String(),
+		},
+		SubjectKeyId: fmt.Sprintf("% X", p.Certificate.SubjectKeyId),
+	}
+	if api.AcceptsCBOR(r) {
+		writeCBOR(w, rep)
+		return
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// serverTiming records the metrics of the Server-Timing header of a response.
+// A nil serverTiming records nothing, such that the instrumentation can be
+// left in place if timing is not requested.
+type serverTiming struct {
+	metrics []string
+}
+
+// add records the duration of the named step and updates the header. The
+// header must be updated before the response is written.
+func (t *serverTiming) add(w http.ResponseWriter, name string, d time.Duration) {
+	if t == nil {
+		return
+	}
+	ms := float64(d) / float64(time.Millisecond)
+	t.metrics = append(t.metrics, fmt.Sprintf("%s;dur=%.3f", name, ms))
+	w.Header().Set("Server-Timing", strings.Join(t.metrics, ", "))
+}
+
+// PreviewCaRenewal describes the certificate chain that would be issued for
+// the PEM encoded certificate signing request in the request body.
+func (s *Server) PreviewCaRenewal(w http.ResponseWriter, r *http.Request) {
+	if s.CA.PolicyGen == nil {
+		caNotConfigured(w)
+		return
+	}
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		if requestTooLarge(w, err) {
+			return
+		}
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "unable to read request body",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef("expected PEM block of type CERTIFICATE REQUEST"),
+			Status: http.StatusBadRequest,
+			Title:  "malformed certificate signing request",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err == nil {
+		err = csr.CheckSignature()
+	}
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed certificate signing request",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	chain, err := s.CA.PreviewChain(r.Context(), csr)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to create certificate chain",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	// The created chain is validated, so the ISD-AS can always be extracted.
+	subject, _ := cppki.ExtractIA(chain[0].Subject)
+	issuer, _ := cppki.ExtractIA(chain[1].Subject)
+	rep := Chain{
+		Subject: Certificate{
+			DistinguishedName: api.DistinguishedName(chain[0].Subject),
+			IsdAs:             subject.String(),
+			SubjectKeyAlgo:    chain[0].PublicKeyAlgorithm.String(),
+			SubjectKeyId:      fmt.Sprintf("% X", chain[0].SubjectKeyId),
+			Validity: Validity{
+				NotAfter:  chain[0].NotAfter,
+				NotBefore: chain[0].NotBefore,
+			},
+		},
+		Issuer: Certificate{
+			DistinguishedName: api.DistinguishedName(chain[1].Subject),
+			IsdAs:             issuer.String(),
+			SubjectKeyAlgo:    chain[1].PublicKeyAlgorithm.String(),
+			SubjectKeyId:      fmt.Sprintf("% X", chain[1].SubjectKeyId),
+			Validity: Validity{
+				NotAfter:  chain[1].NotAfter,
+				NotBefore: chain[1].NotBefore,
+			},
+		},
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// caNotConfigured writes the problem response for CA endpoints on instances
+// without CA capability.
+func caNotConfigured(w http.ResponseWriter) {
+	ErrorResponse(w, Problem{
+		Detail: api.StringRef("This instance is not configured with CA capability"),
+		Status: http.StatusNotImplemented,
+		Title:  "Not a CA",
+		Type:   api.StringRef(api.CANotConfigured),
+	})
+}
+
+// GetTrcs gets the trcs specified by it's params.
+func (s *Server) GetTrcs(
+	w http.ResponseWriter,
+	r *http.Request,
+	params GetTrcsParams, // nolint - name from published API
+) {
+	cppkiParams := cppkiapi.GetTrcsParams{ // nolint - name from published API
+		Isd: params.Isd,
+		All: params.All,
+	}
+	s.CPPKIServer.GetTrcs(w, r, cppkiParams) // nolint - name from published API
+}
+
+// GetTrc gets the trc specified by it's isd base and serial.
+func (s *Server) GetTrc(w http.ResponseWriter, r *http.Request, isd int, base int, serial int) {
+	s.CPPKIServer.GetTrc(w, r, isd, base, serial) // nolint - name from published API
+}
+
+// GetTrcBlob gets the trc encoded pem blob.
+func (s *Server) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, base int, serial int) {
+	s.CPPKIServer.GetTrcBlob(w, r, isd, base, serial) // nolint - name from published API
+}
+
+// GetTrustAnchor gets the latest TRC of every ISD as a PEM bundle.
+func (s *Server) GetTrustAnchor(w http.ResponseWriter, r *http.Request) {
+	if s.TrustDB == nil {
+		ErrorResponse(w, Problem{
+			Status: http.StatusNotImplemented,
+			Title:  "trust database not available",
+			Type:   api.StringRef(api.NotImplemented),
+		})
+		return
+	}
+	trcs, err := s.TrustDB.SignedTRCs(r.Context(), truststorage.TRCsQuery{Latest: true})
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "error getting trcs",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	if len(trcs) == 0 {
+		ErrorResponse(w, Problem{
+			Status: http.StatusNotFound,
+			Title:  "there are no trcs",
+			Type:   api.StringRef(api.NotFound),
+		})
+		return
+	}
+	sort.Sort(trcs)
+	var buf bytes.Buffer
+	for _, trc := range trcs {
+		if err := pem.Encode(&buf, &pem.Block{Type: "TRC", Bytes: trc.TRC.Raw}); err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "unable to marshal response",
+				Type:   api.StringRef(api.InternalError),
+			})
+			return
+		}
+	}
+	etag := api.ContentETag(buf.Bytes())
+	w.Header().Set("ETag", etag)
+	if api.ETagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-pem-file")
+	_, _ = w.Write(buf.Bytes())
+}
+
+// GetTrcDiff compares two TRCs and lists their differences.
+func (s *Server) GetTrcDiff(w http.ResponseWriter, r *http.Request, params GetTrcDiffParams) {
+	s.CPPKIServer.GetTrcDiff(w, r, cppkiapi.GetTrcDiffParams(params))
+}
+
+// GetConfig is an indirection to the http handler.
+func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
+	s.Config(w, r)
+}
+
+// ReloadConfig reloads the hot-reloadable configuration of the service.
+func (s *Server) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+	if s.Reload == nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef("This instance does not support reloading the configuration"),
+			Status: http.StatusNotImplemented,
+			Title:  "reload not supported",
+			Type:   api.StringRef(api.NotImplemented),
+		})
+		return
+	}
+	changes, err := s.Reload(r.Context())
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusUnprocessableEntity,
+			Title:  "invalid configuration",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	if changes == nil {
+		changes = []string{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(ConfigReloadResult{Changes: changes}); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// GetInfo is an indirection to the http handler.
+func (s *Server) GetInfo(w http.ResponseWriter, r *http.Request) {
+	s.Info(w, r)
+}
+
+// GetVersion gets the build information of the binary.
+func (s *Server) GetVersion(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	rep := VersionInfo{
+		Version:   env.StartupVersion,
+		GoVersion: runtime.Version(),
+	}
+	// The VCS settings are only stamped by the go tool, they are absent in
+	// binaries built by bazel.
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				rep.GitCommit = api.StringRef(setting.Value)
+			case "vcs.time":
+				if t, err := time.Parse(time.RFC3339, setting.Value); err == nil {
+					rep.BuildTime = &t
+				}
+			}
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// GetLogLevel is an indirection to the http handler.
+func (s *Server) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+	s.LogLevel(w, r)
+}
+
+// SetLogLevel is an indirection to the http handler.
+func (s *Server) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+	s.LogLevel(w, r)
+}
+
+// GetLogLevelOptions describes the current logging level and the levels that
+// can be set.
+func (s *Server) GetLogLevelOptions(w http.ResponseWriter, r *http.Request) {
+	if s.LogLevels == nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef("the service does not report the logging levels"),
+			Status: http.StatusNotImplemented,
+			Title:  "logging levels not available",
+			Type:   api.StringRef(api.NotImplemented),
+		})
+		return
+	}
+	level, levels := s.LogLevels()
+	rep := LogLevelOptions{
+		Level:  level,
+		Levels: levels,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// GetSigner generates the singer response content. If all is set, all
+// currently valid signers are listed instead of only the last expiring one.
+func (s *Server) GetSigner(w http.ResponseWriter, r *http.Request, params GetSignerParams) {
+	w.Header().Set("Content-Type", "application/json")
+	signers, err := s.Signer.SignerGen.Generate(r.Context())
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "Unable to get signer",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	now := s.now()
+	var rep any
+	if params.All != nil && *params.All {
+		rep = validSigners(signers, now)
+	} else {
+		p, err := trust.LastExpiring(signers, cppki.Validity{
+			NotBefore: now,
+			NotAfter:  now,
+		})
+		if err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "No signer currently valid",
+				Type:   api.StringRef(api.InternalError),
+			})
+			return
+		}
+		signer := signerDescription(p)
+		if next, ok := nextSigner(signers, now); ok {
+			signer.NextSigner = &next
+		}
+		rep = signer
+	}
+	if api.AcceptsCBOR(r) {
+		writeCBOR(w, rep)
+		return
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// validSigners describes the signers that are valid at the given time, sorted
+// by expiration, the soonest to expire first. During a key rollover, both the
+// old and the new signer are valid.
+func validSigners(signers []trust.Signer, now time.Time) []Signer {
+	valid := make([]trust.Signer, 0, len(signers))
+	for _, signer := range signers {
+		if signer.Validity().Contains(now) {
+			valid = append(valid, signer)
+		}
+	}
+	slices.SortStableFunc(valid, signerComparators["expiration"])
+	rep := make([]Signer, 0, len(valid))
+	for _, signer := range valid {
+		rep = append(rep, signerDescription(signer))
+	}
+	return rep
+}
+
+// signerComparators maps the supported sort fields of the signer listing to a
+// comparison function that orders signers ascending by that field.
+var signerComparators = map[string]func(a, b trust.Signer) int{
+	"expiration": func(a, b trust.Signer) int {
+		return a.Expiration.Compare(b.Expiration)
+	},
+	"not_before": func(a, b trust.Signer) int {
+		return a.ChainValidity.NotBefore.Compare(b.ChainValidity.NotBefore)
+	},
+}
+
+// GetSigners lists all signers that are currently generated.
+func (s *Server) GetSigners(w http.ResponseWriter, r *http.Request, params GetSignersParams) {
+	var errs serrors.List
+	by := "expiration:desc"
+	if params.Sort != nil {
+		by = *params.Sort
+	}
+	compare, err := parseSortOrder(by, signerComparators)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if params.Limit != nil && *params.Limit < 0 {
+		errs = append(errs, serrors.New("negative value for parameter", "limit", *params.Limit))
+	}
+	if params.Offset != nil && *params.Offset < 0 {
+		errs = append(errs, serrors.New("negative value for parameter", "offset", *params.Offset))
+	}
+	if err := errs.ToError(); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameters",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	signers, err := s.Signer.SignerGen.Generate(r.Context())
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "Unable to get signers",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	slices.SortStableFunc(signers, compare)
+	if params.Offset != nil {
+		signers = signers[min(*params.Offset, len(signers)):]
+	}
+	if params.Limit != nil {
+		signers = signers[:min(*params.Limit, len(signers))]
+	}
+	rep := make([]Signer, 0, len(signers))
+	for _, signer := range signers {
+		rep = append(rep, signerDescription(signer))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// signerDescription describes the signer in the format of the API.
+func signerDescription(p trust.Signer) Signer {
+	return Signer{
+		AsCertificate: signerCertificate(p),
+		Expiration:    p.Expiration,
+		TrcId: TRCID{ // nolint - name from published API
+			BaseNumber:   int(p.TRCID.Base),
+			Isd:          int(p.TRCID.ISD),
+			SerialNumber: int(p.TRCID.Serial),
+		},
+		TrcInGracePeriod: p.InGrace, // nolint - name from published API
+	}
+}
+
+func signerCertificate(p trust.Signer) Certificate {
+	return Certificate{
+		DistinguishedName: api.DistinguishedName(p.Subject),
+		IsdAs:             p.IA.String(),
+		SubjectKeyAlgo:    p.Algorithm.String(),
+		SubjectKeyId:      fmt.Sprintf("% X", p.SubjectKeyID),
+		Validity: Validity{
+			NotAfter:  p.ChainValidity.NotAfter,
+			NotBefore: p.ChainValidity.NotBefore,
+		},
+	}
+}
+
+// nextSigner returns the signer that becomes active next, i.e., the signer with
+// the earliest start of validity after now. It reports false if there is no
+// such signer.
+func nextSigner(signers []trust.Signer, now time.Time) (NextSigner, bool) {
+	var upcoming []trust.Signer
+	for _, signer := range signers {
+		if signer.ChainValidity.NotBefore.After(now) {
+			upcoming = append(upcoming, signer)
+		}
+	}
+	if len(upcoming) == 0 {
+		return NextSigner{}, false
+	}
+	next := slices.MinFunc(upcoming, signerComparators["not_before"])
+	return NextSigner{
+		Activation:    next.ChainValidity.NotBefore,
+		AsCertificate: signerCertificate(next),
+		Expiration:    next.Expiration,
+		TrcId: TRCID{ // nolint - name from published API
+			BaseNumber:   int(next.TRCID.Base),
+			Isd:          int(next.TRCID.ISD),
+			SerialNumber: int(next.TRCID.Serial),
+		},
+	}, true
+}
+
+// GetSignerStatus summarizes the status of the signer with the same thresholds
+// as the signer health check.
+func (s *Server) GetSignerStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	signers, err := s.Signer.SignerGen.Generate(r.Context())
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "Unable to get signer",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	now := s.now()
+	p, err := trust.LastExpiring(signers, cppki.Validity{
+		NotBefore: now,
+		NotAfter:  now,
+	})
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "No signer currently valid",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	rep := SignerStatus{
+		Ok:        !p.InGrace && p.Expiration.Sub(now) >= s.signerExpiryWarning(),
+		ExpiresAt: p.Expiration,
+		InGrace:   p.InGrace,
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// GetSignerTrust lists the active TRCs that verify the certificate chain of
+// the signer.
+func (s *Server) GetSignerTrust(w http.ResponseWriter, r *http.Request) {
+	if s.TrustDB == nil {
+		ErrorResponse(w, Problem{
+			Status: http.StatusNotImplemented,
+			Title:  "trust database not available",
+			Type:   api.StringRef(api.NotImplemented),
+		})
+		return
+	}
+	signers, err := s.Signer.SignerGen.Generate(r.Context())
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to get signer",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	now := s.now()
+	p, err := trust.LastExpiring(signers, cppki.Validity{
+		NotBefore: now,
+		NotAfter:  now,
+	})
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "no signer currently valid",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	trcs, err := s.activeTRCs(r.Context(), p.IA.ISD(), now)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to get active TRCs",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	rep := SignerTrust{
+		SubjectKeyId: fmt.Sprintf("% X", p.SubjectKeyID),
+		Trcs:         []SignerTrustTRC{},
+	}
+	var tried []string
+	for i, trc := range trcs {
+		tried = append(tried, trc.TRC.ID.String())
+		opts := cppki.VerifyOptions{TRC: []*cppki.TRC{&trc.TRC}, CurrentTime: now}
+		if err := cppki.VerifyChain(p.Chain, opts); err != nil {
+			continue
+		}
+		rep.Trcs = append(rep.Trcs, SignerTrustTRC{
+			Id: TRCID{
+				BaseNumber:   int(trc.TRC.ID.Base),
+				Isd:          int(trc.TRC.ID.ISD),
+				SerialNumber: int(trc.TRC.ID.Serial),
+			},
+			// Only the predecessor of the latest TRC is in grace period.
+			InGrace: i > 0,
+		})
+	}
+	if len(rep.Trcs) == 0 {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(fmt.Sprintf("active TRCs: %s",
+				strings.Join(tried, ", "))),
+			Status: http.StatusInternalServerError,
+			Title:  "no active TRC verifies the signer",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+}
+
+// activeTRCs returns the TRCs of the ISD that can currently be used as trust
+// root. The latest TRC comes first, followed by its predecessor if the latest
+// TRC is in grace period.
+func (s *Server) activeTRCs(
+	ctx context.Context,
+	isd addr.ISD,
+	now time.Time,
+) ([]cppki.SignedTRC, error) {
+
+	latest, err := s.TrustDB.SignedTRC(ctx, cppki.TRCID{
+		ISD:    isd,
+		Base:   scrypto.LatestVer,
+		Serial: scrypto.LatestVer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if latest.IsZero() {
+		return nil, serrors.New("no TRC available", "isd", isd)
+	}
+	if !latest.TRC.Validity.Contains(now) {
+		return nil, serrors.New("latest TRC not valid", "id", latest.TRC.ID,
+			"validity", latest.TRC.Validity)
+	}
+	if !latest.TRC.InGracePeriod(now) {
+		return []cppki.SignedTRC{latest}, nil
+	}
+	grace, err := s.TrustDB.SignedTRC(ctx, cppki.TRCID{
+		ISD:    isd,
+		Base:   latest.TRC.ID.Base,
+		Serial: latest.TRC.ID.Serial - 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if grace.IsZero() {
+		return []cppki.SignedTRC{latest}, nil
+	}
+	return []cppki.SignedTRC{latest, grace}, nil
+}
+
+// GetSignerChain generates a certificate chain blob response encoded as PEM.
+func (s *Server) GetSignerChain(w http.ResponseWriter, r *http.Request) {
+	signers, err := s.Signer.SignerGen.Generate(r.Context())
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to get signer",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	now := s.now()
+	p, err := trust.LastExpiring(signers, cppki.Validity{
+		NotBefore: now,
+		NotAfter:  now,
+	})
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "no signer currently valid",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	var buf bytes.Buffer
+	if len(p.Chain) == 0 {
+		ErrorResponse(w, Problem{
+			Status: http.StatusInternalServerError,
+			Title:  "no certificates available",
 			Type:   api.StringRef(api.InternalError),
 		})
 		return
 	}
+	for _, cert := range p.Chain {
+		if err := pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}); err != nil {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "unable to marshal response",
+				Type:   api.StringRef(api.InternalError),
+			})
+			return
+		}
+	}
 	_, _ = w.Write(buf.Bytes())
 }
 
-// GetSegments gets the stored in the PathDB.
-func (s *Server) GetSegments(w http.ResponseWriter,
-	r *http.Request, params GetSegmentsParams) {
-	p := segapi.GetSegmentsParams{
-		StartIsdAs: params.StartIsdAs,
-		EndIsdAs:   params.EndIsdAs,
+// GetSignerCsr creates a certificate signing request for the subject and the
+// public key of the currently active signer. The request is signed with the
+// private key of the signer, which is not exposed.
+func (s *Server) GetSignerCsr(w http.ResponseWriter, r *http.Request) {
+	signers, err := s.Signer.SignerGen.Generate(r.Context())
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to get signer",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
 	}
-	s.SegmentsServer.GetSegments(w, r, p)
-}
-
-func (s *Server) GetSegment(w http.ResponseWriter, r *http.Request, id SegmentID) {
-	s.SegmentsServer.GetSegment(w, r, id)
-}
-
-func (s *Server) DeleteSegment(w http.ResponseWriter, r *http.Request, id SegmentID) {
-	s.SegmentsServer.DeleteSegment(w, r, id)
+	now := s.now()
+	p, err := trust.LastExpiring(signers, cppki.Validity{
+		NotBefore: now,
+		NotAfter:  now,
+	})
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "no signer currently valid",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	if len(p.Chain) == 0 {
+		ErrorResponse(w, Problem{
+			Status: http.StatusInternalServerError,
+			Title:  "no certificates available",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	// The raw subject of the AS certificate is used, such that the ISD-AS
+	// attribute is preserved as is.
+	csr, err := x509.CreateCertificateRequest(
+		rand.Reader,
+		&x509.CertificateRequest{RawSubject: p.Chain[0].RawSubject},
+		p.PrivateKey,
+	)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to create certificate signing request",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-pem-file")
+	_ = pem.Encode(w, &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})
 }
 
-func (s *Server) GetSegmentBlob(w http.ResponseWriter, r *http.Request, id SegmentID) {
-	s.SegmentsServer.GetSegmentBlob(w, r, id)
+// GetTopology is an indirection to the http handler.
+func (s *Server) GetTopology(w http.ResponseWriter, r *http.Request) {
+	s.Topology(w, r)
 }
 
-// GetCertificates lists the certificate chains.
-func (s *Server) GetCertificates(w http.ResponseWriter,
-	r *http.Request, params GetCertificatesParams) {
-	cppkiParams := cppkiapi.GetCertificatesParams{
-		IsdAs:   params.IsdAs,
-		ValidAt: params.ValidAt,
-		All:     params.All,
+// GetHealth indicates the health of the service. If groups of health checks are
+// selected, only their checks are evaluated and considered for the overall
+// status. If a wait duration is provided, the response is delayed until the
+// status of a health check changes or the duration elapses.
+func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request, params GetHealthParams) {
+	wait, err := parseHealthWait(params.Wait)
+	if err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameters",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	var errs serrors.List
+	var statuses map[Status]bool
+	if params.Status != nil {
+		statuses = make(map[Status]bool, len(*params.Status))
+		for _, status := range *params.Status {
+			switch status {
+			case Passing, Degraded, Failing:
+				statuses[status] = true
+			default:
+				errs = append(errs, serrors.New(
+					"unknown value for parameter",
+					"status",
+					status,
+				))
+			}
+		}
+	}
+	groups, groupErrs := parseHealthGroups(params.Checks)
+	errs = append(errs, groupErrs...)
+	if err := errs.ToError(); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameters",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	rep := s.health(r.Context(), groups)
+	if params.Check != nil {
+		if _, ok := findCheck(rep.Health.Checks, *params.Check); !ok {
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(serrors.New(
+					"unknown health check",
+					"check",
+					*params.Check,
+				).Error()),
+				Status: http.StatusBadRequest,
+				Title:  "malformed query parameters",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		}
+	}
+	if wait > 0 {
+		rep = s.awaitHealthChange(r.Context(), rep, groups, wait)
+	}
+	// The checks are only filtered after waiting, such that the detection of
+	// changes considers all checks. If a single check is requested, the overall
+	// status is derived from that check alone.
+	if params.Check != nil {
+		check, ok := findCheck(rep.Health.Checks, *params.Check)
+		if !ok {
+			// The set of checks is fixed by the configuration, this is only a
+			// safeguard.
+			check = Check{Name: *params.Check, Status: Failing}
+		}
+		rep.Health = Health{Checks: []Check{check}, Status: check.Status}
+	}
+	if statuses != nil {
+		checks := make([]Check, 0, len(rep.Health.Checks))
+		for _, check := range rep.Health.Checks {
+			if statuses[check.Status] {
+				checks = append(checks, check)
+			}
+		}
+		rep.Health.Checks = checks
+	}
+	if acceptsPlainHealth(r) {
+		writePlainHealth(w, rep.Health.Status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
+		ErrorResponse(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
 	}
-	s.CPPKIServer.GetCertificates(w, r, cppkiParams)
-}
-
-// GetCertificate lists the certificate chain for a given ChainID.
-func (s *Server) GetCertificate(w http.ResponseWriter, r *http.Request, chainID ChainID) {
-	s.CPPKIServer.GetCertificate(w, r, chainID)
 }
 
-// GetCertificateBlob generates a certificate chain blob response encoded as PEM
-// for a given chainId.
-func (s *Server) GetCertificateBlob(w http.ResponseWriter, r *http.Request, chainID ChainID) {
-	s.CPPKIServer.GetCertificateBlob(w, r, chainID)
+// findCheck returns the health check with the given name.
+func findCheck(checks []Check, name string) (Check, bool) {
+	for _, check := range checks {
+		if check.Name == name {
+			return check, true
+		}
+	}
+	return Check{}, false
 }
 
-// GetCa gets the CA info.
-func (s *Server) GetCa(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	if s.CA.PolicyGen == nil {
+// GetHealthChanges lists the health checks whose status changed after the
+// requested time. If a wait duration is provided and no check changed, the
+// response is delayed until the status of a health check changes or the
+// duration elapses.
+func (s *Server) GetHealthChanges(
+	w http.ResponseWriter,
+	r *http.Request,
+	params GetHealthChangesParams,
+) {
+	wait, err := parseHealthWait(params.Wait)
+	if err != nil {
 		ErrorResponse(w, Problem{
-			Detail: api.StringRef("This instance is not configured with CA capability"),
-			Status: http.StatusNotImplemented,
-			Title:  "Not a CA",
-			Type:   api.StringRef(api.NotImplemented),
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameters",
+			Type:   api.StringRef(api.BadRequest),
 		})
 		return
 	}
-
-	p, err := s.CA.PolicyGen.Generate(r.Context())
-	if err != nil {
+	// Evaluating the health records the transitions up to now.
+	health := s.health(r.Context(), nil)
+	checks := s.checksChangedSince(health, params.Since)
+	if len(checks) == 0 && wait > 0 {
+		health = s.awaitHealthChange(r.Context(), health, nil, wait)
+		checks = s.checksChangedSince(health, params.Since)
+	}
+	rep := HealthChanges{
+		Timestamp: s.now().UTC(),
+		Checks:    checks,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
 		ErrorResponse(w, Problem{
 			Detail: api.StringRef(err.Error()),
 			Status: http.StatusInternalServerError,
-			Title:  "No active signer",
+			Title:  "unable to marshal response",
 			Type:   api.StringRef(api.InternalError),
 		})
 		return
 	}
-	ia, err := cppki.ExtractIA(p.Certificate.Subject)
+}
+
+// checksChangedSince returns the checks of the health response for which a
+// status transition after the given time is recorded.
+func (s *Server) checksChangedSince(health HealthResponse, since time.Time) []Check {
+	changed := make(map[string]bool)
+	for _, e := range s.healthHistory.list() {
+		if e.Timestamp.After(since) {
+			changed[e.Check] = true
+		}
+	}
+	checks := make([]Check, 0, len(changed))
+	for _, check := range health.Health.Checks {
+		if changed[check.Name] {
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
+
+// parseHealthWait parses the long-poll duration of a health request. If no
+// duration is provided, 0 is returned.
+func parseHealthWait(raw *string) (time.Duration, error) {
+	if raw == nil {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(*raw)
 	if err != nil {
+		return 0, err
+	}
+	if d < 0 || d > maxHealthWait {
+		return 0, serrors.New("wait duration out of range", "wait", d, "max", maxHealthWait)
+	}
+	return d, nil
+}
+
+// GetHealthHistory lists the recorded status transitions of the health checks.
+func (s *Server) GetHealthHistory(w http.ResponseWriter, r *http.Request) {
+	rep := HealthHistory{Events: s.healthHistory.list()}
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
 		ErrorResponse(w, Problem{
 			Detail: api.StringRef(err.Error()),
 			Status: http.StatusInternalServerError,
-			Title:  "Unable to extract ISD-AS",
+			Title:  "unable to marshal response",
 			Type:   api.StringRef(api.InternalError),
 		})
 		return
 	}
-	rep := CA{
-		CertValidity: Validity{
-			NotAfter:  p.Certificate.NotAfter,
-			NotBefore: p.Certificate.NotBefore,
-		},
-		Policy: Policy{
-			ChainLifetime: p.Validity.String(),
-		},
-		Subject: Subject{
-			IsdAs: ia.String(),
-		},
-		SubjectKeyId: fmt.Sprintf("% X", p.Certificate.SubjectKeyId),
+}
+
+const (
+	// maxHealthWait is the longest duration a health request can be held open.
+	maxHealthWait = 5 * time.Minute
+	// defaultHealthPollInterval is the interval at which the health checks are
+	// re-evaluated while a health request is held open.
+	defaultHealthPollInterval = time.Second
+	// defaultSignerExpiryWarning is the remaining validity below which the
+	// signer is considered close to expiration if no threshold is configured.
+	defaultSignerExpiryWarning = 6 * time.Hour
+	// defaultMaxBeaconCount is the number of stored beacons above which the
+	// beacon store is considered too large if no threshold is configured.
+	defaultMaxBeaconCount = 100000
+	// defaultCertificateExpiryWindow is the remaining validity below which the
+	// AS certificates are considered close to expiration if no window is
+	// configured.
+	defaultCertificateExpiryWindow = 72 * time.Hour
+)
+
+// awaitHealthChange re-evaluates the health checks of the groups until the
+// status of any check differs from the initial health, the wait duration
+// elapses, or the context is canceled. It returns the most recently evaluated
+// health.
+func (s *Server) awaitHealthChange(
+	ctx context.Context,
+	initial HealthResponse,
+	groups healthGroups,
+	wait time.Duration,
+) HealthResponse {
+	interval := s.healthPollInterval
+	if interval <= 0 {
+		interval = defaultHealthPollInterval
+	}
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	current := initial
+	for {
+		select {
+		case <-ctx.Done():
+			return current
+		case <-ticker.C:
+			current = s.health(ctx, groups)
+			if healthChanged(initial, current) {
+				return current
+			}
+		}
+	}
+}
+
+// healthChanged reports whether the overall status or the status of any
+// health check differs.
+func healthChanged(a, b HealthResponse) bool {
+	if a.Health.Status != b.Health.Status || len(a.Health.Checks) != len(b.Health.Checks) {
+		return true
+	}
+	for i := range a.Health.Checks {
+		if a.Health.Checks[i].Name != b.Health.Checks[i].Name ||
+			a.Health.Checks[i].Status != b.Health.Checks[i].Status {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStatus summarizes the active signer, the latest TRC of the local ISD, the
+// CA availability and the overall health. All parts are derived from a single
+// snapshot of the health data, such that they are consistent with each other.
+func (s *Server) GetStatus(w http.ResponseWriter, r *http.Request) {
+	snapshot := s.healthSnapshot(r.Context(), nil)
+	rep := ServiceStatus{
+		CaEnabled: s.CA.PolicyGen != nil,
+		Health:    s.evaluateHealth(r.Context(), snapshot).Health,
+	}
+	if s.Signer.SignerGen != nil {
+		if signers, err := s.Signer.SignerGen.Generate(r.Context()); err == nil {
+			now := s.now()
+			p, err := trust.LastExpiring(signers, cppki.Validity{NotBefore: now, NotAfter: now})
+			if err == nil {
+				signer := signerDescription(p)
+				rep.Signer = &signer
+			}
+		}
+	}
+	if !snapshot.trc.TRCNotFound {
+		rep.Trc = &TRCID{
+			BaseNumber:   int(snapshot.trc.TRCID.Base),
+			Isd:          int(snapshot.trc.TRCID.ISD),
+			SerialNumber: int(snapshot.trc.TRCID.Serial),
+		}
 	}
+	if snapshot.caOK {
+		rep.Ca = api.StringRef(string(snapshot.ca))
+	}
+	w.Header().Set("Content-Type", "application/json")
 	enc := json.NewEncoder(w)
 	enc.SetIndent("", "    ")
 	if err := enc.Encode(rep); err != nil {
@@ -586,95 +4295,267 @@ func (s *Server) GetCa(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTrcs gets the trcs specified by it's params.
-func (s *Server) GetTrcs(
-	w http.ResponseWriter,
-	r *http.Request,
-	params GetTrcsParams, // nolint - name from published API
-) {
-	cppkiParams := cppkiapi.GetTrcsParams{ // nolint - name from published API
-		Isd: params.Isd,
-		All: params.All,
+// The groups of health checks that can be selected with the checks parameter
+// of the health endpoint.
+const (
+	// signerHealthGroup contains the signer validity and coverage checks.
+	signerHealthGroup = "signer"
+	// trcHealthGroup contains the check for the TRC of the local ISD.
+	trcHealthGroup = "trc"
+	// caHealthGroup contains the CA connection and consistency checks.
+	caHealthGroup = "ca"
+	// beaconsHealthGroup contains the beacon store size check.
+	beaconsHealthGroup = "beacons"
+	// certificatesHealthGroup contains the AS certificates expiry check.
+	certificatesHealthGroup = "certificates"
+)
+
+// healthGroups is a set of groups of health checks. The nil set contains all
+// groups.
+type healthGroups map[string]bool
+
+func (g healthGroups) has(group string) bool {
+	return g == nil || g[group]
+}
+
+// parseHealthGroups parses the selected groups of health checks. If no groups
+// are selected, nil is returned, i.e., all groups.
+func parseHealthGroups(checks *[]string) (healthGroups, serrors.List) {
+	if checks == nil {
+		return nil, nil
+	}
+	var errs serrors.List
+	groups := make(healthGroups, len(*checks))
+	for _, group := range *checks {
+		switch group {
+		case signerHealthGroup, trcHealthGroup, caHealthGroup, beaconsHealthGroup,
+			certificatesHealthGroup:
+			groups[group] = true
+		default:
+			errs = append(errs, serrors.New(
+				"unknown value for parameter",
+				"checks",
+				group,
+			))
+		}
+	}
+	return groups, errs
+}
+
+// healthSnapshot is the data that the health checks are evaluated on.
+type healthSnapshot struct {
+	// groups are the groups of health checks that are evaluated. If it is
+	// nil, all health checks are evaluated.
+	groups healthGroups
+	signer SignerHealthData
+	trc    TRCHealthData
+	ca     CAHealthStatus
+	caOK   bool
+	// beaconCount is the number of stored beacons. It is only set if the
+	// beacon store is available.
+	beaconCount    int
+	beaconCountErr error
+	beaconCountOK  bool
+	// chains are the currently valid certificate chains. They are only set if
+	// the trust database is available.
+	chains    [][]*x509.Certificate
+	chainsErr error
+	chainsOK  bool
+	// caIA is the subject ISD-AS of the CA certificate. It is only set if the
+	// service acts as CA.
+	caIA    addr.IA
+	caIAErr error
+	caIAOK  bool
+}
+
+// acceptsPlainHealth indicates whether the client negotiated the plain text
+// health status. Requests for text/plain with a version parameter ask for the
+// Prometheus exposition format and are not considered.
+func acceptsPlainHealth(r *http.Request) bool {
+	var plainQ, jsonQ float64
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			rangeType, params, err := mime.ParseMediaType(mediaRange)
+			if err != nil {
+				continue
+			}
+			q := 1.0
+			if raw, ok := params["q"]; ok {
+				if q, err = strconv.ParseFloat(raw, 64); err != nil {
+					continue
+				}
+			}
+			_, versioned := params["version"]
+			switch {
+			case rangeType == "text/plain" && !versioned:
+				plainQ = max(plainQ, q)
+			case rangeType == "application/json":
+				jsonQ = max(jsonQ, q)
+			}
+		}
+	}
+	return plainQ > 0 && plainQ > jsonQ
+}
+
+// writePlainHealth writes the overall health status as a single word. A
+// failing service is reported with status 503, such that clients can rely on
+// the status code alone.
+func writePlainHealth(w http.ResponseWriter, status Status) {
+	word, code := "OK", http.StatusOK
+	switch status {
+	case Degraded:
+		word = "DEGRADED"
+	case Failing:
+		word, code = "FAILING", http.StatusServiceUnavailable
 	}
-	s.CPPKIServer.GetTrcs(w, r, cppkiParams) // nolint - name from published API
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(code)
+	fmt.Fprintln(w, word)
 }
 
-// GetTrc gets the trc specified by it's isd base and serial.
-func (s *Server) GetTrc(w http.ResponseWriter, r *http.Request, isd int, base int, serial int) {
-	s.CPPKIServer.GetTrc(w, r, isd, base, serial) // nolint - name from published API
+// healthSnapshot collects the data of all health checks.
+func (s *Server) healthSnapshot(ctx context.Context, groups healthGroups) healthSnapshot {
+	snapshot := healthSnapshot{groups: groups}
+	// The consistency of the CA is checked against the signer and the TRC.
+	if groups.has(signerHealthGroup) || groups.has(caHealthGroup) {
+		snapshot.signer = s.Healther.GetSignerHealth(ctx)
+	}
+	if groups.has(trcHealthGroup) || groups.has(caHealthGroup) {
+		snapshot.trc = s.Healther.GetTRCHealth(ctx)
+	}
+	if groups.has(caHealthGroup) {
+		snapshot.ca, snapshot.caOK = s.Healther.GetCAHealth(ctx)
+	}
+	if s.Beacons != nil && groups.has(beaconsHealthGroup) {
+		snapshot.beaconCount, snapshot.beaconCountErr = s.countBeacons(ctx)
+		snapshot.beaconCountOK = true
+	}
+	if s.TrustDB != nil && groups.has(certificatesHealthGroup) {
+		snapshot.chains, snapshot.chainsErr = s.validChains(ctx)
+		snapshot.chainsOK = true
+	}
+	if s.CA.PolicyGen != nil && groups.has(caHealthGroup) {
+		snapshot.caIA, snapshot.caIAErr = s.caSubjectIA(ctx)
+		snapshot.caIAOK = true
+	}
+	return snapshot
 }
 
-// GetTrcBlob gets the trc encoded pem blob.
-func (s *Server) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, base int, serial int) {
-	s.CPPKIServer.GetTrcBlob(w, r, isd, base, serial) // nolint - name from published API
+// countBeacons counts the stored beacons. Counting is a full scan of the beacon
+// store, hence the count is cached and the query is bounded by the query
+// timeout.
+func (s *Server) countBeacons(ctx context.Context) (int, error) {
+	return s.beaconCount.get(s.now(), func() (int, error) {
+		ctx, cancel := s.queryContext(ctx)
+		defer cancel()
+		return s.Beacons.CountBeacons(ctx)
+	})
 }
 
-// GetConfig is an indirection to the http handler.
-func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
-	s.Config(w, r)
+// validChains loads the currently valid certificate chains. Loading them parses
+// all chains in the trust database, hence they are cached.
+func (s *Server) validChains(ctx context.Context) ([][]*x509.Certificate, error) {
+	now := s.now()
+	return s.chains.get(now, func() ([][]*x509.Certificate, error) {
+		return s.TrustDB.Chains(ctx, trust.ChainQuery{
+			Validity: cppki.Validity{NotBefore: now, NotAfter: now},
+		})
+	})
 }
 
-// GetInfo is an indirection to the http handler.
-func (s *Server) GetInfo(w http.ResponseWriter, r *http.Request) {
-	s.Info(w, r)
+// caSubjectIA extracts the ISD-AS from the subject of the current CA
+// certificate.
+func (s *Server) caSubjectIA(ctx context.Context) (addr.IA, error) {
+	p, err := s.CA.PolicyGen.Generate(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if p.Certificate == nil {
+		return 0, serrors.New("no CA certificate")
+	}
+	return cppki.ExtractIA(p.Certificate.Subject)
 }
 
-// GetLogLevel is an indirection to the http handler.
-func (s *Server) GetLogLevel(w http.ResponseWriter, r *http.Request) {
-	s.LogLevel(w, r)
+// signerExpiryWarning returns the remaining validity below which the signer is
+// considered close to expiration.
+func (s *Server) signerExpiryWarning() time.Duration {
+	if s.SignerExpiryWarning == 0 {
+		return defaultSignerExpiryWarning
+	}
+	return s.SignerExpiryWarning
 }
 
-// SetLogLevel is an indirection to the http handler.
-func (s *Server) SetLogLevel(w http.ResponseWriter, r *http.Request) {
-	s.LogLevel(w, r)
+// health evaluates the health checks of the groups. If groups is nil, all
+// health checks of the service are evaluated.
+func (s *Server) health(ctx context.Context, groups healthGroups) HealthResponse {
+	return s.evaluateHealth(ctx, s.healthSnapshot(ctx, groups))
 }
 
-// GetSigner generates the singer response content.
-func (s *Server) GetSigner(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	signers, err := s.Signer.SignerGen.Generate(r.Context())
-	if err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "Unable to get signer",
-			Type:   api.StringRef(api.InternalError),
-		})
-		return
+// evaluateHealth evaluates the health checks on the snapshot and records the
+// status transitions in the health history.
+func (s *Server) evaluateHealth(ctx context.Context, snapshot healthSnapshot) HealthResponse {
+	var checks []Check
+	if snapshot.groups.has(signerHealthGroup) {
+		checks = append(checks, s.signerChecks(ctx, snapshot.signer)...)
 	}
-	now := s.now()
-	p, err := trust.LastExpiring(signers, cppki.Validity{
-		NotBefore: now,
-		NotAfter:  now,
-	})
-	if err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "No signer currently valid",
-			Type:   api.StringRef(api.InternalError),
-		})
-		return
+	if snapshot.groups.has(trcHealthGroup) {
+		checks = append(checks, trcCheck(snapshot.trc))
 	}
-	rep := Signer{
-		AsCertificate: Certificate{
-			DistinguishedName: p.Subject.String(),
-			IsdAs:             p.IA.String(),
-			SubjectKeyAlgo:    p.Algorithm.String(),
-			SubjectKeyId:      fmt.Sprintf("% X", p.SubjectKeyID),
-			Validity: Validity{
-				NotAfter:  p.ChainValidity.NotAfter,
-				NotBefore: p.ChainValidity.NotBefore,
-			},
+	if snapshot.caOK {
+		status := snapshot.ca
+		caCheck := Check{
+			Status: Degraded,
+			Name:   "CPPKI CA Connection",
+		}
+		if status == Available {
+			caCheck.Status = Passing
+		}
+		caCheck.Data = CheckData{
+			"status": status,
+		}
+		checks = append(checks, caCheck)
+	}
+	if snapshot.caIAOK {
+		checks = append(checks, caSignerCheck(snapshot))
+	}
+	if snapshot.beaconCountOK {
+		checks = append(checks, s.beaconCountCheck(snapshot.beaconCount, snapshot.beaconCountErr))
+	}
+	if snapshot.chainsOK {
+		checks = append(checks, s.certificateExpiryCheck(snapshot.chains, snapshot.chainsErr))
+	}
+	size := s.HealthHistorySize
+	if size <= 0 {
+		size = defaultHealthHistorySize
+	}
+	s.healthHistory.record(s.now(), checks, size)
+	return HealthResponse{
+		Health: Health{
+			Status: Status(healthapi.AggregateHealthStatus(
+				func() []healthapi.Status {
+					statuses := make([]healthapi.Status, 0, len(checks))
+					for _, c := range checks {
+						statuses = append(statuses, healthapi.Status(c.Status))
+					}
+					return statuses
+				}()),
+			),
+			Checks: checks,
 		},
-		Expiration: p.Expiration,
-		TrcId: TRCID{ // nolint - name from published API
-			BaseNumber:   int(p.TRCID.Base),
-			Isd:          int(p.TRCID.ISD),
-			SerialNumber: int(p.TRCID.Serial),
+	}
+}
+
+// GetLiveness indicates that the service is alive. It succeeds as long as the
+// management API is served, and does not evaluate any health checks, such that
+// it is cheap enough to be probed frequently.
+func (s *Server) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	rep := HealthResponse{
+		Health: Health{
+			Status: Passing,
+			Checks: []Check{},
 		},
-		TrcInGracePeriod: p.InGrace, // nolint - name from published API
 	}
+	w.Header().Set("Content-Type", "application/json")
 	enc := json.NewEncoder(w)
 	enc.SetIndent("", "    ")
 	if err := enc.Encode(rep); err != nil {
@@ -688,65 +4569,53 @@ func (s *Server) GetSigner(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetSignerChain generates a certificate chain blob response encoded as PEM.
-func (s *Server) GetSignerChain(w http.ResponseWriter, r *http.Request) {
-	signers, err := s.Signer.SignerGen.Generate(r.Context())
+// GetReadiness indicates whether the service is ready to serve requests. The
+// service is not ready as long as no TRC for the local ISD is available.
+func (s *Server) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	check := trcCheck(s.Healther.GetTRCHealth(r.Context()))
+	rep := HealthResponse{
+		Health: Health{
+			Status: check.Status,
+			Checks: []Check{check},
+		},
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if check.Status != Passing {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	// no point in catching error here, the status code has already been written.
+	_ = enc.Encode(rep)
+}
+
+// verifySignerChain verifies the signer certificate chain against the active
+// TRCs of the ISD of the signer. It returns nil if any of them verifies the
+// chain.
+func (s *Server) verifySignerChain(ctx context.Context, chain []*x509.Certificate) error {
+	ia, err := cppki.ExtractIA(chain[0].Subject)
 	if err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "unable to get signer",
-			Type:   api.StringRef(api.InternalError),
-		})
-		return
+		return err
 	}
 	now := s.now()
-	p, err := trust.LastExpiring(signers, cppki.Validity{
-		NotBefore: now,
-		NotAfter:  now,
-	})
+	trcs, err := s.activeTRCs(ctx, ia.ISD(), now)
 	if err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "no signer currently valid",
-			Type:   api.StringRef(api.InternalError),
-		})
-		return
-	}
-	var buf bytes.Buffer
-	if len(p.Chain) == 0 {
-		ErrorResponse(w, Problem{
-			Status: http.StatusInternalServerError,
-			Title:  "no certificates available",
-			Type:   api.StringRef(api.InternalError),
-		})
-		return
+		return err
 	}
-	for _, cert := range p.Chain {
-		if err := pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}); err != nil {
-			ErrorResponse(w, Problem{
-				Detail: api.StringRef(err.Error()),
-				Status: http.StatusInternalServerError,
-				Title:  "unable to marshal response",
-				Type:   api.StringRef(api.InternalError),
-			})
-			return
+	var errs serrors.List
+	for _, trc := range trcs {
+		opts := cppki.VerifyOptions{TRC: []*cppki.TRC{&trc.TRC}, CurrentTime: now}
+		if err := cppki.VerifyChain(chain, opts); err != nil {
+			errs = append(errs, serrors.Wrap("verifying chain", err, "trc", trc.TRC.ID))
+			continue
 		}
+		return nil
 	}
-	_, _ = w.Write(buf.Bytes())
-}
-
-// GetTopology is an indirection to the http handler.
-func (s *Server) GetTopology(w http.ResponseWriter, r *http.Request) {
-	s.Topology(w, r)
+	return errs.ToError()
 }
 
-func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
-
-	var checks []Check
-
-	signerHealth := s.Healther.GetSignerHealth(r.Context())
+// signerChecks evaluates the health checks of the signer.
+func (s *Server) signerChecks(ctx context.Context, signerHealth SignerHealthData) []Check {
 	signerCheck := Check{
 		Status: Passing,
 		Name:   "valid signer available",
@@ -771,7 +4640,7 @@ func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
 		}
 		signerCheck.Detail = api.StringRef(`signer certificate is authenticated
 		by TRC in grace period`)
-	case time.Until(signerHealth.Expiration) < 6*time.Hour:
+	case time.Until(signerHealth.Expiration) < s.signerExpiryWarning():
 		signerCheck.Status = Degraded
 		signerCheck.Data = CheckData{
 			"expires_at": signerHealth.Expiration.Format(time.RFC3339),
@@ -782,13 +4651,224 @@ func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
 			"expires_at": signerHealth.Expiration.Format(time.RFC3339),
 		}
 	}
-	checks = append(checks, signerCheck)
+	if signerCheck.Status != Failing && s.TrustDB != nil && len(signerHealth.Chain) != 0 {
+		if err := s.verifySignerChain(ctx, signerHealth.Chain); err != nil {
+			signerCheck.Status = Failing
+			signerCheck.Detail = api.StringRef(
+				"signer certificate chain is not verified by an active TRC: " + err.Error(),
+			)
+		}
+	}
+	checks := []Check{signerCheck}
+	if len(signerHealth.Validities) > 0 {
+		checks = append(checks, s.signerCoverageCheck(signerHealth.Validities))
+	}
+	return checks
+}
+
+// caSignerCheck checks that the CA and the beaconing signer are consistent,
+// i.e., the CA certificate is issued to the local ISD and to the same AS as
+// the certificate of the active signer. Values that are not known are not
+// compared.
+func caSignerCheck(snapshot healthSnapshot) Check {
+	check := Check{
+		Status: Passing,
+		Name:   "CA and signer consistency",
+		Data:   CheckData{},
+	}
+	if snapshot.caIAErr != nil {
+		check.Status = Degraded
+		check.Detail = api.StringRef(
+			"unable to determine CA subject: " + snapshot.caIAErr.Error(),
+		)
+		return check
+	}
+	caIA := snapshot.caIA
+	check.Data["ca_isd_as"] = caIA.String()
+	var mismatches []string
+	if !snapshot.trc.TRCNotFound {
+		localISD := snapshot.trc.TRCID.ISD
+		check.Data["local_isd"] = localISD
+		if caIA.ISD() != localISD {
+			mismatches = append(mismatches, fmt.Sprintf(
+				"CA ISD %d differs from local ISD %d", caIA.ISD(), localISD))
+		}
+	}
+	if chain := snapshot.signer.Chain; len(chain) != 0 {
+		if signerIA, err := cppki.ExtractIA(chain[0].Subject); err == nil {
+			check.Data["signer_isd_as"] = signerIA.String()
+			if signerIA != caIA {
+				mismatches = append(mismatches, fmt.Sprintf(
+					"CA subject %s differs from signer subject %s", caIA, signerIA))
+			}
+		}
+	}
+	if len(mismatches) != 0 {
+		check.Status = Failing
+		check.Detail = api.StringRef(strings.Join(mismatches, "; "))
+	}
+	return check
+}
+
+// signerCoverageCheck checks that the signer validity periods cover the
+// interval between now and the farthest signer expiration without gaps. A gap
+// that starts now is failing, a gap in the future is degraded.
+func (s *Server) signerCoverageCheck(validities []cppki.Validity) Check {
+	now := s.now()
+	gaps := validityGaps(now, validities)
+	check := Check{
+		Status: Passing,
+		Name:   "signer validity coverage",
+	}
+	if len(gaps) == 0 {
+		var coveredUntil time.Time
+		for _, v := range validities {
+			if v.NotAfter.After(coveredUntil) {
+				coveredUntil = v.NotAfter
+			}
+		}
+		check.Data = CheckData{
+			"covered_until": coveredUntil.UTC().Format(time.RFC3339),
+		}
+		if !coveredUntil.After(now) {
+			check.Status = Failing
+			check.Detail = api.StringRef("no signer is currently valid")
+		}
+		return check
+	}
+	check.Status = Degraded
+	check.Detail = api.StringRef("signer validity periods leave intervals uncovered")
+	if !gaps[0].NotBefore.After(now) {
+		check.Status = Failing
+		check.Detail = api.StringRef("no signer is currently valid")
+	}
+	data := make([]map[string]string, 0, len(gaps))
+	for _, gap := range gaps {
+		data = append(data, map[string]string{
+			"not_before": gap.NotBefore.UTC().Format(time.RFC3339),
+			"not_after":  gap.NotAfter.UTC().Format(time.RFC3339),
+		})
+	}
+	check.Data = CheckData{
+		"gaps": data,
+	}
+	return check
+}
+
+// validityGaps returns the intervals between now and the farthest NotAfter
+// that are not covered by any of the validity periods.
+func validityGaps(now time.Time, validities []cppki.Validity) []cppki.Validity {
+	sorted := slices.Clone(validities)
+	slices.SortFunc(sorted, func(a, b cppki.Validity) int {
+		return a.NotBefore.Compare(b.NotBefore)
+	})
+	var gaps []cppki.Validity
+	covered := now
+	for _, v := range sorted {
+		if !v.NotAfter.After(covered) {
+			continue
+		}
+		if v.NotBefore.After(covered) {
+			gaps = append(gaps, cppki.Validity{NotBefore: covered, NotAfter: v.NotBefore})
+		}
+		covered = v.NotAfter
+	}
+	return gaps
+}
+
+// beaconCountCheck checks that the number of stored beacons does not exceed
+// the configured threshold.
+func (s *Server) beaconCountCheck(count int, err error) Check {
+	limit := s.MaxBeaconCount
+	if limit <= 0 {
+		limit = defaultMaxBeaconCount
+	}
+	check := Check{
+		Status: Passing,
+		Name:   "beacon store size",
+	}
+	if err != nil {
+		check.Status = Degraded
+		check.Detail = api.StringRef("unable to count beacons: " + err.Error())
+		return check
+	}
+	check.Data = CheckData{
+		"beacons":     count,
+		"max_beacons": limit,
+	}
+	if count > limit {
+		check.Status = Degraded
+		check.Detail = api.StringRef(
+			"beacon store exceeds the expected size, expired beacons might not be cleaned up",
+		)
+	}
+	return check
+}
+
+// certificateExpiryCheck reports the ASes whose latest AS certificate expires
+// within the expiry window. Older chains of an AS are ignored, because they
+// are expected to expire once they are superseded.
+func (s *Server) certificateExpiryCheck(chains [][]*x509.Certificate, err error) Check {
+	window := s.CertificateExpiryWindow
+	if window <= 0 {
+		window = defaultCertificateExpiryWindow
+	}
+	check := Check{
+		Status: Passing,
+		Name:   "AS certificates expiry",
+	}
+	if err != nil {
+		check.Status = Degraded
+		check.Detail = api.StringRef("unable to load certificate chains: " + err.Error())
+		return check
+	}
+	latest := make(map[addr.IA]*x509.Certificate)
+	for _, chain := range chains {
+		if len(chain) == 0 {
+			continue
+		}
+		ia, err := cppki.ExtractIA(chain[0].Subject)
+		if err != nil {
+			continue
+		}
+		if cur, ok := latest[ia]; !ok || chain[0].NotAfter.After(cur.NotAfter) {
+			latest[ia] = chain[0]
+		}
+	}
+	deadline := s.now().Add(window)
+	var expiring int
+	var soonest *x509.Certificate
+	var soonestIA addr.IA
+	for ia, cert := range latest {
+		if !cert.NotAfter.Before(deadline) {
+			continue
+		}
+		expiring++
+		if soonest == nil || cert.NotAfter.Before(soonest.NotAfter) ||
+			(cert.NotAfter.Equal(soonest.NotAfter) && ia.String() < soonestIA.String()) {
+			soonest, soonestIA = cert, ia
+		}
+	}
+	check.Data = CheckData{
+		"expiring": expiring,
+		"window":   window.String(),
+	}
+	if expiring > 0 {
+		check.Status = Degraded
+		check.Data["soonest_expiry"] = soonest.NotAfter.UTC().Format(time.RFC3339)
+		check.Data["soonest_isd_as"] = soonestIA.String()
+		check.Detail = api.StringRef(fmt.Sprintf(
+			"%d AS certificates expire within %s", expiring, window,
+		))
+	}
+	return check
+}
 
+func trcCheck(trcHealthData TRCHealthData) Check {
 	trcCheck := Check{
 		Status: Failing,
 		Name:   "TRC for local ISD available",
 	}
-	trcHealthData := s.Healther.GetTRCHealth(r.Context())
 	if trcHealthData.TRCNotFoundDetail != "" {
 		trcCheck.Detail = api.StringRef(trcHealthData.TRCNotFoundDetail)
 	}
@@ -800,46 +4880,7 @@ func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
 			"isd":           trcHealthData.TRCID.ISD,
 		}
 	}
-	checks = append(checks, trcCheck)
-
-	if status, ok := s.Healther.GetCAHealth(r.Context()); ok {
-		caCheck := Check{
-			Status: Degraded,
-			Name:   "CPPKI CA Connection",
-		}
-		if status == Available {
-			caCheck.Status = Passing
-		}
-		caCheck.Data = CheckData{
-			"status": status,
-		}
-		checks = append(checks, caCheck)
-	}
-	rep := HealthResponse{
-		Health: Health{
-			Status: Status(healthapi.AggregateHealthStatus(
-				func() []healthapi.Status {
-					statuses := make([]healthapi.Status, 0, len(checks))
-					for _, c := range checks {
-						statuses = append(statuses, healthapi.Status(c.Status))
-					}
-					return statuses
-				}()),
-			),
-			Checks: checks,
-		},
-	}
-	enc := json.NewEncoder(w)
-	enc.SetIndent("", "    ")
-	if err := enc.Encode(rep); err != nil {
-		ErrorResponse(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "unable to marshal response",
-			Type:   api.StringRef(api.InternalError),
-		})
-		return
-	}
+	return trcCheck
 }
 
 func (s *Server) now() time.Time {
@@ -15,14 +15,23 @@
 package mgmtapi_test
 
 import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -30,6 +39,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	beaconlib "github.com/scionproto/scion/control/beacon"
 	api "github.com/scionproto/scion/control/mgmtapi"
@@ -37,15 +47,28 @@ import (
 	cstrust "github.com/scionproto/scion/control/trust"
 	"github.com/scionproto/scion/control/trust/mock_trust"
 	"github.com/scionproto/scion/pkg/addr"
+	"github.com/scionproto/scion/pkg/private/ptr"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/private/xtest"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	"github.com/scionproto/scion/pkg/scrypto/signed"
 	seg "github.com/scionproto/scion/pkg/segment"
+	"github.com/scionproto/scion/pkg/segment/extensions/staticinfo"
+	"github.com/scionproto/scion/pkg/segment/iface"
+	"github.com/scionproto/scion/private/app/command"
 	"github.com/scionproto/scion/private/ca/renewal"
 	"github.com/scionproto/scion/private/ca/renewal/mock_renewal"
+	"github.com/scionproto/scion/private/mgmtapi"
+	segapi "github.com/scionproto/scion/private/mgmtapi/segments/api"
+	"github.com/scionproto/scion/private/mgmtapi/segments/api/mock_api"
+	"github.com/scionproto/scion/private/pathdb/query"
 	"github.com/scionproto/scion/private/storage/beacon"
+	"github.com/scionproto/scion/private/storage/mock_storage"
+	truststorage "github.com/scionproto/scion/private/storage/trust"
+	"github.com/scionproto/scion/private/topology"
 	"github.com/scionproto/scion/private/trust"
+	"github.com/scionproto/scion/scion-pki/testcrypto"
 )
 
 var update = xtest.UpdateGoldenFiles()
@@ -58,6 +81,8 @@ func TestAPI(t *testing.T) {
 	testCases := map[string]struct {
 		Handler            func(t *testing.T, ctrl *gomock.Controller) http.Handler
 		RequestURL         string
+		Method             string
+		Body               string
 		Status             int
 		IgnoreResponseBody bool
 		TimestampOffset    time.Duration
@@ -77,26 +102,46 @@ func TestAPI(t *testing.T) {
 			RequestURL: "/beacons",
 			Status:     200,
 		},
-		"beacons non-existing sort": {
+		"beacons dedupe hops": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
 					Beacons: bs,
 				}
+				fresher := beacons[0]
+				fresher.LastUpdated = fresher.LastUpdated.Add(time.Hour)
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
 					matchQuery(&beacon.QueryParams{}),
-				).Times(0).Return(beacons, nil)
+				).Times(1).Return([]beacon.Beacon{beacons[0], beacons[1], fresher}, nil)
 				return api.Handler(s)
 			},
-			RequestURL: "/beacons?sort=invalid",
-			Status:     400,
+			RequestURL: "/beacons?dedupe=hops",
+			Status:     200,
 		},
-		"beacons sort by ingress interface": {
+		"beacons names": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
 					Beacons: bs,
+					// Only the link of the first beacon is resolved. The
+					// remote interface of the second link is unknown.
+					Interfaces: func() map[iface.ID]topology.IFInfo {
+						return map[iface.ID]topology.IFInfo{
+							2: {
+								ID:         2,
+								BRName:     "br1-ff00_0_110-1",
+								IA:         addr.MustParseIA("1-ff00:0:111"),
+								RemoteIfID: 3,
+								LinkType:   topology.Child,
+							},
+							1: {
+								ID:       1,
+								IA:       addr.MustParseIA("3-ff00:0:330"),
+								LinkType: topology.Core,
+							},
+						}
+					},
 				}
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
@@ -104,40 +149,79 @@ func TestAPI(t *testing.T) {
 				).Times(1).Return(beacons, nil)
 				return api.Handler(s)
 			},
-			RequestURL: "/beacons?sort=ingress_interface",
+			RequestURL: "/beacons?names=true",
 			Status:     200,
 		},
-		"beacons descending order": {
+		"beacons snapshot malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons?snapshot=invalid!",
+			Status:     400,
+		},
+		"beacons snapshot future": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
+				return api.Handler(s)
+			},
+			// Snapshot token of 2100-01-01.
+			RequestURL: "/beacons?snapshot=OO7Pz1amAAA",
+			Status:     400,
+		},
+		"beacons dedupe unknown": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons?dedupe=interfaces",
+			Status:     400,
+		},
+		"beacons min mtu": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
 					Beacons: bs,
 				}
+				// The path MTU of the first beacon is limited by the MTU of
+				// its link, the one of the second beacon by its AS entries.
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
 					matchQuery(&beacon.QueryParams{}),
-				).Times(1).Return(beacons, nil)
+				).Times(1).Return([]beacon.Beacon{
+					withMTU(beacons[0], 1500),
+					withMTU(beacons[1], 1400),
+				}, nil)
 				return api.Handler(s)
 			},
-			RequestURL: "/beacons?desc=true",
+			RequestURL: "/beacons?min_mtu=1300",
 			Status:     200,
 		},
-		"beacons non-existing usages": {
+		"beacons min mtu negative": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
 					Beacons: bs,
 				}
-				bs.EXPECT().GetBeacons(
-					gomock.Any(),
-					gomock.Any(),
-				).Times(0).Return(beacons, nil)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
 				return api.Handler(s)
 			},
-			RequestURL: "/beacons?usages=up_registration&usages=Invalid",
+			RequestURL: "/beacons?min_mtu=-1",
 			Status:     400,
 		},
-		"beacons existing usages": {
+		"beacons non-existing sort": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
@@ -145,16 +229,14 @@ func TestAPI(t *testing.T) {
 				}
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
-					matchQuery(&beacon.QueryParams{
-						Usages: []beaconlib.Usage{beaconlib.UsageDownReg | beaconlib.UsageUpReg},
-					}),
-				).Times(1).Return(beacons[:1], nil)
+					matchQuery(&beacon.QueryParams{}),
+				).Times(0).Return(beacons, nil)
 				return api.Handler(s)
 			},
-			RequestURL: "/beacons?usages=up_registration&usages=down_registration",
-			Status:     200,
+			RequestURL: "/beacons?sort=invalid",
+			Status:     400,
 		},
-		"beacon": {
+		"beacons sort by ingress interface": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
@@ -162,14 +244,14 @@ func TestAPI(t *testing.T) {
 				}
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
-					&beacon.QueryParams{SegIDs: [][]byte{beacons[0].Beacon.Segment.ID()}},
-				).AnyTimes().Return(beacons[:1], nil)
+					matchQuery(&beacon.QueryParams{}),
+				).Times(1).Return(beacons, nil)
 				return api.Handler(s)
 			},
-			RequestURL: "/beacons/" + hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
+			RequestURL: "/beacons?sort=ingress_interface",
 			Status:     200,
 		},
-		"beacon id prefix": {
+		"beacons sort by multiple keys": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
@@ -177,14 +259,14 @@ func TestAPI(t *testing.T) {
 				}
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
-					&beacon.QueryParams{SegIDs: [][]byte{beacons[0].Beacon.Segment.ID()[:10]}},
-				).AnyTimes().Return(beacons[:1], nil)
+					matchQuery(&beacon.QueryParams{}),
+				).Times(1).Return(beacons, nil)
 				return api.Handler(s)
 			},
-			RequestURL: "/beacons/" + hex.EncodeToString(beacons[0].Beacon.Segment.ID()[:10]),
+			RequestURL: "/beacons?sort=start_isd_as:desc,expiration:asc",
 			Status:     200,
 		},
-		"beacon no matches": {
+		"beacons invalid sort direction": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
@@ -192,14 +274,14 @@ func TestAPI(t *testing.T) {
 				}
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
-					&beacon.QueryParams{SegIDs: [][]byte{[]byte("1234")}},
-				).AnyTimes().Return([]beacon.Beacon{}, nil)
+					gomock.Any(),
+				).Times(0)
 				return api.Handler(s)
 			},
-			RequestURL: "/beacons/" + hex.EncodeToString([]byte("1234")),
+			RequestURL: "/beacons?sort=expiration:up",
 			Status:     400,
 		},
-		"beacon no unique match": {
+		"beacons descending order": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
@@ -207,14 +289,14 @@ func TestAPI(t *testing.T) {
 				}
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
-					&beacon.QueryParams{SegIDs: [][]byte{[]byte("1234")}},
-				).AnyTimes().Return(beacons, nil)
+					matchQuery(&beacon.QueryParams{}),
+				).Times(1).Return(beacons, nil)
 				return api.Handler(s)
 			},
-			RequestURL: "/beacons/" + hex.EncodeToString([]byte("1234")),
-			Status:     400,
+			RequestURL: "/beacons?desc=true",
+			Status:     200,
 		},
-		"beacon blob": {
+		"beacons signed with": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
@@ -222,50 +304,51 @@ func TestAPI(t *testing.T) {
 				}
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
-					&beacon.QueryParams{SegIDs: [][]byte{beacons[0].Beacon.Segment.ID()}},
-				).AnyTimes().Return(beacons[:1], nil)
+					matchQuery(&beacon.QueryParams{}),
+				).Times(1).Return(signBeacons(t, beacons[:1]), nil)
 				return api.Handler(s)
 			},
-			RequestURL: fmt.Sprintf(
-				"/beacons/%s/blob",
-				hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
-			),
-			Status: 200,
+			RequestURL: "/beacons?signed_with=ecdsa-sha256",
+			Status:     200,
 		},
-		"beacon id prefix blob": {
+		"beacons crypto agile": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Beacons: bs,
+					Beacons:               bs,
+					CryptoAgileAlgorithms: []signed.SignatureAlgorithm{signed.ECDSAWithSHA256},
 				}
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
-					&beacon.QueryParams{SegIDs: [][]byte{beacons[0].Beacon.Segment.ID()[:10]}},
-				).AnyTimes().Return(beacons[:1], nil)
+					matchQuery(&beacon.QueryParams{}),
+				).Times(1).Return(signBeacons(t, beacons[:1]), nil)
 				return api.Handler(s)
 			},
-			RequestURL: fmt.Sprintf(
-				"/beacons/%s/blob",
-				hex.EncodeToString(beacons[0].Beacon.Segment.ID()[:10]),
-			),
-			Status: 200,
+			RequestURL: "/beacons",
+			Status:     200,
 		},
-		"beacon no matches blob": {
+		"beacons exceeding maximum hops": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Beacons: bs,
+					Beacons:       bs,
+					MaxBeaconHops: 1,
+				}
+				short := beacons[1]
+				short.Beacon.Segment = &seg.PathSegment{
+					Info:      short.Beacon.Segment.Info,
+					ASEntries: short.Beacon.Segment.ASEntries[:1],
 				}
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
-					&beacon.QueryParams{SegIDs: [][]byte{[]byte("1234")}},
-				).AnyTimes().Return([]beacon.Beacon{}, nil)
+					matchQuery(&beacon.QueryParams{}),
+				).Times(1).Return([]beacon.Beacon{beacons[0], short}, nil)
 				return api.Handler(s)
 			},
-			RequestURL: fmt.Sprintf("/beacons/%s/blob", hex.EncodeToString([]byte("1234"))),
-			Status:     400,
+			RequestURL: "/beacons",
+			Status:     200,
 		},
-		"beacon no unique match blob": {
+		"beacons unknown signature algorithm": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
@@ -273,640 +356,4702 @@ func TestAPI(t *testing.T) {
 				}
 				bs.EXPECT().GetBeacons(
 					gomock.Any(),
-					&beacon.QueryParams{SegIDs: [][]byte{[]byte("1234")}},
-				).AnyTimes().Return(beacons, nil)
+					gomock.Any(),
+				).Times(0)
 				return api.Handler(s)
 			},
-			RequestURL: fmt.Sprintf("/beacons/%s/blob", hex.EncodeToString([]byte("1234"))),
+			RequestURL: "/beacons?signed_with=RSA",
 			Status:     400,
 		},
-		"signer": {
+		"beacons start isd as wildcard": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				g := mock_trust.NewMockSignerGen(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Signer: cstrust.RenewingSigner{
-						SignerGen: g,
-					},
+					Beacons: bs,
 				}
-				notBefore := time.Unix(1611051121, 0).UTC()
-				notAfter := time.Unix(1611061121, 0).UTC()
-				now := notBefore.Add(time.Minute)
-				s.SetNowProvider(func() time.Time { return now })
-				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
-					[]trust.Signer{{
-						IA:        addr.MustParseIA("1-ff00:0:110"),
-						Algorithm: signed.ECDSAWithSHA512,
-						Subject: pkix.Name{
-							Country:    []string{"CH"},
-							CommonName: "1-ff00:0:110 AS Certificate",
-						},
-						SubjectKeyID: []byte("лучший учитель"),
-						TRCID: cppki.TRCID{
-							ISD:    1,
-							Serial: 42,
-							Base:   1,
-						},
-						Expiration: notAfter,
-						ChainValidity: cppki.Validity{
-							NotBefore: notBefore,
-							NotAfter:  notAfter,
-						},
-						InGrace: true,
-					}}, nil,
-				)
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					matchQuery(&beacon.QueryParams{}),
+				).Times(1).Return(beacons, nil)
 				return api.Handler(s)
 			},
-			RequestURL: "/signer",
+			RequestURL: "/beacons?start_isd_as=1-FF00:0:1*",
 			Status:     200,
 		},
-		"signer error": {
+		"beacons malformed start isd as wildcard": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				g := mock_trust.NewMockSignerGen(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Signer: cstrust.RenewingSigner{
-						SignerGen: g,
-					},
+					Beacons: bs,
 				}
-				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
-					nil, serrors.New("internal"),
-				)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
 				return api.Handler(s)
 			},
-			RequestURL: "/signer",
-			Status:     500,
+			RequestURL: "/beacons?start_isd_as=1-ff00:*:110",
+			Status:     400,
 		},
-		"signer blob": {
+		"beacons start isd": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				g := mock_trust.NewMockSignerGen(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Signer: cstrust.RenewingSigner{
-						SignerGen: g,
-					},
+					Beacons: bs,
 				}
-				validCert, _ := cppki.ReadPEMCerts(filepath.Join("testdata", "signer-chain.crt"))
-				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
-					[]trust.Signer{{
-						Chain:      validCert,
-						Expiration: time.Now().Add(time.Hour),
-						ChainValidity: cppki.Validity{
-							NotBefore: time.Now(),
-							NotAfter:  time.Now().Add(time.Hour),
-						},
-					}}, nil,
-				)
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					matchQuery(&beacon.QueryParams{
+						StartsAt: []addr.IA{addr.MustParseIA("1-0")},
+					}),
+				).Times(1).Return(beacons, nil)
 				return api.Handler(s)
 			},
-			RequestURL: "/signer/blob",
+			RequestURL: "/beacons?start_isd=1",
 			Status:     200,
 		},
-		"signer blob error": {
+		"beacons start isd and start isd as": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				g := mock_trust.NewMockSignerGen(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Signer: cstrust.RenewingSigner{
-						SignerGen: g,
-					},
+					Beacons: bs,
 				}
-				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
-					[]trust.Signer{{}}, nil,
-				)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
 				return api.Handler(s)
 			},
-			RequestURL: "/signer/blob",
-			Status:     500,
+			RequestURL: "/beacons?start_isd=1&start_isd_as=1-ff00:0:110",
+			Status:     400,
 		},
-		"ca": {
+		"beacons start isd out of range": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				g := mock_renewal.NewMockPolicyGen(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					CA: renewal.ChainBuilder{
-						PolicyGen: g,
-					},
+					Beacons: bs,
 				}
-				validCert, _ := cppki.ReadPEMCerts(filepath.Join("testdata", "cp-ca.crt"))
-				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
-					cppki.CAPolicy{
-						Validity:    3 * 24 * time.Hour,
-						Certificate: validCert[0],
-					}, nil,
-				)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
 				return api.Handler(s)
 			},
-			RequestURL: "/ca",
-			Status:     200,
+			RequestURL: "/beacons?start_isd=0",
+			Status:     400,
 		},
-		"ca error (empty certificate)": {
+		"beacons explain": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				g := mock_renewal.NewMockPolicyGen(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					CA: renewal.ChainBuilder{
-						PolicyGen: g,
-					},
+					Beacons: bs,
 				}
-				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
-					cppki.CAPolicy{
-						Validity:    3 * 24 * time.Hour,
-						Certificate: &x509.Certificate{},
-						CurrentTime: time.Now(),
-					}, nil,
-				)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
 				return api.Handler(s)
 			},
-			RequestURL: "/ca",
-			Status:     500,
+			RequestURL: "/beacons?explain=true&start_isd_as=1-0&usages=up_registration" +
+				"&usages=down_registration&ingress_interface=2" +
+				"&valid_at=2021-11-25T12:20:50Z&sort=expiration:desc&signed_with=ecdsa-sha256" +
+				"&loops_only=true",
+			Status: 200,
 		},
-		"ca error (no signer)": {
+		"beacons validate": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				g := mock_renewal.NewMockPolicyGen(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					CA: renewal.ChainBuilder{
-						PolicyGen: g,
-					},
+					Beacons:    bs,
+					Interfaces: topologyInterfaces,
 				}
-				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
-					cppki.CAPolicy{
-						Validity:    3 * 24 * time.Hour,
-						Certificate: &x509.Certificate{},
-						CurrentTime: time.Now(),
-					}, serrors.New("internal"),
-				)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
 				return api.Handler(s)
 			},
-			RequestURL: "/ca",
-			Status:     500,
+			Method: http.MethodPost,
+			RequestURL: "/beacons/validate?start_isd_as=1-ff00:0:1*&usages=up_registration" +
+				"&from_neighbor=1-ff00:0:111&valid_at=2021-11-25T12:20:50Z" +
+				"&sort=start_isd_as,expiration:desc&signed_with=ecdsa-sha256",
+			Status: 200,
 		},
-		"health": {
+		"beacons validate malformed": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons: bs,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(10 * time.Hour),
-						InGrace:       false,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: false,
-						TRCID: cppki.TRCID{
-							Base:   2,
-							Serial: 1,
-							ISD:    12,
-						},
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
-				)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 10 * time.Hour,
-			Status:          200,
+			Method: http.MethodPost,
+			RequestURL: "/beacons/validate?start_isd=0&ingress_interface=70000" +
+				"&sort=unknown&expired_only=true&all=true",
+			Status: 400,
 		},
-		"health expired signer": {
+		"beacons valid at future": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons: bs,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(-10 * time.Hour),
-						InGrace:       false,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: false,
-						TRCID: cppki.TRCID{
-							Base:   2,
-							Serial: 1,
-							ISD:    12,
-						},
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
-				)
+				now := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: -10 * time.Hour,
-			Status:          200,
+			RequestURL: "/beacons?valid_at=2021-01-01T10:00:00Z",
+			Status:     400,
 		},
-		"health trc fails": {
+		"beacons valid at future ok": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons: bs,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(10 * time.Hour),
-						InGrace:       false,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: true,
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
-				)
+				now := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{ValidAt: now.Add(2 * time.Hour)},
+				).Times(1).Return(beacons[:1], nil)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 10 * time.Hour,
-			Status:          200,
+			RequestURL: "/beacons?valid_at=2021-01-01T10:00:00Z&future_ok=true",
+			Status:     200,
 		},
-		"health trc error": {
+		"beacons expand class": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons: bs,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(10 * time.Hour),
-						InGrace:       false,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound:       true,
-						TRCNotFoundDetail: "internal",
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
+				// Without registration usages, the class is derived from the
+				// segment. It is only conclusive for the beacon that crosses
+				// ISD boundaries.
+				intraISD, interISD := beacons[0], beacons[1]
+				intraISD.Usage = beaconlib.UsageProp
+				interISD.Usage = beaconlib.UsageProp
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					matchQuery(&beacon.QueryParams{}),
+				).Times(1).Return(
+					[]beacon.Beacon{beacons[0], beacons[1], intraISD, interISD}, nil,
 				)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 10 * time.Hour,
-			Status:          200,
+			RequestURL: "/beacons?expand=class",
+			Status:     200,
 		},
-		"health signer error": {
+		"beacons expand malformed": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons: bs,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: true,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: true,
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
-				)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 10 * time.Hour,
-			Status:          200,
+			RequestURL: "/beacons?expand=class,hops",
+			Status:     400,
 		},
-		"health signer fails": {
+		"beacons registered via": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons:    bs,
+					Interfaces: topologyInterfaces,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: true,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: false,
-						TRCID: cppki.TRCID{
-							Base:   2,
-							Serial: 1,
-							ISD:    12,
-						},
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
-				)
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					matchQuery(&beacon.QueryParams{}),
+				).Times(1).Return(beacons, nil)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 2 * time.Hour,
-			Status:          200,
+			RequestURL: "/beacons",
+			Status:     200,
 		},
-		"health signer degraded": {
+		"beacons from neighbor": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
-				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(3 * time.Hour),
-						InGrace:       false,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: false,
-						TRCID: cppki.TRCID{
-							Base:   2,
-							Serial: 1,
-							ISD:    12,
-						},
+					Beacons: bs,
+					Interfaces: func() map[iface.ID]topology.IFInfo {
+						return map[iface.ID]topology.IFInfo{
+							1: {ID: 1, IA: addr.MustParseIA("1-ff00:0:112")},
+							2: {ID: 2, IA: addr.MustParseIA("1-ff00:0:111")},
+							5: {ID: 5, IA: addr.MustParseIA("1-ff00:0:111")},
+						}
 					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
-				)
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					matchQuery(&beacon.QueryParams{
+						IngressInterfaces: []uint16{2, 5},
+					}),
+				).Times(1).Return(beacons[:1], nil)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 3 * time.Hour,
-			Status:          200,
+			RequestURL: "/beacons?from_neighbor=1-ff00:0:111",
+			Status:     200,
 		},
-		"health signer grace period": {
+		"beacons from neighbor not configured": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons:    bs,
+					Interfaces: topologyInterfaces,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(2 * time.Hour),
-						InGrace:       true,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: false,
-						TRCID: cppki.TRCID{
-							Base:   2,
-							Serial: 1,
-							ISD:    12,
-						},
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
-				)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 2 * time.Hour,
-			Status:          200,
+			RequestURL: "/beacons?from_neighbor=1-ff00:0:112",
+			Status:     400,
 		},
-		"health signer degraded trc fails": {
+		"beacons from neighbor and ingress interface": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons:    bs,
+					Interfaces: topologyInterfaces,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(2 * time.Hour),
-						InGrace:       false,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: true,
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
-				)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 2 * time.Hour,
-			Status:          200,
+			RequestURL: "/beacons?from_neighbor=1-ff00:0:111&ingress_interface=2",
+			Status:     400,
 		},
-		"health signer grace period trc fails": {
+		"beacons expired only": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons: bs,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(2 * time.Hour),
-						InGrace:       true,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: true,
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
-				)
+				now := time.Date(2021, 1, 15, 8, 0, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{},
+				).Times(1).Return(beacons, nil)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 2 * time.Hour,
-			Status:          200,
+			RequestURL: "/beacons?expired_only=true",
+			Status:     200,
 		},
-		"health signer fails trc fails": {
+		"beacons expired only and all": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
+				return api.Handler(&api.Server{Beacons: bs})
+			},
+			RequestURL: "/beacons?expired_only=true&all=true",
+			Status:     400,
+		},
+		"beacons all and valid at": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
+				return api.Handler(&api.Server{Beacons: bs})
+			},
+			RequestURL: "/beacons?all=true&valid_at=2021-01-01T08:00:00Z",
+			Status:     400,
+		},
+		"beacons loops only": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons: bs,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: true,
+				looping := beacons[0]
+				entries := looping.Beacon.Segment.ASEntries
+				looping.Beacon.Segment = &seg.PathSegment{
+					Info:      looping.Beacon.Segment.Info,
+					ASEntries: append(slices.Clone(entries), entries[0]),
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					matchQuery(&beacon.QueryParams{}),
+				).Times(1).Return(append([]beacon.Beacon{looping}, beacons...), nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons?loops_only=true",
+			Status:     200,
+		},
+		"beacons non-existing usages": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					gomock.Any(),
+				).Times(0).Return(beacons, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons?usages=up_registration&usages=Invalid",
+			Status:     400,
+		},
+		"beacons existing usages": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					matchQuery(&beacon.QueryParams{
+						Usages: []beaconlib.Usage{beaconlib.UsageDownReg | beaconlib.UsageUpReg},
+					}),
+				).Times(1).Return(beacons[:1], nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons?usages=up_registration&usages=down_registration",
+			Status:     200,
+		},
+		"beacon": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{
+						SegIDPrefix: hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
 					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: true,
+				).AnyTimes().Return(beacons[:1], nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/" + hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
+			Status:     200,
+		},
+		"beacon registered via": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons:    bs,
+					Interfaces: topologyInterfaces,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{
+						SegIDPrefix: hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
 					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Available, true,
-				)
+				).AnyTimes().Return(beacons[:1], nil)
 				return api.Handler(s)
 			},
-			RequestURL: "/health",
+			RequestURL: "/beacons/" + hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
 			Status:     200,
 		},
-		"health ca starting": {
+		"beacon id prefix": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons: bs,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(10 * time.Hour),
-						InGrace:       false,
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{
+						SegIDPrefix: hex.EncodeToString(beacons[0].Beacon.Segment.ID()[:10]),
 					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: false,
-						TRCID: cppki.TRCID{
-							Base:   2,
-							Serial: 1,
-							ISD:    12,
-						},
+				).AnyTimes().Return(beacons[:1], nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/" + hex.EncodeToString(beacons[0].Beacon.Segment.ID()[:10]),
+			Status:     200,
+		},
+		"beacon no matches": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{
+						SegIDPrefix: hex.EncodeToString([]byte("1234")),
 					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Starting, true,
-				)
+				).AnyTimes().Return([]beacon.Beacon{}, nil)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 10 * time.Hour,
-			Status:          200,
+			RequestURL: "/beacons/" + hex.EncodeToString([]byte("1234")),
+			Status:     400,
 		},
-		"health ca stopping": {
+		"beacon no unique match": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
 				s := &api.Server{
-					Healther: h,
+					Beacons: bs,
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(10 * time.Hour),
-						InGrace:       false,
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{
+						SegIDPrefix: hex.EncodeToString([]byte("1234")),
 					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: false,
-						TRCID: cppki.TRCID{
-							Base:   2,
-							Serial: 1,
-							ISD:    12,
-						},
+				).AnyTimes().Return(beacons, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/" + hex.EncodeToString([]byte("1234")),
+			Status:     300,
+		},
+		"beacon id too long": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/" + hex.EncodeToString(
+				append(beacons[0].Beacon.Segment.ID(), 0x00),
+			),
+			Status: 400,
+		},
+		"beacon id half byte prefix": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{
+						SegIDPrefix: hex.EncodeToString(beacons[0].Beacon.Segment.ID())[:5],
 					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Stopping, true,
-				)
+				).AnyTimes().Return(beacons[:1], nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/" + hex.EncodeToString(beacons[0].Beacon.Segment.ID())[:5],
+			Status:     200,
+		},
+		"beacon id malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/12zz",
+			Status:     400,
+		},
+		"beacon blob": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{SegIDs: [][]byte{beacons[0].Beacon.Segment.ID()}},
+				).AnyTimes().Return(beacons[:1], nil)
+				return api.Handler(s)
+			},
+			RequestURL: fmt.Sprintf(
+				"/beacons/%s/blob",
+				hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
+			),
+			Status: 200,
+		},
+		"beacon id prefix blob": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{SegIDs: [][]byte{beacons[0].Beacon.Segment.ID()[:10]}},
+				).AnyTimes().Return(beacons[:1], nil)
 				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 10 * time.Hour,
-			Status:          200,
+			RequestURL: fmt.Sprintf(
+				"/beacons/%s/blob",
+				hex.EncodeToString(beacons[0].Beacon.Segment.ID()[:10]),
+			),
+			Status: 200,
+		},
+		"beacon no matches blob": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{SegIDs: [][]byte{[]byte("1234")}},
+				).AnyTimes().Return([]beacon.Beacon{}, nil)
+				return api.Handler(s)
+			},
+			RequestURL: fmt.Sprintf("/beacons/%s/blob", hex.EncodeToString([]byte("1234"))),
+			Status:     400,
+		},
+		"beacon no unique match blob": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{SegIDs: [][]byte{[]byte("1234")}},
+				).AnyTimes().Return(beacons, nil)
+				return api.Handler(s)
+			},
+			RequestURL: fmt.Sprintf("/beacons/%s/blob", hex.EncodeToString([]byte("1234"))),
+			Status:     400,
+		},
+		"beacon segments": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				ss := mock_api.NewMockSegmentStore(ctrl)
+				s := &api.Server{
+					Beacons:        bs,
+					SegmentsServer: segapi.Server{Segments: ss},
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{SegIDs: [][]byte{beacons[0].Beacon.Segment.ID()}},
+				).Return(beacons[:1], nil)
+				ss.EXPECT().Get(
+					gomock.Any(),
+					&query.Params{StartsAt: []addr.IA{addr.MustParseIA("1-ff00:0:110")}},
+				).Return(registeredSegments(beacons[0].Beacon.Segment), nil)
+				return api.Handler(s)
+			},
+			RequestURL: fmt.Sprintf(
+				"/beacons/%s/segments",
+				hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
+			),
+			Status: 200,
+		},
+		"beacon segments none": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				ss := mock_api.NewMockSegmentStore(ctrl)
+				s := &api.Server{
+					Beacons:        bs,
+					SegmentsServer: segapi.Server{Segments: ss},
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{SegIDs: [][]byte{beacons[1].Beacon.Segment.ID()}},
+				).Return(beacons[1:], nil)
+				ss.EXPECT().Get(
+					gomock.Any(),
+					&query.Params{StartsAt: []addr.IA{addr.MustParseIA("2-ff00:0:220")}},
+				).Return(registeredSegments(beacons[0].Beacon.Segment), nil)
+				return api.Handler(s)
+			},
+			RequestURL: fmt.Sprintf(
+				"/beacons/%s/segments",
+				hex.EncodeToString(beacons[1].Beacon.Segment.ID()),
+			),
+			Status: 200,
+		},
+		"beacon segments no matches": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{SegIDs: [][]byte{[]byte("1234")}},
+				).Return([]beacon.Beacon{}, nil)
+				return api.Handler(s)
+			},
+			RequestURL: fmt.Sprintf("/beacons/%s/segments", hex.EncodeToString([]byte("1234"))),
+			Status:     400,
+		},
+		"beacon segments id too long": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				return api.Handler(s)
+			},
+			RequestURL: fmt.Sprintf(
+				"/beacons/%s/segments",
+				hex.EncodeToString(append(beacons[0].Beacon.Segment.ID(), 0x00)),
+			),
+			Status: 400,
+		},
+		"beacon segments error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				ss := mock_api.NewMockSegmentStore(ctrl)
+				s := &api.Server{
+					Beacons:        bs,
+					SegmentsServer: segapi.Server{Segments: ss},
+				}
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(beacons[:1], nil)
+				ss.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					nil, serrors.New("internal"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL: fmt.Sprintf(
+				"/beacons/%s/segments",
+				hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
+			),
+			Status: 500,
+		},
+		"signer": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				notBefore := time.Unix(1611051121, 0).UTC()
+				notAfter := time.Unix(1611061121, 0).UTC()
+				now := notBefore.Add(time.Minute)
+				s.SetNowProvider(func() time.Time { return now })
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					[]trust.Signer{{
+						IA:        addr.MustParseIA("1-ff00:0:110"),
+						Algorithm: signed.ECDSAWithSHA512,
+						Subject: pkix.Name{
+							Country:    []string{"CH"},
+							CommonName: "1-ff00:0:110 AS Certificate",
+						},
+						SubjectKeyID: []byte("лучший учитель"),
+						TRCID: cppki.TRCID{
+							ISD:    1,
+							Serial: 42,
+							Base:   1,
+						},
+						Expiration: notAfter,
+						ChainValidity: cppki.Validity{
+							NotBefore: notBefore,
+							NotAfter:  notAfter,
+						},
+						InGrace: true,
+					}}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer",
+			Status:     200,
+		},
+		"signer next": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				notBefore := time.Unix(1611051121, 0).UTC()
+				now := notBefore.Add(time.Minute)
+				s.SetNowProvider(func() time.Time { return now })
+				signer := func(keyID byte, notBefore time.Time) trust.Signer {
+					return trust.Signer{
+						IA:        addr.MustParseIA("1-ff00:0:110"),
+						Algorithm: signed.ECDSAWithSHA512,
+						Subject: pkix.Name{
+							Country:    []string{"CH"},
+							CommonName: "1-ff00:0:110 AS Certificate",
+						},
+						SubjectKeyID: []byte{keyID},
+						TRCID: cppki.TRCID{
+							ISD:    1,
+							Serial: 1,
+							Base:   1,
+						},
+						Expiration: notBefore.Add(24 * time.Hour),
+						ChainValidity: cppki.Validity{
+							NotBefore: notBefore,
+							NotAfter:  notBefore.Add(24 * time.Hour),
+						},
+					}
+				}
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					[]trust.Signer{
+						signer(1, notBefore),
+						signer(3, notBefore.Add(2*time.Hour)),
+						signer(2, notBefore.Add(time.Hour)),
+					}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer",
+			Status:     200,
+		},
+		"signer all": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				now := time.Unix(1611051121, 0).UTC()
+				s.SetNowProvider(func() time.Time { return now })
+				signer := func(keyID byte, notBefore, notAfter time.Time) trust.Signer {
+					return trust.Signer{
+						IA:        addr.MustParseIA("1-ff00:0:110"),
+						Algorithm: signed.ECDSAWithSHA512,
+						Subject: pkix.Name{
+							Country:    []string{"CH"},
+							CommonName: "1-ff00:0:110 AS Certificate",
+						},
+						SubjectKeyID: []byte{keyID},
+						TRCID: cppki.TRCID{
+							ISD:    1,
+							Serial: 1,
+							Base:   1,
+						},
+						Expiration: notAfter,
+						ChainValidity: cppki.Validity{
+							NotBefore: notBefore,
+							NotAfter:  notAfter,
+						},
+					}
+				}
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					[]trust.Signer{
+						// The new signer of the key rollover.
+						signer(2, now.Add(-time.Hour), now.Add(24*time.Hour)),
+						// The old signer of the key rollover.
+						signer(1, now.Add(-24*time.Hour), now.Add(time.Hour)),
+						// Not yet valid.
+						signer(3, now.Add(time.Hour), now.Add(48*time.Hour)),
+						// Expired.
+						signer(4, now.Add(-48*time.Hour), now.Add(-time.Hour)),
+					}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer?all=true",
+			Status:     200,
+		},
+		"signer error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					nil, serrors.New("internal"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer",
+			Status:     500,
+		},
+		"signer status": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				now := time.Unix(1611051121, 0).UTC()
+				s.SetNowProvider(func() time.Time { return now })
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					[]trust.Signer{{
+						Expiration: now.Add(48 * time.Hour),
+						ChainValidity: cppki.Validity{
+							NotBefore: now.Add(-time.Hour),
+							NotAfter:  now.Add(72 * time.Hour),
+						},
+					}}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer/status",
+			Status:     200,
+		},
+		"signer status close to expiration": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				now := time.Unix(1611051121, 0).UTC()
+				s.SetNowProvider(func() time.Time { return now })
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					[]trust.Signer{{
+						Expiration: now.Add(time.Hour),
+						ChainValidity: cppki.Validity{
+							NotBefore: now.Add(-time.Hour),
+							NotAfter:  now.Add(72 * time.Hour),
+						},
+					}}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer/status",
+			Status:     200,
+		},
+		"signer status in grace": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				now := time.Unix(1611051121, 0).UTC()
+				s.SetNowProvider(func() time.Time { return now })
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					[]trust.Signer{{
+						Expiration: now.Add(48 * time.Hour),
+						InGrace:    true,
+						ChainValidity: cppki.Validity{
+							NotBefore: now.Add(-time.Hour),
+							NotAfter:  now.Add(72 * time.Hour),
+						},
+					}}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer/status",
+			Status:     200,
+		},
+		"signer status error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					nil, serrors.New("internal"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer/status",
+			Status:     500,
+		},
+		"signer trust error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+					TrustDB: mock_storage.NewMockTrustDB(ctrl),
+				}
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					nil, serrors.New("internal"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer/trust",
+			Status:     500,
+		},
+		"signer trust no TRC": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				db := mock_storage.NewMockTrustDB(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+					TrustDB: db,
+				}
+				now := time.Unix(1611051121, 0).UTC()
+				s.SetNowProvider(func() time.Time { return now })
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					[]trust.Signer{{
+						IA:         addr.MustParseIA("1-ff00:0:110"),
+						Expiration: now.Add(48 * time.Hour),
+						ChainValidity: cppki.Validity{
+							NotBefore: now.Add(-time.Hour),
+							NotAfter:  now.Add(72 * time.Hour),
+						},
+					}}, nil,
+				)
+				db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(
+					cppki.SignedTRC{}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer/trust",
+			Status:     500,
+		},
+		"signer trust not supported": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{})
+			},
+			RequestURL: "/signer/trust",
+			Status:     501,
+		},
+		"signers": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				g.EXPECT().Generate(gomock.Any()).Times(1).Return(
+					rolloverSigners(), nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signers",
+			Status:     200,
+		},
+		"signers paginated": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				g.EXPECT().Generate(gomock.Any()).Times(1).Return(
+					rolloverSigners(), nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signers?sort=not_before:asc&limit=1&offset=1",
+			Status:     200,
+		},
+		"signers malformed query": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				g.EXPECT().Generate(gomock.Any()).Times(0).Return(
+					rolloverSigners(), nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signers?sort=not_before:up&limit=-1",
+			Status:     400,
+		},
+		"signer blob": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				validCert, _ := cppki.ReadPEMCerts(filepath.Join("testdata", "signer-chain.crt"))
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					[]trust.Signer{{
+						Chain:      validCert,
+						Expiration: time.Now().Add(time.Hour),
+						ChainValidity: cppki.Validity{
+							NotBefore: time.Now(),
+							NotAfter:  time.Now().Add(time.Hour),
+						},
+					}}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer/blob",
+			Status:     200,
+		},
+		"signer blob error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					[]trust.Signer{{}}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/signer/blob",
+			Status:     500,
+		},
+		"ca": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_renewal.NewMockPolicyGen(ctrl)
+				s := &api.Server{
+					CA: renewal.ChainBuilder{
+						PolicyGen: g,
+					},
+				}
+				validCert, _ := cppki.ReadPEMCerts(filepath.Join("testdata", "cp-ca.crt"))
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					cppki.CAPolicy{
+						Validity:    3 * 24 * time.Hour,
+						Certificate: validCert[0],
+					}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/ca",
+			Status:     200,
+		},
+		"ca error (empty certificate)": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_renewal.NewMockPolicyGen(ctrl)
+				s := &api.Server{
+					CA: renewal.ChainBuilder{
+						PolicyGen: g,
+					},
+				}
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					cppki.CAPolicy{
+						Validity:    3 * 24 * time.Hour,
+						Certificate: &x509.Certificate{},
+						CurrentTime: time.Now(),
+					}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/ca",
+			Status:     500,
+		},
+		"ca error (no signer)": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_renewal.NewMockPolicyGen(ctrl)
+				s := &api.Server{
+					CA: renewal.ChainBuilder{
+						PolicyGen: g,
+					},
+				}
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+					cppki.CAPolicy{
+						Validity:    3 * 24 * time.Hour,
+						Certificate: &x509.Certificate{},
+						CurrentTime: time.Now(),
+					}, serrors.New("internal"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/ca",
+			Status:     500,
+		},
+		"ca not configured": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{})
+			},
+			RequestURL: "/ca",
+			Status:     501,
+		},
+		"ca renew preview not configured": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{})
+			},
+			RequestURL: "/ca/renew/preview",
+			Method:     http.MethodPost,
+			Status:     501,
+		},
+		"ca renew preview malformed request": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				g := mock_renewal.NewMockPolicyGen(ctrl)
+				g.EXPECT().Generate(gomock.Any()).Times(0)
+				s := &api.Server{
+					CA: renewal.ChainBuilder{
+						PolicyGen: g,
+					},
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/ca/renew/preview",
+			Method:     http.MethodPost,
+			Body:       "not a certificate request",
+			Status:     400,
+		},
+		"log level options": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{
+					LogLevels: func() (string, []string) {
+						return "info", []string{"debug", "info", "error"}
+					},
+				})
+			},
+			RequestURL: "/loglevel",
+			Status:     200,
+		},
+		"log level options not supported": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{})
+			},
+			RequestURL: "/loglevel",
+			Status:     501,
+		},
+		"version": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{})
+			},
+			RequestURL:         "/version",
+			Status:             200,
+			IgnoreResponseBody: true,
+		},
+		"reload config": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &api.Server{
+					Reload: func(context.Context) ([]string, error) {
+						return []string{"interface 8 removed", "interface 11 modified"}, nil
+					},
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/config/reload",
+			Method:     http.MethodPost,
+			Status:     200,
+		},
+		"reload config unchanged": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &api.Server{
+					Reload: func(context.Context) ([]string, error) {
+						return nil, nil
+					},
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/config/reload",
+			Method:     http.MethodPost,
+			Status:     200,
+		},
+		"reload config invalid": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				s := &api.Server{
+					Reload: func(context.Context) ([]string, error) {
+						return nil, serrors.New("validating update")
+					},
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/config/reload",
+			Method:     http.MethodPost,
+			Status:     422,
+		},
+		"reload config not supported": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{})
+			},
+			RequestURL: "/config/reload",
+			Method:     http.MethodPost,
+			Status:     501,
+		},
+		"health": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health expired signer": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(-10 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: -10 * time.Hour,
+			Status:          200,
+		},
+		"health trc fails": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: true,
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health trc error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound:       true,
+						TRCNotFoundDetail: "internal",
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health signer error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: true,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: true,
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health signer fails": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: true,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 2 * time.Hour,
+			Status:          200,
+		},
+		"health signer degraded": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(3 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 3 * time.Hour,
+			Status:          200,
+		},
+		"health signer expiry warning": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther:            h,
+					SignerExpiryWarning: 2 * time.Hour,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(3 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 3 * time.Hour,
+			Status:          200,
+		},
+		"health signer grace period": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(2 * time.Hour),
+						InGrace:       true,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 2 * time.Hour,
+			Status:          200,
+		},
+		"health signer chain not verified": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				db := mock_storage.NewMockTrustDB(ctrl)
+				s := &api.Server{
+					Healther: h,
+					TrustDB:  db,
+				}
+				chain, err := cppki.ReadPEMCerts(filepath.Join("testdata", "signer-chain.crt"))
+				require.NoError(t, err)
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(3 * 24 * time.Hour),
+						Chain:      chain,
+					},
+				)
+				db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(
+					cppki.SignedTRC{}, nil,
+				)
+				db.EXPECT().Chains(gomock.Any(), gomock.Any()).Return(nil, nil)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   1,
+							Serial: 1,
+							ISD:    1,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Unavailable, false,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 3 * 24 * time.Hour,
+			Status:          200,
+		},
+		"health signer validity covered": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				fixed := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return fixed })
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+						Validities: []cppki.Validity{
+							{
+								NotBefore: fixed.Add(-time.Hour),
+								NotAfter:  fixed.Add(2 * time.Hour),
+							},
+							{
+								NotBefore: fixed.Add(time.Hour),
+								NotAfter:  fixed.Add(4 * time.Hour),
+							},
+						},
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health signer validity gap": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				fixed := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return fixed })
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+						Validities: []cppki.Validity{
+							{
+								NotBefore: fixed.Add(-time.Hour),
+								NotAfter:  fixed.Add(2 * time.Hour),
+							},
+							{
+								NotBefore: fixed.Add(3 * time.Hour),
+								NotAfter:  fixed.Add(4 * time.Hour),
+							},
+						},
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health signer validity not covered": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				fixed := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return fixed })
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+						Validities: []cppki.Validity{
+							{
+								NotBefore: fixed.Add(-2 * time.Hour),
+								NotAfter:  fixed.Add(-time.Hour),
+							},
+							{
+								NotBefore: fixed.Add(time.Hour),
+								NotAfter:  fixed.Add(4 * time.Hour),
+							},
+						},
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health wait change": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				s.SetHealthPollInterval(time.Millisecond)
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+					},
+				)
+				h.EXPECT().GetSignerHealth(gomock.Any()).AnyTimes().Return(
+					api.SignerHealthData{
+						SignerMissing:       true,
+						SignerMissingDetail: "no signer",
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).AnyTimes().Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).AnyTimes().Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health?wait=1m",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health history": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther:          h,
+					HealthHistorySize: 2,
+				}
+				evaluated := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return evaluated })
+				gomock.InOrder(
+					h.EXPECT().GetSignerHealth(gomock.Any()).Times(2).Return(
+						api.SignerHealthData{
+							Expiration: now.Add(10 * time.Hour),
+						},
+					),
+					h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+						api.SignerHealthData{
+							SignerMissing:       true,
+							SignerMissingDetail: "no signer",
+						},
+					),
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).AnyTimes().Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).AnyTimes().Return(api.Unavailable, false)
+				handler := api.Handler(s)
+				for i := 0; i < 3; i++ {
+					req := httptest.NewRequest(http.MethodGet, "/health", nil)
+					handler.ServeHTTP(httptest.NewRecorder(), req)
+					evaluated = evaluated.Add(time.Minute)
+				}
+				return handler
+			},
+			RequestURL: "/health/history",
+			Status:     200,
+		},
+		"health changes": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				evaluated := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return evaluated })
+				gomock.InOrder(
+					h.EXPECT().GetSignerHealth(gomock.Any()).Times(2).Return(
+						api.SignerHealthData{
+							Expiration: now.Add(10 * time.Hour),
+						},
+					),
+					h.EXPECT().GetSignerHealth(gomock.Any()).Times(2).Return(
+						api.SignerHealthData{
+							SignerMissing:       true,
+							SignerMissingDetail: "no signer",
+						},
+					),
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).AnyTimes().Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).AnyTimes().Return(api.Unavailable, false)
+				handler := api.Handler(s)
+				for i := 0; i < 3; i++ {
+					req := httptest.NewRequest(http.MethodGet, "/health", nil)
+					handler.ServeHTTP(httptest.NewRecorder(), req)
+					evaluated = evaluated.Add(time.Minute)
+				}
+				return handler
+			},
+			RequestURL: "/health/changes?since=2021-01-01T08:00:30Z",
+			Status:     200,
+		},
+		"health changes none": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				evaluated := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return evaluated })
+				gomock.InOrder(
+					h.EXPECT().GetSignerHealth(gomock.Any()).Times(2).Return(
+						api.SignerHealthData{
+							Expiration: now.Add(10 * time.Hour),
+						},
+					),
+					h.EXPECT().GetSignerHealth(gomock.Any()).Times(2).Return(
+						api.SignerHealthData{
+							SignerMissing:       true,
+							SignerMissingDetail: "no signer",
+						},
+					),
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).AnyTimes().Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).AnyTimes().Return(api.Unavailable, false)
+				handler := api.Handler(s)
+				for i := 0; i < 3; i++ {
+					req := httptest.NewRequest(http.MethodGet, "/health", nil)
+					handler.ServeHTTP(httptest.NewRecorder(), req)
+					evaluated = evaluated.Add(time.Minute)
+				}
+				return handler
+			},
+			RequestURL: "/health/changes?since=2021-01-01T08:02:30Z",
+			Status:     200,
+		},
+		"health changes malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{
+					Healther: mock_mgmtapi.NewMockHealther(ctrl),
+				})
+			},
+			RequestURL: "/health/changes",
+			Status:     400,
+		},
+		"health wait timeout": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				s.SetHealthPollInterval(time.Millisecond)
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+					},
+				)
+				h.EXPECT().GetSignerHealth(gomock.Any()).AnyTimes().Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).AnyTimes().Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).AnyTimes().Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health?wait=20ms",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health wait malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{
+					Healther: mock_mgmtapi.NewMockHealther(ctrl),
+				})
+			},
+			RequestURL: "/health?wait=1h",
+			Status:     400,
+		},
+		"health signer degraded trc fails": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(2 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: true,
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 2 * time.Hour,
+			Status:          200,
+		},
+		"health signer grace period trc fails": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(2 * time.Hour),
+						InGrace:       true,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: true,
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 2 * time.Hour,
+			Status:          200,
+		},
+		"health signer fails trc fails": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: true,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: true,
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/health",
+			Status:     200,
+		},
+		"health ca starting": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Starting, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health ca stopping": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Stopping, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health ca unavailable": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Unavailable, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health beacon store": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Healther:       h,
+					Beacons:        bs,
+					MaxBeaconCount: 1000,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(api.Available, true)
+				bs.EXPECT().CountBeacons(gomock.Any()).Return(1000, nil)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health beacon store too large": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Healther:       h,
+					Beacons:        bs,
+					MaxBeaconCount: 1000,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(api.Available, true)
+				bs.EXPECT().CountBeacons(gomock.Any()).Return(1001, nil)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health beacon store error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Healther:       h,
+					Beacons:        bs,
+					MaxBeaconCount: 1000,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(api.Available, true)
+				bs.EXPECT().CountBeacons(gomock.Any()).Return(
+					0, serrors.New("database is locked"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health checks subset": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+					// The beacon store must not be queried.
+					Beacons: mock_mgmtapi.NewMockBeaconStore(ctrl),
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{TRCNotFound: true},
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health?checks=signer,trc",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health checks signer": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health?checks=signer",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health checks malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{
+					Healther: mock_mgmtapi.NewMockHealther(ctrl),
+				})
+			},
+			RequestURL: "/health?checks=signer,unknown",
+			Status:     400,
+		},
+		"health status filter": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Healther:       h,
+					Beacons:        bs,
+					MaxBeaconCount: 1000,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(api.Available, true)
+				bs.EXPECT().CountBeacons(gomock.Any()).Return(1001, nil)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health?status=failing,degraded",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health status malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{
+					Healther: mock_mgmtapi.NewMockHealther(ctrl),
+				})
+			},
+			RequestURL: "/health?status=failing,broken",
+			Status:     400,
+		},
+		"health check filter": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Healther:       h,
+					Beacons:        bs,
+					MaxBeaconCount: 1000,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(api.Available, true)
+				bs.EXPECT().CountBeacons(gomock.Any()).Return(1001, nil)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health?check=valid%20signer%20available",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health check unknown": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(api.Available, true)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health?check=unknown",
+			TimestampOffset: 10 * time.Hour,
+			Status:          400,
+		},
+		"health ca signer consistent": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				g := mock_renewal.NewMockPolicyGen(ctrl)
+				s := &api.Server{
+					Healther: h,
+					CA: renewal.ChainBuilder{
+						PolicyGen: g,
+					},
+				}
+				caCert, err := cppki.ReadPEMCerts(filepath.Join("testdata", "cp-ca.crt"))
+				require.NoError(t, err)
+				chain, err := cppki.ReadPEMCerts(filepath.Join("testdata", "cp-ca.crt"))
+				require.NoError(t, err)
+				g.EXPECT().Generate(gomock.Any()).Return(
+					cppki.CAPolicy{
+						Validity:    3 * 24 * time.Hour,
+						Certificate: caCert[0],
+					}, nil,
+				)
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+						Chain:      chain,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   1,
+							Serial: 1,
+							ISD:    1,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health ca signer mismatch": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				g := mock_renewal.NewMockPolicyGen(ctrl)
+				s := &api.Server{
+					Healther: h,
+					CA: renewal.ChainBuilder{
+						PolicyGen: g,
+					},
+				}
+				caCert, err := cppki.ReadPEMCerts(filepath.Join("testdata", "cp-ca.crt"))
+				require.NoError(t, err)
+				chain, err := cppki.ReadPEMCerts(filepath.Join("testdata", "signer-chain.crt"))
+				require.NoError(t, err)
+				g.EXPECT().Generate(gomock.Any()).Return(
+					cppki.CAPolicy{
+						Validity:    3 * 24 * time.Hour,
+						Certificate: caCert[0],
+					}, nil,
+				)
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: now.Add(10 * time.Hour),
+						Chain:      chain,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   1,
+							Serial: 1,
+							ISD:    2,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"health ca check not run": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing: false,
+						Expiration:    now.Add(10 * time.Hour),
+						InGrace:       false,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Unavailable, false,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/health",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"status": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				g := mock_trust.NewMockSignerGen(ctrl)
+				pg := mock_renewal.NewMockPolicyGen(ctrl)
+				s := &api.Server{
+					Healther: h,
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+					CA: renewal.ChainBuilder{
+						PolicyGen: pg,
+					},
+				}
+				caCert, err := cppki.ReadPEMCerts(filepath.Join("testdata", "cp-ca.crt"))
+				require.NoError(t, err)
+				pg.EXPECT().Generate(gomock.Any()).Return(
+					cppki.CAPolicy{
+						Validity:    3 * 24 * time.Hour,
+						Certificate: caCert[0],
+					}, nil,
+				)
+				expiration := now.Add(10 * time.Hour).Truncate(time.Second)
+				g.EXPECT().Generate(gomock.Any()).Return(
+					[]trust.Signer{{
+						IA:        addr.MustParseIA("1-ff00:0:110"),
+						Algorithm: signed.ECDSAWithSHA512,
+						Subject: pkix.Name{
+							Country:    []string{"CH"},
+							CommonName: "1-ff00:0:110 AS Certificate",
+						},
+						SubjectKeyID: []byte("лучший учитель"),
+						TRCID: cppki.TRCID{
+							ISD:    1,
+							Serial: 1,
+							Base:   1,
+						},
+						Expiration: expiration,
+						ChainValidity: cppki.Validity{
+							NotBefore: time.Unix(1611051121, 0).UTC(),
+							NotAfter:  expiration,
+						},
+					}}, nil,
+				)
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						Expiration: expiration,
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCID: cppki.TRCID{
+							Base:   1,
+							Serial: 1,
+							ISD:    1,
+						},
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Available, true,
+				)
+				return api.Handler(s)
+			},
+			RequestURL:      "/status",
+			TimestampOffset: 10 * time.Hour,
+			Status:          200,
+		},
+		"status unavailable": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				g := mock_trust.NewMockSignerGen(ctrl)
+				s := &api.Server{
+					Healther: h,
+					Signer: cstrust.RenewingSigner{
+						SignerGen: g,
+					},
+				}
+				g.EXPECT().Generate(gomock.Any()).Return(nil, serrors.New("internal"))
+				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
+					api.SignerHealthData{
+						SignerMissing:       true,
+						SignerMissingDetail: "no signer available",
+					},
+				)
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound:       true,
+						TRCNotFoundDetail: "no TRC available",
+					},
+				)
+				h.EXPECT().GetCAHealth(gomock.Any()).Return(
+					api.Unavailable, false,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/status",
+			Status:     200,
+		},
+		"beacon sla": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				now := time.Date(2021, 2, 2, 8, 10, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{ValidAt: now},
+				).Times(1).Return(beacons, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/sla",
+			Status:     200,
+		},
+		"beacon sla by usage": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				now := time.Date(2021, 2, 2, 8, 10, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{ValidAt: now},
+				).Times(1).Return(beacons, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/sla?window=1h&group_by=usage",
+			Status:     200,
+		},
+		"beacon sla malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/sla?window=-1m&group_by=hops",
+			Status:     400,
+		},
+		"beacon stats": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				now := time.Date(2021, 2, 2, 8, 10, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				bs.EXPECT().Stats(
+					gomock.Any(),
+					&beacon.QueryParams{ValidAt: now},
+				).Times(1).Return(beaconStats(beacons[0], beacons[1], beacons[1]), nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/stats",
+			Status:     200,
+		},
+		"beacon stats top": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				now := time.Date(2021, 2, 2, 8, 10, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				bs.EXPECT().Stats(
+					gomock.Any(),
+					&beacon.QueryParams{ValidAt: now},
+				).Times(1).Return(beaconStats(beacons[0], beacons[1], beacons[1]), nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/stats?top=1",
+			Status:     200,
+		},
+		"beacon stats malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/stats?top=-1",
+			Status:     400,
+		},
+		"beacons page": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					matchQuery(&beacon.QueryParams{}),
+				).Return(beacons, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons?sort=timestamp&limit=1&offset=1",
+			Status:     200,
+		},
+		"beacons page past end": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					matchQuery(&beacon.QueryParams{}),
+				).Return(beacons, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons?offset=5",
+			Status:     200,
+		},
+		"beacons page malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons?limit=1001&offset=-1",
+			Status:     400,
+		},
+		"beacons delete": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().DeleteBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{
+						StartsAt:          []addr.IA{addr.MustParseIA("1-ff00:0:110")},
+						Usages:            []beaconlib.Usage{beaconlib.UsageUpReg},
+						IngressInterfaces: []uint16{2},
+					},
+				).Times(1).Return(3, nil)
+				return api.Handler(s)
+			},
+			Method: http.MethodDelete,
+			RequestURL: "/beacons?start_isd_as=1-ff00:0:110&usages=up_registration" +
+				"&ingress_interface=2",
+			Status: 200,
+		},
+		"beacons delete all": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().DeleteBeacons(gomock.Any(), nil).Times(1).Return(5, nil)
+				return api.Handler(s)
+			},
+			Method:     http.MethodDelete,
+			RequestURL: "/beacons?all=true",
+			Status:     200,
+		},
+		"beacons delete no filter": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				return api.Handler(s)
+			},
+			Method:     http.MethodDelete,
+			RequestURL: "/beacons",
+			Status:     400,
+		},
+		"beacons delete all with filter": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				return api.Handler(s)
+			},
+			Method:     http.MethodDelete,
+			RequestURL: "/beacons?all=true&start_isd_as=1-0",
+			Status:     400,
+		},
+		"beacon age histogram": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				now := time.Date(2021, 2, 2, 8, 10, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{ValidAt: now},
+				).Times(1).Return([]beacon.Beacon{beacons[0], beacons[1], beacons[1]}, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/age-histogram",
+			Status:     200,
+		},
+		"beacon age histogram buckets": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				now := time.Date(2021, 2, 2, 8, 10, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{ValidAt: now},
+				).Times(1).Return([]beacon.Beacon{beacons[0], beacons[1], beacons[1]}, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/age-histogram?buckets=10m,20m",
+			Status:     200,
+		},
+		"beacon age histogram malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/age-histogram?buckets=15m,5m",
+			Status:     400,
+		},
+		"beacon heartbeat": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				now := time.Date(2021, 2, 1, 8, 10, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{},
+				).Times(1).Return([]beacon.Beacon{beacons[0], beacons[1]}, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/heartbeat?silent_after=1h",
+			Status:     200,
+		},
+		"beacon heartbeat malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/heartbeat?silent_after=0s",
+			Status:     400,
+		},
+		"beacon cover": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+					Interfaces: func() map[iface.ID]topology.IFInfo {
+						return map[iface.ID]topology.IFInfo{1: {ID: 1}, 2: {ID: 2}, 3: {ID: 3}}
+					},
+				}
+				now := time.Date(2021, 2, 2, 8, 30, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				// The older beacon on interface 1 must not be selected.
+				older := beacons[0]
+				older.Beacon.InIfID = 1
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{ValidAt: now},
+				).Times(1).Return([]beacon.Beacon{older, beacons[0], beacons[1]}, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/cover",
+			Status:     200,
+		},
+		"beacon cover error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(1).Return(
+					nil, serrors.New("internal"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/cover",
+			Status:     500,
+		},
+		"selected beacons": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconSelector(ctrl)
+				s := &api.Server{
+					BeaconSelector: bs,
+				}
+				bs.EXPECT().SelectedBeacons(gomock.Any()).Times(1).Return(
+					[]beaconlib.Selection{
+						{
+							Beacon: beacons[0].Beacon,
+							Policy: beaconlib.UpRegPolicy,
+							Reason: beaconlib.SelectedShortest,
+						},
+						{
+							Beacon: beacons[1].Beacon,
+							Policy: beaconlib.UpRegPolicy,
+							Reason: beaconlib.SelectedDiverse,
+						},
+						{
+							Beacon: beacons[0].Beacon,
+							Policy: beaconlib.DownRegPolicy,
+							Reason: beaconlib.SelectedCandidate,
+						},
+					}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/selected",
+			Status:     200,
+		},
+		"selected beacons error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconSelector(ctrl)
+				s := &api.Server{
+					BeaconSelector: bs,
+				}
+				bs.EXPECT().SelectedBeacons(gomock.Any()).Times(1).Return(
+					nil, serrors.New("internal"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/selected",
+			Status:     500,
+		},
+		"selected beacons not supported": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{})
+			},
+			RequestURL: "/beacons/selected",
+			Status:     501,
+		},
+		"beacons reconcile": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				missing := bytes.Repeat([]byte{0xff}, 32)
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{SegIDs: [][]byte{
+						beacons[0].Beacon.Segment.ID(), missing, beacons[0].Beacon.Segment.ID(),
+					}},
+				).Times(1).Return(beacons[:1], nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/reconcile",
+			Method:     http.MethodPost,
+			Body: `{"ids": [
+				"6e1f2ac35d1382a6064600007f9f270f6506c0b3453ee50423b5f1a73de53345",
+				"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+				"6E1F2AC35D1382A6064600007F9F270F6506C0B3453EE50423B5F1A73DE53345"
+			]}`,
+			Status: 200,
+		},
+		"beacons reconcile malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(0)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/reconcile",
+			Method:     http.MethodPost,
+			Body:       `{"ids": ["6e1f2ac35d", "not hex"]}`,
+			Status:     400,
+		},
+		"beacons reconcile error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(1).Return(
+					nil, serrors.New("internal"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/reconcile",
+			Method:     http.MethodPost,
+			Body: `{"ids": [
+				"6e1f2ac35d1382a6064600007f9f270f6506c0b3453ee50423b5f1a73de53345"
+			]}`,
+			Status: 500,
+		},
+		"beacon anomalies": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				empty := beacon.Beacon{
+					Beacon: beaconlib.Beacon{
+						Segment: &seg.PathSegment{},
+					},
+					LastUpdated: time.Date(2021, 1, 2, 8, 0, 0, 0, time.UTC),
+				}
+				future := beacons[1]
+				future.LastUpdated = future.Beacon.Segment.Info.Timestamp.Add(-time.Hour)
+				bs.EXPECT().GetBeacons(gomock.Any(), &beacon.QueryParams{}).Times(1).Return(
+					[]beacon.Beacon{beacons[0], future, empty}, nil,
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/anomalies",
+			Status:     200,
+		},
+		"beacon anomalies error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+				}
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(1).Return(
+					nil, serrors.New("internal"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/anomalies",
+			Status:     500,
+		},
+		"interfaces": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons: bs,
+					Interfaces: func() map[iface.ID]topology.IFInfo {
+						return map[iface.ID]topology.IFInfo{
+							3: {
+								ID:       3,
+								IA:       addr.MustParseIA("1-ff00:0:112"),
+								LinkType: topology.Peer,
+								MTU:      1400,
+							},
+							1: {
+								ID:       1,
+								IA:       addr.MustParseIA("1-ff00:0:120"),
+								LinkType: topology.Core,
+								MTU:      1472,
+							},
+							2: {
+								ID:       2,
+								IA:       addr.MustParseIA("1-ff00:0:111"),
+								LinkType: topology.Child,
+								MTU:      1472,
+							},
+						}
+					},
+				}
+				now := time.Date(2021, 2, 2, 8, 30, 0, 0, time.UTC)
+				s.SetNowProvider(func() time.Time { return now })
+				bs.EXPECT().GetBeacons(
+					gomock.Any(),
+					&beacon.QueryParams{IngressInterfaces: []uint16{1, 2, 3}},
+				).Times(1).Return(beacons, nil)
+				return api.Handler(s)
+			},
+			RequestURL: "/interfaces",
+			Status:     200,
+		},
+		"interfaces error": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				s := &api.Server{
+					Beacons:    bs,
+					Interfaces: topologyInterfaces,
+				}
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(1).Return(
+					nil, serrors.New("internal"),
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/interfaces",
+			Status:     500,
+		},
+		"interfaces not supported": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{})
+			},
+			RequestURL: "/interfaces",
+			Status:     501,
+		},
+		"beacon policy": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				bp := mock_mgmtapi.NewMockBeaconPolicyProvider(ctrl)
+				s := &api.Server{
+					BeaconPolicy: bp,
+				}
+				prop := beaconlib.Policy{
+					Type:        beaconlib.PropPolicy,
+					BestSetSize: 5,
+					Filter: beaconlib.Filter{
+						AsBlackList:  []addr.AS{addr.MustParseAS("ff00:0:110")},
+						IsdBlackList: []addr.ISD{2},
+						AllowIsdLoop: ptr.To(false),
+					},
+				}
+				prop.InitDefaults()
+				upReg := beaconlib.Policy{Type: beaconlib.UpRegPolicy}
+				upReg.InitDefaults()
+				bp.EXPECT().BeaconPolicy().Return(api.BeaconPolicyData{
+					OriginationInterval:  5 * time.Second,
+					PropagationInterval:  5 * time.Second,
+					RegistrationInterval: 10 * time.Second,
+					Policies:             []beaconlib.Policy{prop, upReg},
+				})
+				return api.Handler(s)
+			},
+			RequestURL: "/beacons/policy",
+			Status:     200,
+		},
+		"livez": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				return api.Handler(&api.Server{
+					Healther: mock_mgmtapi.NewMockHealther(ctrl),
+				})
+			},
+			RequestURL: "/livez",
+			Status:     200,
+		},
+		"readyz": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound: false,
+						TRCID: cppki.TRCID{
+							Base:   2,
+							Serial: 1,
+							ISD:    12,
+						},
+					},
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/readyz",
+			Status:     200,
+		},
+		"readyz trc missing": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				h := mock_mgmtapi.NewMockHealther(ctrl)
+				s := &api.Server{
+					Healther: h,
+				}
+				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
+					api.TRCHealthData{
+						TRCNotFound:       true,
+						TRCNotFoundDetail: "TRC not found",
+					},
+				)
+				return api.Handler(s)
+			},
+			RequestURL: "/readyz",
+			Status:     503,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			method := tc.Method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req, err := http.NewRequest(method, tc.RequestURL, strings.NewReader(tc.Body))
+			require.NoError(t, err)
+
+			rr := httptest.NewRecorder()
+			tc.Handler(t, ctrl).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.Status, rr.Result().StatusCode)
+
+			if tc.IgnoreResponseBody {
+				return
+			}
+			expiresAt := now.Add(tc.TimestampOffset).Format(time.RFC3339)
+			goldenFile := "testdata/" + xtest.SanitizedName(t)
+			if *update {
+				raw := strings.ReplaceAll(rr.Body.String(), expiresAt, "EXPIRES_AT")
+				require.NoError(t, os.WriteFile(goldenFile, []byte(raw), 0o666))
+			}
+			goldenRaw, err := os.ReadFile(goldenFile)
+			require.NoError(t, err)
+			golden := strings.ReplaceAll(string(goldenRaw), "EXPIRES_AT", expiresAt)
+			assert.Equal(t, golden, rr.Body.String())
+		})
+	}
+}
+
+func TestGetBeaconETag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	beacons := createBeacons(t)
+	bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+	gomock.InOrder(
+		bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(2).Return(beacons[:1], nil),
+		bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, *beacon.QueryParams) ([]beacon.Beacon, error) {
+				updated := beacons[0]
+				updated.LastUpdated = updated.LastUpdated.Add(time.Hour)
+				return []beacon.Beacon{updated}, nil
+			},
+		),
+	)
+	handler := api.Handler(&api.Server{Beacons: bs})
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet,
+			"/beacons/"+hex.EncodeToString(beacons[0].Beacon.Segment.ID()), nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := get("")
+	require.Equal(t, http.StatusOK, rr.Code)
+	etag := rr.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	rr = get(`"other", ` + etag)
+	assert.Equal(t, http.StatusNotModified, rr.Code)
+	assert.Empty(t, rr.Body.String())
+
+	rr = get(etag)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.NotEqual(t, etag, rr.Header().Get("ETag"))
+	assert.NotEmpty(t, rr.Body.String())
+}
+
+func TestGetBeaconsStableOrder(t *testing.T) {
+	beacons := createBeacons(t)
+	// Make all sort keys tie, such that only the segment ID decides.
+	beacons[1].LastUpdated = beacons[0].LastUpdated
+	beacons[1].Beacon.InIfID = beacons[0].Beacon.InIfID
+	ids := []string{
+		hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
+		hex.EncodeToString(beacons[1].Beacon.Segment.ID()),
+	}
+	slices.Sort(ids)
+
+	testCases := map[string]struct {
+		Query    string
+		Expected []string
+	}{
+		"default": {
+			Expected: ids,
+		},
+		"last_updated": {
+			Query:    "?sort=last_updated",
+			Expected: ids,
+		},
+		"ingress_interface": {
+			Query:    "?sort=ingress_interface",
+			Expected: ids,
+		},
+		"desc": {
+			Query:    "?sort=last_updated&desc=true",
+			Expected: []string{ids[1], ids[0]},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for _, order := range [][]beacon.Beacon{
+				{beacons[0], beacons[1]},
+				{beacons[1], beacons[0]},
+			} {
+				ctrl := gomock.NewController(t)
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(order, nil)
+				handler := api.Handler(&api.Server{Beacons: bs})
+
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/beacons"+tc.Query, nil))
+				require.Equal(t, http.StatusOK, rr.Code)
+				var rep struct {
+					Beacons []struct {
+						ID string `json:"id"`
+					} `json:"beacons"`
+				}
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+				var got []string
+				for _, b := range rep.Beacons {
+					got = append(got, b.ID)
+				}
+				assert.Equal(t, tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestGetBeaconsSortISDCount(t *testing.T) {
+	// The first beacon stays within ISD 1, the second one traverses ISDs 2
+	// and 3.
+	beacons := createBeacons(t)
+	ids := []string{
+		hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
+		hex.EncodeToString(beacons[1].Beacon.Segment.ID()),
+	}
+
+	testCases := map[string]struct {
+		Query    string
+		Expected []string
+	}{
+		"ascending": {
+			Query:    "?sort=isd_count",
+			Expected: ids,
+		},
+		"descending": {
+			Query:    "?sort=isd_count:desc",
+			Expected: []string{ids[1], ids[0]},
+		},
+		"desc": {
+			Query:    "?sort=isd_count&desc=true",
+			Expected: []string{ids[1], ids[0]},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+			bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(
+				[]beacon.Beacon{beacons[1], beacons[0]}, nil,
+			)
+			handler := api.Handler(&api.Server{Beacons: bs})
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/beacons"+tc.Query, nil))
+			require.Equal(t, http.StatusOK, rr.Code)
+			var rep struct {
+				Beacons []struct {
+					ID string `json:"id"`
+				} `json:"beacons"`
+			}
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+			var got []string
+			for _, b := range rep.Beacons {
+				got = append(got, b.ID)
+			}
+			assert.Equal(t, tc.Expected, got)
+		})
+	}
+}
+
+func TestGetBeaconsSortHopCount(t *testing.T) {
+	// The second beacon is shortened to a single AS entry, i.e., it has fewer
+	// hops than the first one. It is also created later and expires later.
+	beacons := createBeacons(t)
+	beacons[1].Beacon.Segment.ASEntries = beacons[1].Beacon.Segment.ASEntries[:1]
+	ids := []string{
+		hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
+		hex.EncodeToString(beacons[1].Beacon.Segment.ID()),
+	}
+
+	testCases := map[string]struct {
+		Query    string
+		Expected []string
+	}{
+		"hop count": {
+			Query:    "?sort=hop_count",
+			Expected: []string{ids[1], ids[0]},
+		},
+		"hop count descending": {
+			Query:    "?sort=hop_count:desc",
+			Expected: ids,
+		},
+		"expiration time descending": {
+			Query:    "?sort=expiration_time_desc",
+			Expected: []string{ids[1], ids[0]},
+		},
+		"expiration time": {
+			Query:    "?sort=expiration_time",
+			Expected: ids,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+			bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(
+				[]beacon.Beacon{beacons[0], beacons[1]}, nil,
+			)
+			handler := api.Handler(&api.Server{Beacons: bs})
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/beacons"+tc.Query, nil))
+			require.Equal(t, http.StatusOK, rr.Code)
+			var rep struct {
+				Beacons []struct {
+					ID string `json:"id"`
+				} `json:"beacons"`
+			}
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+			var got []string
+			for _, b := range rep.Beacons {
+				got = append(got, b.ID)
+			}
+			assert.Equal(t, tc.Expected, got)
+		})
+	}
+}
+
+func TestGetBeaconsSnapshot(t *testing.T) {
+	beacons := createBeacons(t)
+	// The snapshot is taken between the updates of the two beacons.
+	snapshotTime := time.Date(2021, 1, 15, 8, 0, 0, 0, time.UTC)
+	ctrl := gomock.NewController(t)
+	bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+	bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, q *beacon.QueryParams) ([]beacon.Beacon, error) {
+			assert.True(t, snapshotTime.Equal(q.ValidAt), "valid at %s", q.ValidAt)
+			return beacons, nil
+		},
+	)
+	s := &api.Server{Beacons: bs}
+	s.SetNowProvider(func() time.Time { return snapshotTime })
+	handler := api.Handler(s)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/snapshot", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	var snapshot api.Snapshot
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &snapshot))
+	assert.True(t, snapshotTime.Equal(snapshot.TakenAt))
+
+	s.SetNowProvider(func() time.Time { return snapshotTime.Add(time.Hour) })
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet,
+		"/beacons?snapshot="+snapshot.Token, nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	var rep struct {
+		Beacons []struct {
+			ID          string    `json:"id"`
+			LastUpdated time.Time `json:"last_updated"`
+		} `json:"beacons"`
+		Warnings []string `json:"warnings"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+	// The second beacon was refreshed after the snapshot. It is still listed,
+	// with its current content.
+	require.Len(t, rep.Beacons, 2)
+	assert.Equal(t, hex.EncodeToString(beacons[0].Beacon.Segment.ID()), rep.Beacons[0].ID)
+	assert.Equal(t, hex.EncodeToString(beacons[1].Beacon.Segment.ID()), rep.Beacons[1].ID)
+	assert.True(t, beacons[1].LastUpdated.Equal(rep.Beacons[1].LastUpdated))
+	assert.NotEmpty(t, rep.Warnings)
+}
+
+func TestGetBeaconsCursor(t *testing.T) {
+	beacons := createBeacons(t)
+	ids := []string{
+		hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
+		hex.EncodeToString(beacons[1].Beacon.Segment.ID()),
+	}
+	firstPage := time.Date(2021, 3, 1, 8, 0, 0, 0, time.UTC)
+	// The first beacon is refreshed after the first page was listed, thus it
+	// moves behind the second one in the default order by last update.
+	refreshed := beacons[0]
+	refreshed.LastUpdated = firstPage.Add(time.Minute)
+
+	type page struct {
+		Beacons []struct {
+			ID string `json:"id"`
+		} `json:"beacons"`
+		TotalCount int    `json:"total_count"`
+		NextCursor string `json:"next_cursor"`
+	}
+	ctrl := gomock.NewController(t)
+	bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+	gomock.InOrder(
+		bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(beacons, nil),
+		bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+			func(_ context.Context, q *beacon.QueryParams) ([]beacon.Beacon, error) {
+				// The cursor pins the listing to the time of the first page.
+				assert.True(t, firstPage.Equal(q.ValidAt), "valid at %s", q.ValidAt)
+				return []beacon.Beacon{refreshed, beacons[1]}, nil
+			},
+		),
+	)
+	s := &api.Server{Beacons: bs}
+	s.SetNowProvider(func() time.Time { return firstPage })
+	handler := api.Handler(s)
+	get := func(t *testing.T, query string) page {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/beacons"+query, nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var rep page
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+		return rep
+	}
+
+	first := get(t, "?limit=1")
+	require.Len(t, first.Beacons, 1)
+	assert.Equal(t, ids[0], first.Beacons[0].ID)
+	assert.Equal(t, 2, first.TotalCount)
+	require.NotEmpty(t, first.NextCursor)
+
+	s.SetNowProvider(func() time.Time { return firstPage.Add(time.Hour) })
+	second := get(t, "?limit=1&cursor="+first.NextCursor)
+	require.Len(t, second.Beacons, 1)
+	assert.Equal(t, ids[1], second.Beacons[0].ID)
+	assert.Equal(t, 2, second.TotalCount)
+	require.NotEmpty(t, second.NextCursor)
+
+	// The refreshed beacon is not dropped, it is listed at its new position.
+	third := get(t, "?limit=1&cursor="+second.NextCursor)
+	require.Len(t, third.Beacons, 1)
+	assert.Equal(t, ids[0], third.Beacons[0].ID)
+	assert.Empty(t, third.NextCursor)
+
+	for name, query := range map[string]string{
+		"malformed":       "?cursor=invalid!",
+		"with offset":     "?offset=1&cursor=" + first.NextCursor,
+		"other sort":      "?sort=hop_count&cursor=" + first.NextCursor,
+		"other direction": "?desc=true&cursor=" + first.NextCursor,
+		"other snapshot":  "?snapshot=AAAAAAAAAAA&cursor=" + first.NextCursor,
+	} {
+		t.Run(name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/beacons"+query, nil))
+			assert.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		})
+	}
+}
+
+func TestDeleteBeaconIfMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	beacons := createBeacons(t)
+	id := hex.EncodeToString(beacons[0].Beacon.Segment.ID())
+	// The prefix ends in half a byte.
+	prefix := id[:5]
+	bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+	gomock.InOrder(
+		bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Times(4).Return(beacons[:1], nil),
+		bs.EXPECT().DeleteBeacon(gomock.Any(), prefix).Times(2),
+		bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(nil, nil),
+		bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(beacons[:2], nil),
+	)
+	handler := api.Handler(&api.Server{Beacons: bs})
+	send := func(method, path, accept, ifMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := send(http.MethodGet, "/beacons/"+id, "", "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	etag := rr.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	// The ETag does not depend on the representation of the beacon.
+	rr = send(http.MethodGet, "/beacons/"+id+"?include_blob=true&include_peers=true",
+		"application/cbor", "")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, etag, rr.Header().Get("ETag"))
+
+	// A stale ETag must not delete the beacon.
+	assert.Equal(t, http.StatusPreconditionFailed,
+		send(http.MethodDelete, "/beacons/"+prefix, "", `"other"`).Code)
+	assert.Equal(t, http.StatusNoContent,
+		send(http.MethodDelete, "/beacons/"+prefix, "", etag).Code)
+	// Without If-Match, the beacon is deleted unconditionally.
+	assert.Equal(t, http.StatusNoContent,
+		send(http.MethodDelete, "/beacons/"+prefix, "", "").Code)
+	// The beacon no longer exists.
+	assert.Equal(t, http.StatusPreconditionFailed,
+		send(http.MethodDelete, "/beacons/"+prefix, "", etag).Code)
+	// An ambiguous prefix lists the candidates, like the GET request.
+	rr = send(http.MethodDelete, "/beacons/"+prefix, "", etag)
+	assert.Equal(t, http.StatusMultipleChoices, rr.Code)
+	var candidates api.BeaconCandidates
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &candidates))
+	assert.Len(t, candidates.SegmentIds, 2)
+}
+
+func TestGetCaServerTiming(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	validCert, err := cppki.ReadPEMCerts(filepath.Join("testdata", "cp-ca.crt"))
+	require.NoError(t, err)
+	g := mock_renewal.NewMockPolicyGen(ctrl)
+	g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(
+		cppki.CAPolicy{
+			Validity:    3 * 24 * time.Hour,
+			Certificate: validCert[0],
+		}, nil,
+	)
+	handler := api.Handler(&api.Server{CA: renewal.ChainBuilder{PolicyGen: g}})
+	get := func(target string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+		require.Equal(t, http.StatusOK, rr.Code)
+		return rr
+	}
+
+	rr := get("/ca")
+	assert.Empty(t, rr.Header().Get("Server-Timing"))
+	assert.Empty(t, rr.Header().Get("Cache-Control"))
+	plain := rr.Body.String()
+
+	rr = get("/ca?debug=true")
+	assert.Regexp(t, `^policy;dur=\d+\.\d{3}, ia;dur=\d+\.\d{3}$`,
+		rr.Header().Get("Server-Timing"))
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	assert.Equal(t, plain, rr.Body.String())
+}
+
+func TestGetSignerTrust(t *testing.T) {
+	dir := genCrypto(t)
+	other := genCrypto(t)
+	chain := xtest.LoadChain(t,
+		filepath.Join(dir, "ISD1/ASff00_0_111/crypto/as/ISD1-ASff00_0_111.pem"))
+	signer := trust.Signer{
+		IA:           addr.MustParseIA("1-ff00:0:111"),
+		Chain:        chain,
+		SubjectKeyID: chain[0].SubjectKeyId,
+		Expiration:   chain[0].NotAfter,
+		ChainValidity: cppki.Validity{
+			NotBefore: chain[0].NotBefore,
+			NotAfter:  chain[0].NotAfter,
+		},
+	}
+
+	testCases := map[string]struct {
+		TRC          string
+		Status       int
+		ExpectedTRCs []api.SignerTrustTRC
+	}{
+		"verified": {
+			TRC:    filepath.Join(dir, "trcs/ISD1-B1-S1.trc"),
+			Status: http.StatusOK,
+			ExpectedTRCs: []api.SignerTrustTRC{{
+				Id: api.TRCID{Isd: 1, BaseNumber: 1, SerialNumber: 1},
+			}},
+		},
+		"not verified": {
+			TRC:    filepath.Join(other, "trcs/ISD1-B1-S1.trc"),
+			Status: http.StatusInternalServerError,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			g := mock_trust.NewMockSignerGen(ctrl)
+			g.EXPECT().Generate(gomock.Any()).Return([]trust.Signer{signer}, nil)
+			db := mock_storage.NewMockTrustDB(ctrl)
+			db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(
+				xtest.LoadTRC(t, tc.TRC), nil,
+			)
+			handler := api.Handler(&api.Server{
+				Signer:  cstrust.RenewingSigner{SignerGen: g},
+				TrustDB: db,
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/signer/trust", nil)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, tc.Status, rr.Code, rr.Body.String())
+			if tc.Status != http.StatusOK {
+				return
+			}
+			var rep api.SignerTrust
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+			assert.Equal(t, fmt.Sprintf("% X", chain[0].SubjectKeyId), rep.SubjectKeyId)
+			assert.Equal(t, tc.ExpectedTRCs, rep.Trcs)
+		})
+	}
+}
+
+func TestGetSignerCsr(t *testing.T) {
+	dir := filepath.Join(genCrypto(t), "ISD1/ASff00_0_111/crypto/as")
+	chain := xtest.LoadChain(t, filepath.Join(dir, "ISD1-ASff00_0_111.pem"))
+	raw, err := os.ReadFile(filepath.Join(dir, "cp-as.key"))
+	require.NoError(t, err)
+	block, _ := pem.Decode(raw)
+	require.NotNil(t, block)
+	priv, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	require.NoError(t, err)
+
+	t.Run("csr", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		g := mock_trust.NewMockSignerGen(ctrl)
+		g.EXPECT().Generate(gomock.Any()).Return([]trust.Signer{{
+			PrivateKey: priv.(crypto.Signer),
+			IA:         addr.MustParseIA("1-ff00:0:111"),
+			Chain:      chain,
+			Expiration: chain[0].NotAfter,
+			ChainValidity: cppki.Validity{
+				NotBefore: chain[0].NotBefore,
+				NotAfter:  chain[0].NotAfter,
+			},
+		}}, nil)
+		handler := api.Handler(&api.Server{Signer: cstrust.RenewingSigner{SignerGen: g}})
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/signer/csr", nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		assert.Equal(t, "application/x-pem-file", rr.Header().Get("Content-Type"))
+		block, rest := pem.Decode(rr.Body.Bytes())
+		require.NotNil(t, block)
+		assert.Empty(t, rest)
+		assert.Equal(t, "CERTIFICATE REQUEST", block.Type)
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		require.NoError(t, err)
+		require.NoError(t, csr.CheckSignature())
+		assert.Equal(t, chain[0].RawSubject, csr.RawSubject)
+		assert.Equal(t, chain[0].PublicKey, csr.PublicKey)
+	})
+	t.Run("no signer", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		g := mock_trust.NewMockSignerGen(ctrl)
+		g.EXPECT().Generate(gomock.Any()).Return(nil, serrors.New("internal"))
+		handler := api.Handler(&api.Server{Signer: cstrust.RenewingSigner{SignerGen: g}})
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/signer/csr", nil))
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestGetTrustAnchor(t *testing.T) {
+	isd1 := xtest.LoadTRC(t, filepath.Join(genCrypto(t), "trcs/ISD1-B1-S1.trc"))
+	// The TRC of the other ISD is only distinguished by its ID and raw bytes.
+	isd2 := xtest.LoadTRC(t, filepath.Join(genCrypto(t), "trcs/ISD1-B1-S1.trc"))
+	isd2.TRC.ID.ISD = 2
+
+	t.Run("bundle", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		db := mock_storage.NewMockTrustDB(ctrl)
+		db.EXPECT().SignedTRCs(gomock.Any(), truststorage.TRCsQuery{Latest: true}).Return(
+			cppki.SignedTRCs{isd2, isd1}, nil,
+		).Times(2)
+		handler := api.Handler(&api.Server{TrustDB: db})
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/trust-anchor", nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		assert.Equal(t, "application/x-pem-file", rr.Header().Get("Content-Type"))
+		var raw [][]byte
+		for rest := rr.Body.Bytes(); len(rest) != 0; {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			require.NotNil(t, block)
+			assert.Equal(t, "TRC", block.Type)
+			raw = append(raw, block.Bytes)
+		}
+		assert.Equal(t, [][]byte{isd1.TRC.Raw, isd2.TRC.Raw}, raw)
+
+		etag := rr.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+		req := httptest.NewRequest(http.MethodGet, "/trust-anchor", nil)
+		req.Header.Set("If-None-Match", etag)
+		rr = httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotModified, rr.Code)
+		assert.Empty(t, rr.Body.Bytes())
+	})
+	t.Run("no trcs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		db := mock_storage.NewMockTrustDB(ctrl)
+		db.EXPECT().SignedTRCs(gomock.Any(), gomock.Any()).Return(nil, nil)
+		handler := api.Handler(&api.Server{TrustDB: db})
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/trust-anchor", nil))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+	t.Run("error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		db := mock_storage.NewMockTrustDB(ctrl)
+		db.EXPECT().SignedTRCs(gomock.Any(), gomock.Any()).Return(nil, serrors.New("internal"))
+		handler := api.Handler(&api.Server{TrustDB: db})
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/trust-anchor", nil))
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+	t.Run("no trust db", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		api.Handler(&api.Server{}).ServeHTTP(rr,
+			httptest.NewRequest(http.MethodGet, "/trust-anchor", nil))
+		assert.Equal(t, http.StatusNotImplemented, rr.Code)
+	})
+}
+
+func TestHealthSignerChain(t *testing.T) {
+	dir := genCrypto(t)
+	other := genCrypto(t)
+	chain := xtest.LoadChain(t,
+		filepath.Join(dir, "ISD1/ASff00_0_111/crypto/as/ISD1-ASff00_0_111.pem"))
+
+	testCases := map[string]struct {
+		TRC    string
+		Status api.Status
+	}{
+		"verified": {
+			TRC:    filepath.Join(dir, "trcs/ISD1-B1-S1.trc"),
+			Status: api.Passing,
+		},
+		"not verified": {
+			TRC:    filepath.Join(other, "trcs/ISD1-B1-S1.trc"),
+			Status: api.Failing,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := mock_mgmtapi.NewMockHealther(ctrl)
+			h.EXPECT().GetSignerHealth(gomock.Any()).Return(api.SignerHealthData{
+				Expiration: chain[0].NotAfter,
+				Chain:      chain,
+			})
+			h.EXPECT().GetTRCHealth(gomock.Any()).Return(api.TRCHealthData{})
+			h.EXPECT().GetCAHealth(gomock.Any()).Return(api.Unavailable, false)
+			db := mock_storage.NewMockTrustDB(ctrl)
+			db.EXPECT().SignedTRC(gomock.Any(), gomock.Any()).Return(
+				xtest.LoadTRC(t, tc.TRC), nil,
+			)
+			db.EXPECT().Chains(gomock.Any(), gomock.Any()).Return(nil, nil)
+			handler := api.Handler(&api.Server{Healther: h, TrustDB: db})
+
+			req := httptest.NewRequest(http.MethodGet, "/health", nil)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			var rep api.HealthResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+			require.NotEmpty(t, rep.Health.Checks)
+			signerCheck := rep.Health.Checks[0]
+			assert.Equal(t, "valid signer available", signerCheck.Name)
+			assert.Equal(t, tc.Status, signerCheck.Status, signerCheck.Detail)
+		})
+	}
+}
+
+func TestHealthPlainText(t *testing.T) {
+	testCases := map[string]struct {
+		Accept       string
+		Signer       api.SignerHealthData
+		ExpectedCode int
+		ExpectedBody string
+		ExpectedJSON bool
+	}{
+		"passing": {
+			Accept:       "text/plain",
+			Signer:       api.SignerHealthData{Expiration: time.Now().Add(24 * time.Hour)},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: "OK\n",
+		},
+		"degraded": {
+			Accept:       "text/plain",
+			Signer:       api.SignerHealthData{Expiration: time.Now().Add(time.Hour)},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: "DEGRADED\n",
+		},
+		"failing": {
+			Accept:       "text/plain; charset=utf-8",
+			Signer:       api.SignerHealthData{SignerMissing: true},
+			ExpectedCode: http.StatusServiceUnavailable,
+			ExpectedBody: "FAILING\n",
+		},
+		"prometheus": {
+			Accept:       "text/plain; version=0.0.4",
+			Signer:       api.SignerHealthData{SignerMissing: true},
+			ExpectedCode: http.StatusOK,
+			ExpectedJSON: true,
+		},
+		"json preferred": {
+			Accept:       "application/json, text/plain; q=0.5",
+			Signer:       api.SignerHealthData{SignerMissing: true},
+			ExpectedCode: http.StatusOK,
+			ExpectedJSON: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := mock_mgmtapi.NewMockHealther(ctrl)
+			h.EXPECT().GetSignerHealth(gomock.Any()).Return(tc.Signer)
+			h.EXPECT().GetTRCHealth(gomock.Any()).Return(api.TRCHealthData{
+				TRCID: cppki.TRCID{ISD: 1, Base: 1, Serial: 1},
+			})
+			h.EXPECT().GetCAHealth(gomock.Any()).Return(api.Unavailable, false)
+
+			req := httptest.NewRequest(http.MethodGet, "/health", nil)
+			req.Header.Set("Accept", tc.Accept)
+			rr := httptest.NewRecorder()
+			api.Handler(&api.Server{Healther: h}).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.ExpectedCode, rr.Code)
+			if tc.ExpectedJSON {
+				assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+				var rep api.HealthResponse
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+				return
+			}
+			assert.Equal(t, "text/plain; charset=utf-8", rr.Header().Get("Content-Type"))
+			assert.Equal(t, tc.ExpectedBody, rr.Body.String())
+		})
+	}
+}
+
+func TestHealthBeaconCountCached(t *testing.T) {
+	now := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+	ctrl := gomock.NewController(t)
+	h := mock_mgmtapi.NewMockHealther(ctrl)
+	h.EXPECT().GetSignerHealth(gomock.Any()).Return(api.SignerHealthData{
+		Expiration: now.Add(30 * 24 * time.Hour),
+	}).AnyTimes()
+	h.EXPECT().GetTRCHealth(gomock.Any()).Return(api.TRCHealthData{}).AnyTimes()
+	h.EXPECT().GetCAHealth(gomock.Any()).Return(api.Unavailable, false).AnyTimes()
+	bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+	gomock.InOrder(
+		// Failed counts are not cached.
+		bs.EXPECT().CountBeacons(gomock.Any()).Return(0, serrors.New("database is locked")),
+		bs.EXPECT().CountBeacons(gomock.Any()).Return(10, nil),
+		bs.EXPECT().CountBeacons(gomock.Any()).Return(20, nil),
+	)
+	s := &api.Server{Healther: h, Beacons: bs}
+	s.SetNowProvider(func() time.Time { return now })
+	handler := api.Handler(s)
+	beaconCount := func() any {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/health", nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var rep api.HealthResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+		idx := slices.IndexFunc(rep.Health.Checks, func(c api.Check) bool {
+			return c.Name == "beacon store size"
+		})
+		require.NotEqual(t, -1, idx)
+		return rep.Health.Checks[idx].Data["beacons"]
+	}
+
+	assert.Nil(t, beaconCount())
+	assert.Equal(t, float64(10), beaconCount())
+	assert.Equal(t, float64(10), beaconCount())
+	now = now.Add(time.Minute)
+	assert.Equal(t, float64(20), beaconCount())
+}
+
+func TestHealthCertificateExpiry(t *testing.T) {
+	now := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+	cert := func(ia string, expiresIn time.Duration) []*x509.Certificate {
+		return []*x509.Certificate{{
+			Subject: pkix.Name{Names: []pkix.AttributeTypeAndValue{
+				{Type: cppki.OIDNameIA, Value: ia},
+			}},
+			NotBefore: now.Add(-time.Hour),
+			NotAfter:  now.Add(expiresIn),
+		}}
+	}
+	testCases := map[string]struct {
+		Chains   [][]*x509.Certificate
+		Err      error
+		Window   time.Duration
+		Status   api.Status
+		Expected api.CheckData
+	}{
+		"none expiring": {
+			Chains: [][]*x509.Certificate{cert("1-ff00:0:110", 30*24*time.Hour)},
+			Status: api.Passing,
+			Expected: api.CheckData{
+				"expiring": float64(0),
+				"window":   "72h0m0s",
+			},
+		},
+		"superseded chain ignored": {
+			Chains: [][]*x509.Certificate{
+				cert("1-ff00:0:110", time.Hour),
+				cert("1-ff00:0:110", 30*24*time.Hour),
+			},
+			Status: api.Passing,
+			Expected: api.CheckData{
+				"expiring": float64(0),
+				"window":   "72h0m0s",
+			},
+		},
+		"expiring": {
+			Chains: [][]*x509.Certificate{
+				cert("1-ff00:0:110", 48*time.Hour),
+				cert("1-ff00:0:111", 24*time.Hour),
+				cert("1-ff00:0:112", 10*24*time.Hour),
+			},
+			Status: api.Degraded,
+			Expected: api.CheckData{
+				"expiring":       float64(2),
+				"window":         "72h0m0s",
+				"soonest_expiry": "2021-01-02T08:00:00Z",
+				"soonest_isd_as": "1-ff00:0:111",
+			},
+		},
+		"configured window": {
+			Chains: [][]*x509.Certificate{
+				cert("1-ff00:0:110", 48*time.Hour),
+				cert("1-ff00:0:111", 24*time.Hour),
+			},
+			Window: 12 * time.Hour,
+			Status: api.Passing,
+			Expected: api.CheckData{
+				"expiring": float64(0),
+				"window":   "12h0m0s",
+			},
+		},
+		"error": {
+			Err:    serrors.New("internal"),
+			Status: api.Degraded,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := mock_mgmtapi.NewMockHealther(ctrl)
+			h.EXPECT().GetSignerHealth(gomock.Any()).Return(api.SignerHealthData{
+				Expiration: time.Now().Add(30 * 24 * time.Hour),
+			}).AnyTimes()
+			h.EXPECT().GetTRCHealth(gomock.Any()).Return(api.TRCHealthData{}).AnyTimes()
+			h.EXPECT().GetCAHealth(gomock.Any()).Return(api.Unavailable, false).AnyTimes()
+			db := mock_storage.NewMockTrustDB(ctrl)
+			db.EXPECT().Chains(gomock.Any(), trust.ChainQuery{
+				Validity: cppki.Validity{NotBefore: now, NotAfter: now},
+			}).Return(tc.Chains, tc.Err)
+			s := &api.Server{
+				Healther:                h,
+				TrustDB:                 db,
+				CertificateExpiryWindow: tc.Window,
+			}
+			s.SetNowProvider(func() time.Time { return now })
+			check := func() api.Check {
+				rr := httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodGet, "/health", nil)
+				api.Handler(s).ServeHTTP(rr, req)
+				require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+				var rep api.HealthResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+				idx := slices.IndexFunc(rep.Health.Checks, func(c api.Check) bool {
+					return c.Name == "AS certificates expiry"
+				})
+				require.NotEqual(t, -1, idx)
+				return rep.Health.Checks[idx]
+			}
+
+			got := check()
+			assert.Equal(t, tc.Status, got.Status)
+			if tc.Expected != nil {
+				assert.Equal(t, tc.Expected, got.Data)
+			}
+			if tc.Err == nil {
+				// The chains are cached, the trust database is queried once.
+				assert.Equal(t, got, check())
+			}
+		})
+	}
+}
+
+func genCrypto(t *testing.T) string {
+	dir := t.TempDir()
+
+	var buf bytes.Buffer
+	cmd := testcrypto.Cmd(command.StringPather(""))
+	cmd.SetArgs([]string{
+		"-t", "testdata/golden.topo",
+		"-o", dir,
+		"--isd-dir",
+		"--as-validity", "1y",
+	})
+	cmd.SetOutput(&buf)
+	err := cmd.Execute()
+	require.NoError(t, err, buf.String())
+	return dir
+}
+
+func TestCBORResponses(t *testing.T) {
+	beacons := createBeacons(t)
+	testCases := map[string]struct {
+		Handler    func(ctrl *gomock.Controller) http.Handler
+		RequestURL string
+		Response   any
+	}{
+		"beacons": {
+			Handler: func(ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).AnyTimes().Return(beacons, nil)
+				return api.Handler(&api.Server{Beacons: bs})
+			},
+			RequestURL: "/beacons",
+			Response:   &map[string][]api.Beacon{},
+		},
+		"beacon": {
+			Handler: func(ctrl *gomock.Controller) http.Handler {
+				bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+				bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).AnyTimes().Return(
+					beacons[:1], nil,
+				)
+				return api.Handler(&api.Server{Beacons: bs})
+			},
+			RequestURL: "/beacons/" + hex.EncodeToString(beacons[0].Beacon.Segment.ID()),
+			Response:   &api.BeaconGetResponseJson{},
+		},
+		"signer": {
+			Handler: func(ctrl *gomock.Controller) http.Handler {
+				g := mock_trust.NewMockSignerGen(ctrl)
+				g.EXPECT().Generate(gomock.Any()).AnyTimes().Return(rolloverSigners(), nil)
+				s := &api.Server{Signer: cstrust.RenewingSigner{SignerGen: g}}
+				now := rolloverSigners()[0].ChainValidity.NotBefore.Add(time.Minute)
+				s.SetNowProvider(func() time.Time { return now })
+				return api.Handler(s)
+			},
+			RequestURL: "/signer",
+			Response:   &api.Signer{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			handler := tc.Handler(gomock.NewController(t))
+			get := func(accept string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodGet, tc.RequestURL, nil)
+				req.Header.Set("Accept", accept)
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, req)
+				require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+				return rr
+			}
+
+			// The CBOR response must have the same content as the JSON one.
+			rr := get("application/json")
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), tc.Response))
+			expected, err := mgmtapi.MarshalCBOR(tc.Response)
+			require.NoError(t, err)
+
+			rr = get("application/cbor")
+			assert.Equal(t, "application/cbor", rr.Header().Get("Content-Type"))
+			assert.Equal(t, expected, rr.Body.Bytes())
+		})
+	}
+}
+
+func TestGetBeaconText(t *testing.T) {
+	beacons := createBeacons(t)
+	ctrl := gomock.NewController(t)
+	bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+	bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).AnyTimes().Return(beacons[:1], nil)
+	handler := api.Handler(&api.Server{Beacons: bs})
+	segment := beacons[0].Beacon.Segment
+
+	testCases := map[string]struct {
+		Accept      string
+		ContentType string
+	}{
+		"text":           {Accept: api.SegmentTextContentType, ContentType: api.SegmentTextContentType},
+		"json preferred": {Accept: "text/vnd.scion.segment;q=0.5, application/json"},
+		"default":        {},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet,
+				"/beacons/"+hex.EncodeToString(segment.ID()), nil)
+			if tc.Accept != "" {
+				req.Header.Set("Accept", tc.Accept)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			if tc.ContentType == "" {
+				assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+				return
+			}
+			assert.Equal(t, tc.ContentType, rr.Header().Get("Content-Type"))
+			assert.Equal(t, segment.String()+"\n", rr.Body.String())
+		})
+	}
+}
+
+func TestQueryTimeout(t *testing.T) {
+	// The stores block until the query context expires.
+	blockBeacons := func(ctx context.Context, _ *beacon.QueryParams) ([]beacon.Beacon, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	blockSegments := func(ctx context.Context, _ *query.Params) (query.Results, error) {
+		<-ctx.Done()
+		return nil, serrors.Wrap("querying segments", ctx.Err())
+	}
+	id := hex.EncodeToString(createBeacons(t)[0].Beacon.Segment.ID())
+	testCases := map[string]struct {
+		Method string
+		URL    string
+		Body   string
+	}{
+		"beacons":              {URL: "/beacons"},
+		"beacon":               {URL: "/beacons/" + id},
+		"beacon blob":          {URL: "/beacons/" + id + "/blob"},
+		"beacon segments":      {URL: "/beacons/" + id + "/segments"},
+		"beacon sla":           {URL: "/beacons/sla"},
+		"beacon stats":         {URL: "/beacons/stats"},
+		"beacon heartbeat":     {URL: "/beacons/heartbeat"},
+		"beacon age histogram": {URL: "/beacons/age-histogram"},
+		"beacon cover":         {URL: "/beacons/cover"},
+		"beacon anomalies":     {URL: "/beacons/anomalies"},
+		"selected beacons":     {URL: "/beacons/selected"},
+		"interfaces":           {URL: "/interfaces"},
+		"reconcile beacons": {
+			Method: http.MethodPost,
+			URL:    "/beacons/reconcile",
+			Body:   `{"ids": ["` + id + `"]}`,
+		},
+		"delete beacons": {Method: http.MethodDelete, URL: "/beacons?all=true"},
+		"delete beacon":  {Method: http.MethodDelete, URL: "/beacons/" + id},
+		"segments":       {URL: "/segments"},
+		"segment":        {URL: "/segments/" + id},
+		"segment blob":   {URL: "/segments/" + id + "/blob"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+			bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(blockBeacons)
+			bs.EXPECT().Stats(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(ctx context.Context, _ *beacon.QueryParams) (beacon.Stats, error) {
+					<-ctx.Done()
+					return beacon.Stats{}, ctx.Err()
+				},
+			)
+			bs.EXPECT().DeleteBeacons(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(ctx context.Context, _ *beacon.QueryParams) (int, error) {
+					<-ctx.Done()
+					return 0, ctx.Err()
+				},
+			)
+			bs.EXPECT().DeleteBeacon(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(ctx context.Context, _ string) error {
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			)
+			selector := mock_mgmtapi.NewMockBeaconSelector(ctrl)
+			selector.EXPECT().SelectedBeacons(gomock.Any()).AnyTimes().DoAndReturn(
+				func(ctx context.Context) ([]beaconlib.Selection, error) {
+					<-ctx.Done()
+					return nil, ctx.Err()
+				},
+			)
+			ss := mock_api.NewMockSegmentStore(ctrl)
+			ss.EXPECT().Get(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(blockSegments)
+			s := &api.Server{
+				Beacons:        bs,
+				BeaconSelector: selector,
+				SegmentsServer: segapi.Server{Segments: ss},
+				Interfaces: func() map[iface.ID]topology.IFInfo {
+					return map[iface.ID]topology.IFInfo{1: {ID: 1}}
+				},
+				QueryTimeout: 10 * time.Millisecond,
+			}
+
+			method := tc.Method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, tc.URL, strings.NewReader(tc.Body))
+			rr := httptest.NewRecorder()
+			api.Handler(s).ServeHTTP(rr, req)
+			require.Equal(t, http.StatusServiceUnavailable, rr.Code, rr.Body.String())
+			var problem api.Problem
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+			assert.Equal(t, "database query timed out", problem.Title)
+		})
+	}
+
+	t.Run("no timeout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+		bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ *beacon.QueryParams) ([]beacon.Beacon, error) {
+				_, ok := ctx.Deadline()
+				assert.False(t, ok)
+				return nil, nil
+			},
+		)
+		req := httptest.NewRequest(http.MethodGet, "/beacons", nil)
+		rr := httptest.NewRecorder()
+		api.Handler(&api.Server{Beacons: bs}).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	})
+}
+
+func TestGetBeaconPeers(t *testing.T) {
+	b := createBeacons(t)[0]
+	segment := *b.Beacon.Segment
+	segment.ASEntries = slices.Clone(segment.ASEntries)
+	segment.ASEntries[1].PeerEntries = []seg.PeerEntry{
+		{
+			HopField:      seg.HopField{ConsIngress: 4, ConsEgress: 3},
+			Peer:          addr.MustParseIA("1-ff00:0:120"),
+			PeerInterface: 8,
+			PeerMTU:       1400,
 		},
-		"health ca unavailable": {
-			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
-				s := &api.Server{
-					Healther: h,
-				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(10 * time.Hour),
-						InGrace:       false,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: false,
-						TRCID: cppki.TRCID{
-							Base:   2,
-							Serial: 1,
-							ISD:    12,
-						},
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Unavailable, true,
-				)
-				return api.Handler(s)
+	}
+	segment.ASEntries[1].Extensions.StaticInfo = &staticinfo.Extension{
+		Latency: staticinfo.LatencyInfo{
+			Inter: map[iface.ID]time.Duration{4: 5 * time.Millisecond},
+		},
+	}
+	b.Beacon.Segment = &segment
+	ctrl := gomock.NewController(t)
+	bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+	bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).AnyTimes().Return(
+		[]beacon.Beacon{b}, nil,
+	)
+	handler := api.Handler(&api.Server{Beacons: bs})
+
+	hopType := func(typ api.HopType) *api.HopType { return &typ }
+	mtu := func(mtu int) *int { return &mtu }
+	latency, peerIA, peerInterface := 5.0, "1-ff00:0:120", 8
+	testCases := map[string]struct {
+		Query    string
+		Expected []api.Hop
+	}{
+		"default": {
+			Expected: []api.Hop{
+				{IsdAs: "1-ff00:0:110", Interface: 1, Mtu: mtu(1200)},
+				{IsdAs: "1-ff00:0:111", Interface: 2, Mtu: mtu(1200)},
+				{IsdAs: "1-ff00:0:111", Interface: 3},
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 10 * time.Hour,
-			Status:          200,
 		},
-		"health ca check not run": {
-			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
-				h := mock_mgmtapi.NewMockHealther(ctrl)
-				s := &api.Server{
-					Healther: h,
+		"include peers": {
+			Query: "?include_peers=true",
+			Expected: []api.Hop{
+				{IsdAs: "1-ff00:0:110", Interface: 1, Mtu: mtu(1200), Type: hopType(api.Core)},
+				{IsdAs: "1-ff00:0:111", Interface: 2, Mtu: mtu(1200), Type: hopType(api.Ingress)},
+				{
+					IsdAs:         "1-ff00:0:111",
+					Interface:     4,
+					Mtu:           mtu(1400),
+					Latency:       &latency,
+					PeerIsdAs:     &peerIA,
+					PeerInterface: &peerInterface,
+					Type:          hopType(api.Peer),
+				},
+				{IsdAs: "1-ff00:0:111", Interface: 3, Type: hopType(api.Egress)},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet,
+				"/beacons/"+hex.EncodeToString(segment.ID())+tc.Query, nil)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			var rep api.BeaconGetResponseJson
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+			assert.Equal(t, tc.Expected, rep.Beacon.Hops)
+		})
+	}
+}
+
+func TestGetBeaconsGeoJSON(t *testing.T) {
+	beacons := createBeacons(t)
+	// Only the first beacon carries geo coordinates, and only for the first
+	// two of its hops.
+	located := beacons[0]
+	segment := *located.Beacon.Segment
+	segment.ASEntries = slices.Clone(segment.ASEntries)
+	segment.ASEntries[0].Extensions.StaticInfo = &staticinfo.Extension{
+		Geo: staticinfo.GeoInfo{
+			1: {Latitude: 47.3769, Longitude: 8.5417, Address: "Zürich"},
+		},
+	}
+	segment.ASEntries[1].Extensions.StaticInfo = &staticinfo.Extension{
+		Geo: staticinfo.GeoInfo{
+			2: {Latitude: 46.948, Longitude: 7.4474},
+		},
+	}
+	located.Beacon.Segment = &segment
+
+	ctrl := gomock.NewController(t)
+	bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+	bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(
+		[]beacon.Beacon{located, beacons[1]}, nil,
+	)
+	req := httptest.NewRequest(http.MethodGet, "/beacons", nil)
+	req.Header.Set("Accept", api.GeoJSONContentType)
+	rr := httptest.NewRecorder()
+	api.Handler(&api.Server{Beacons: bs}).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, api.GeoJSONContentType, rr.Header().Get("Content-Type"))
+
+	id := segapi.SegID(&segment)
+	expected := fmt.Sprintf(`{
+		"type": "FeatureCollection",
+		"features": [
+			{
+				"type": "Feature",
+				"geometry": {"type": "LineString", "coordinates": [[8.5417, 47.3769], [7.4474, 46.948]]},
+				"properties": {"beacon_id": %[1]q}
+			},
+			{
+				"type": "Feature",
+				"geometry": {"type": "Point", "coordinates": [8.5417, 47.3769]},
+				"properties": {
+					"beacon_id": %[1]q,
+					"isd_as": "1-ff00:0:110",
+					"interface": 1,
+					"address": "Zürich"
 				}
-				h.EXPECT().GetSignerHealth(gomock.Any()).Return(
-					api.SignerHealthData{
-						SignerMissing: false,
-						Expiration:    now.Add(10 * time.Hour),
-						InGrace:       false,
-					},
-				)
-				h.EXPECT().GetTRCHealth(gomock.Any()).Return(
-					api.TRCHealthData{
-						TRCNotFound: false,
-						TRCID: cppki.TRCID{
-							Base:   2,
-							Serial: 1,
-							ISD:    12,
-						},
-					},
-				)
-				h.EXPECT().GetCAHealth(gomock.Any()).Return(
-					api.Unavailable, false,
-				)
-				return api.Handler(s)
 			},
-			RequestURL:      "/health",
-			TimestampOffset: 10 * time.Hour,
-			Status:          200,
+			{
+				"type": "Feature",
+				"geometry": {"type": "Point", "coordinates": [7.4474, 46.948]},
+				"properties": {"beacon_id": %[1]q, "isd_as": "1-ff00:0:111", "interface": 2}
+			}
+		]
+	}`, id)
+	assert.JSONEq(t, expected, rr.Body.String())
+}
+
+func TestGetBeaconsNDJSON(t *testing.T) {
+	beacons := createBeacons(t)
+	ids := []string{
+		segapi.SegID(beacons[0].Beacon.Segment),
+		segapi.SegID(beacons[1].Beacon.Segment),
+	}
+
+	testCases := map[string]struct {
+		Query      string
+		Expected   []string
+		TotalCount string
+		Trailer    string
+	}{
+		"all": {
+			Query:    "?sort=timestamp",
+			Expected: ids,
+		},
+		"paginated": {
+			Query:      "?sort=timestamp&offset=1&limit=1",
+			Expected:   ids[1:],
+			TotalCount: "2",
+			Trailer:    `{"total_count":2}`,
+		},
+		"warnings": {
+			Query:    "?sort=timestamp&valid_at=2100-01-01T00:00:00Z&future_ok=true",
+			Expected: ids,
+			Trailer: `{"warnings":["valid_at 2100-01-01T00:00:00Z is in the future, ` +
+				`only beacons that are still valid then are listed"]}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+			bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(beacons, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/beacons"+tc.Query, nil)
+			req.Header.Set("Accept", api.NDJSONContentType)
+			rr := httptest.NewRecorder()
+			api.Handler(&api.Server{Beacons: bs}).ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			assert.Equal(t, api.NDJSONContentType, rr.Header().Get("Content-Type"))
+			assert.Equal(t, tc.TotalCount, rr.Header().Get("X-Total-Count"))
+
+			lines := strings.Split(strings.TrimSuffix(rr.Body.String(), "\n"), "\n")
+			if tc.Trailer != "" {
+				assert.JSONEq(t, tc.Trailer, lines[len(lines)-1])
+				lines = lines[:len(lines)-1]
+			}
+			require.Len(t, lines, len(tc.Expected))
+			for i, line := range lines {
+				var b api.Beacon
+				require.NoError(t, json.Unmarshal([]byte(line), &b), line)
+				assert.Equal(t, tc.Expected[i], b.Id)
+			}
+		})
+	}
+}
+
+func TestGetBeaconsScore(t *testing.T) {
+	beacons := createBeacons(t)
+	// The first beacon announces a latency of 300ms and a bandwidth of
+	// 1Gbit/s, the second beacon announces neither.
+	announced := beacons[0]
+	segment := *announced.Beacon.Segment
+	segment.ASEntries = slices.Clone(segment.ASEntries)
+	segment.ASEntries[0].Extensions.StaticInfo = &staticinfo.Extension{
+		Latency: staticinfo.LatencyInfo{
+			Inter: map[iface.ID]time.Duration{1: 200 * time.Millisecond},
+		},
+		Bandwidth: staticinfo.BandwidthInfo{
+			Inter: map[iface.ID]uint64{1: 1000000},
 		},
 	}
+	segment.ASEntries[1].Extensions.StaticInfo = &staticinfo.Extension{
+		Latency: staticinfo.LatencyInfo{
+			Intra: map[iface.ID]time.Duration{2: 100 * time.Millisecond},
+		},
+		Bandwidth: staticinfo.BandwidthInfo{
+			Intra: map[iface.ID]uint64{2: 2000000},
+		},
+	}
+	announced.Beacon.Segment = &segment
+	// Half of the lifetime of the first beacon remains, the second beacon is
+	// not created yet and is thus fully valid.
+	now := segment.Info.Timestamp.Add(segment.MinExpiry().Sub(segment.Info.Timestamp) / 2)
 
+	testCases := map[string]struct {
+		Weights  api.BeaconScoreWeights
+		Expected []float64
+	}{
+		"default weights": {
+			// (0.5 + 0.5 + 0.25 + 0.5) / 4 and (0.5 + 1) / 2.
+			Expected: []float64{0.438, 0.75},
+		},
+		"custom weights": {
+			Weights: api.BeaconScoreWeights{Hops: 2, Validity: 1, Latency: 1, Bandwidth: -1},
+			// (2*0.5 + 0.5 + 0.25) / 4 and (2*0.5 + 1) / 3.
+			Expected: []float64{0.438, 0.667},
+		},
+		"latency only": {
+			Weights: api.BeaconScoreWeights{Latency: 1},
+			// The second beacon does not announce any latency.
+			Expected: []float64{0.25, 0},
+		},
+	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			t.Parallel()
 			ctrl := gomock.NewController(t)
+			bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+			bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(
+				[]beacon.Beacon{announced, beacons[1]}, nil,
+			)
+			s := &api.Server{Beacons: bs, BeaconScoreWeights: tc.Weights}
+			s.SetNowProvider(func() time.Time { return now })
 
-			req, err := http.NewRequest("GET", tc.RequestURL, nil)
-			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodGet, "/beacons?score=true&sort=timestamp", nil)
+			rr := httptest.NewRecorder()
+			api.Handler(s).ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			var rep struct {
+				Beacons []api.Beacon `json:"beacons"`
+			}
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+			require.Len(t, rep.Beacons, len(tc.Expected))
+			for i, expected := range tc.Expected {
+				require.NotNil(t, rep.Beacons[i].QualityScore)
+				assert.Equal(t, expected, *rep.Beacons[i].QualityScore)
+			}
+		})
+	}
+}
+
+func TestGetBeaconsHopMetadata(t *testing.T) {
+	beacons := createBeacons(t)
+	announced := beacons[0]
+	segment := *announced.Beacon.Segment
+	segment.ASEntries = slices.Clone(segment.ASEntries)
+	segment.ASEntries[0].Extensions.StaticInfo = &staticinfo.Extension{
+		Latency: staticinfo.LatencyInfo{
+			Inter: map[iface.ID]time.Duration{1: 2500 * time.Microsecond},
+		},
+	}
+	segment.ASEntries[1].Extensions.StaticInfo = &staticinfo.Extension{
+		Latency: staticinfo.LatencyInfo{
+			Intra: map[iface.ID]time.Duration{2: 100 * time.Millisecond},
+		},
+		Bandwidth: staticinfo.BandwidthInfo{
+			Intra: map[iface.ID]uint64{2: 2000000},
+		},
+	}
+	announced.Beacon.Segment = &segment
+
+	ctrl := gomock.NewController(t)
+	bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+	bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(
+		[]beacon.Beacon{announced}, nil,
+	).Times(2)
+	s := &api.Server{Beacons: bs}
+
+	mtu := segment.ASEntries[1].HopEntry.IngressMTU
+	expected := []api.Hop{
+		{Interface: 1, IsdAs: "1-ff00:0:110", Mtu: &mtu, Latency: ptr.To(2.5)},
+		{
+			Interface: 2,
+			IsdAs:     "1-ff00:0:111",
+			Mtu:       &mtu,
+			Latency:   ptr.To(100.0),
+			Bandwidth: ptr.To(2000000),
+		},
+		// The egress interface of the last AS entry announces nothing.
+		{Interface: 3, IsdAs: "1-ff00:0:111"},
+	}
+	t.Run("list", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/beacons", nil)
+		rr := httptest.NewRecorder()
+		api.Handler(s).ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var rep struct {
+			Beacons []api.Beacon `json:"beacons"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+		require.Len(t, rep.Beacons, 1)
+		assert.Equal(t, expected, rep.Beacons[0].Hops)
+	})
+	t.Run("get", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/beacons/"+segapi.SegID(&segment), nil)
+		rr := httptest.NewRecorder()
+		api.Handler(s).ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var rep api.BeaconGetResponseJson
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+		assert.Equal(t, expected, rep.Beacon.Hops)
+	})
+}
+
+func TestGetBeaconProtobuf(t *testing.T) {
+	beacons := createBeacons(t)
+	ctrl := gomock.NewController(t)
+	bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+	bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(beacons[:1], nil)
+	segment := beacons[0].Beacon.Segment
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/beacons/"+hex.EncodeToString(segment.ID()), nil)
+	req.Header.Set("Accept", segapi.ProtobufContentType)
+	rr := httptest.NewRecorder()
+	api.Handler(&api.Server{Beacons: bs}).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, segapi.ProtobufContentType, rr.Header().Get("Content-Type"))
+
+	expected, err := beaconlib.PackBeacon(segment)
+	require.NoError(t, err)
+	assert.Equal(t, expected, rr.Body.Bytes())
+}
+
+func TestGetBeaconBlobContentNegotiation(t *testing.T) {
+	beacons := createBeacons(t)
+	segment := beacons[0].Beacon.Segment
+	raw, err := beaconlib.PackBeacon(segment)
+	require.NoError(t, err)
+	pemBlob := pem.EncodeToMemory(&pem.Block{Type: "PATH SEGMENT", Bytes: raw})
+
+	testCases := map[string]struct {
+		Accept      string
+		Status      int
+		ContentType string
+		Expected    []byte
+	}{
+		"default": {
+			Status:      http.StatusOK,
+			ContentType: segapi.PEMContentType,
+			Expected:    pemBlob,
+		},
+		"pem": {
+			Accept:      segapi.PEMContentType,
+			Status:      http.StatusOK,
+			ContentType: segapi.PEMContentType,
+			Expected:    pemBlob,
+		},
+		"protobuf": {
+			Accept:      segapi.ProtobufContentType,
+			Status:      http.StatusOK,
+			ContentType: segapi.ProtobufContentType,
+			Expected:    raw,
+		},
+		"unsupported": {
+			Accept:      "application/json",
+			Status:      http.StatusNotAcceptable,
+			ContentType: "application/problem+json",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+			bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(beacons[:1], nil).AnyTimes()
 
+			req := httptest.NewRequest(http.MethodGet,
+				"/beacons/"+hex.EncodeToString(segment.ID())+"/blob", nil)
+			if tc.Accept != "" {
+				req.Header.Set("Accept", tc.Accept)
+			}
 			rr := httptest.NewRecorder()
-			tc.Handler(t, ctrl).ServeHTTP(rr, req)
+			api.Handler(&api.Server{Beacons: bs}).ServeHTTP(rr, req)
+			require.Equal(t, tc.Status, rr.Code, rr.Body.String())
+			assert.Equal(t, tc.ContentType, rr.Header().Get("Content-Type"))
+			if tc.Expected != nil {
+				assert.Equal(t, tc.Expected, rr.Body.Bytes())
+			}
+		})
+	}
+}
 
-			assert.Equal(t, tc.Status, rr.Result().StatusCode)
+func TestGetBeaconIncludeBlob(t *testing.T) {
+	beacons := createBeacons(t)
+	segment := beacons[0].Beacon.Segment
+	expected, err := beaconlib.PackBeacon(segment)
+	require.NoError(t, err)
 
-			if tc.IgnoreResponseBody {
+	testCases := map[string]struct {
+		Query    string
+		Expected []byte
+	}{
+		"default": {},
+		"disabled": {
+			Query: "?include_blob=false",
+		},
+		"enabled": {
+			Query:    "?include_blob=true",
+			Expected: expected,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bs := mock_mgmtapi.NewMockBeaconStore(ctrl)
+			bs.EXPECT().GetBeacons(gomock.Any(), gomock.Any()).Return(beacons[:1], nil)
+
+			req := httptest.NewRequest(http.MethodGet,
+				"/beacons/"+hex.EncodeToString(segment.ID())+tc.Query, nil)
+			rr := httptest.NewRecorder()
+			api.Handler(&api.Server{Beacons: bs}).ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			var rep api.BeaconGetResponseJson
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rep))
+			assert.Equal(t, segapi.SegID(segment), rep.Beacon.Id)
+			if tc.Expected == nil {
+				assert.Nil(t, rep.Raw)
 				return
 			}
-			expiresAt := now.Add(tc.TimestampOffset).Format(time.RFC3339)
-			goldenFile := "testdata/" + xtest.SanitizedName(t)
-			if *update {
-				raw := strings.ReplaceAll(rr.Body.String(), expiresAt, "EXPIRES_AT")
-				require.NoError(t, os.WriteFile(goldenFile, []byte(raw), 0o666))
+			require.NotNil(t, rep.Raw)
+			assert.Equal(t, tc.Expected, *rep.Raw)
+		})
+	}
+}
+
+func TestWriteBeacons(t *testing.T) {
+	testCases := map[string]struct {
+		Beacons    int
+		TotalCount *int
+		NextCursor *string
+		Warnings   []string
+	}{
+		"empty":    {Beacons: 0},
+		"single":   {Beacons: 1},
+		"multiple": {Beacons: 3},
+		"flushed":  {Beacons: 130},
+		"boundary": {Beacons: 64},
+		"warnings": {Beacons: 2, Warnings: []string{"first", "second"}},
+		"only warnings": {
+			Beacons:  0,
+			Warnings: []string{"first"},
+		},
+		"total count": {
+			Beacons:    2,
+			TotalCount: ptr.To(5),
+			Warnings:   []string{"first"},
+		},
+		"only total count": {
+			Beacons:    0,
+			TotalCount: ptr.To(5),
+		},
+		"next cursor": {
+			Beacons:    2,
+			TotalCount: ptr.To(5),
+			NextCursor: ptr.To("eyJpZCI6ImZvbyJ9"),
+			Warnings:   []string{"first"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			beacons := make([]*api.Beacon, 0, tc.Beacons)
+			for i := 0; i < tc.Beacons; i++ {
+				beacons = append(beacons, &api.Beacon{
+					Id:               fmt.Sprintf("%064x", i),
+					IngressInterface: i,
+					Hops:             []api.Hop{{Interface: i, IsdAs: "1-ff00:0:110"}},
+					Usages:           api.BeaconUsages{api.Propagation},
+				})
 			}
-			goldenRaw, err := os.ReadFile(goldenFile)
+			var expected strings.Builder
+			enc := json.NewEncoder(&expected)
+			enc.SetIndent("", "    ")
+			require.NoError(t, enc.Encode(struct {
+				Beacons    []*api.Beacon `json:"beacons"`
+				TotalCount *int          `json:"total_count,omitempty"`
+				NextCursor *string       `json:"next_cursor,omitempty"`
+				Warnings   []string      `json:"warnings,omitempty"`
+			}{
+				Beacons:    beacons,
+				TotalCount: tc.TotalCount,
+				NextCursor: tc.NextCursor,
+				Warnings:   tc.Warnings,
+			}))
+
+			rr := httptest.NewRecorder()
+			written, err := api.WriteBeacons(rr, beacons, tc.TotalCount, tc.NextCursor,
+				tc.Warnings)
 			require.NoError(t, err)
-			golden := strings.ReplaceAll(string(goldenRaw), "EXPIRES_AT", expiresAt)
-			assert.Equal(t, golden, rr.Body.String())
+			assert.True(t, written)
+			assert.Equal(t, expected.String(), rr.Body.String())
 		})
 	}
 }
@@ -986,11 +5131,137 @@ func createBeacons(t *testing.T) []beacon.Beacon {
 	}
 }
 
+// withMTU returns a copy of the beacon in which all AS entries have the given
+// MTU.
+func withMTU(b beacon.Beacon, mtu int) beacon.Beacon {
+	s := *b.Beacon.Segment
+	s.ASEntries = slices.Clone(s.ASEntries)
+	for i := range s.ASEntries {
+		s.ASEntries[i].MTU = mtu
+	}
+	b.Beacon.Segment = &s
+	return b
+}
+
+// registeredSegments returns two segments that start at the first AS of the
+// beacon. Only the first segment was registered from the beacon, the second
+// one leaves the first AS on a different interface.
+func registeredSegments(b *seg.PathSegment) query.Results {
+	extend := func(egress uint16) *seg.PathSegment {
+		entries := slices.Clone(b.ASEntries)
+		entries[0].HopEntry.HopField.ConsEgress = egress
+		entries = append(entries, seg.ASEntry{
+			Local: addr.MustParseIA("1-ff00:0:112"),
+			HopEntry: seg.HopEntry{
+				HopField: seg.HopField{
+					ConsIngress: 4,
+				},
+			},
+		})
+		return &seg.PathSegment{Info: b.Info, ASEntries: entries}
+	}
+	return query.Results{
+		{Seg: extend(b.ASEntries[0].HopEntry.HopField.ConsEgress)},
+		{Seg: extend(9)},
+	}
+}
+
+// rolloverSigners returns the signers of an AS during a key rollover. The
+// signers are neither ordered by expiration nor by the start of validity.
+// topologyInterfaces returns a topology that only knows the ingress interface
+// of the first beacon created by createBeacons.
+func topologyInterfaces() map[iface.ID]topology.IFInfo {
+	return map[iface.ID]topology.IFInfo{
+		2: {
+			ID:       2,
+			IA:       addr.MustParseIA("1-ff00:0:111"),
+			LinkType: topology.Child,
+		},
+	}
+}
+
+func rolloverSigners() []trust.Signer {
+	start := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+	signer := func(serial int, notBefore, notAfter time.Time) trust.Signer {
+		return trust.Signer{
+			IA:           addr.MustParseIA("1-ff00:0:110"),
+			Algorithm:    signed.ECDSAWithSHA256,
+			SubjectKeyID: []byte{byte(serial)},
+			TRCID: cppki.TRCID{
+				ISD:    1,
+				Serial: 1,
+				Base:   1,
+			},
+			Expiration: notAfter,
+			ChainValidity: cppki.Validity{
+				NotBefore: notBefore,
+				NotAfter:  notAfter,
+			},
+		}
+	}
+	return []trust.Signer{
+		signer(2, start.Add(24*time.Hour), start.Add(96*time.Hour)),
+		signer(1, start, start.Add(48*time.Hour)),
+		signer(3, start.Add(12*time.Hour), start.Add(72*time.Hour)),
+	}
+}
+
+// signBeacons returns copies of the beacons with the AS entries signed with
+// ECDSA-SHA256. The remaining beacons are appended unsigned.
+func signBeacons(t *testing.T, toSign []beacon.Beacon) []beacon.Beacon {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	var res []beacon.Beacon
+	for _, b := range toSign {
+		s := *b.Beacon.Segment
+		s.ASEntries = append([]seg.ASEntry(nil), s.ASEntries...)
+		for i, as := range s.ASEntries {
+			body, err := proto.Marshal(&cppb.ASEntrySignedBody{
+				IsdAs:    uint64(as.Local),
+				HopEntry: &cppb.HopEntry{HopField: &cppb.HopField{}},
+			})
+			require.NoError(t, err)
+			s.ASEntries[i].Signed, err = signed.Sign(
+				signed.Header{SignatureAlgorithm: signed.ECDSAWithSHA256},
+				body,
+				key,
+			)
+			require.NoError(t, err)
+		}
+		b.Beacon.Segment = &s
+		res = append(res, b)
+	}
+	return append(res, createBeacons(t)[len(toSign):]...)
+}
+
 type queryMatcher struct {
 	query        *beacon.QueryParams
 	creationTime time.Time
 }
 
+// beaconStats aggregates the beacons the way the beacon store does.
+func beaconStats(beacons ...beacon.Beacon) beacon.Stats {
+	stats := beacon.Stats{
+		Usages:            make(map[beaconlib.Usage]int),
+		IngressInterfaces: make(map[uint16]int),
+		Origins:           make(map[addr.IA]int),
+	}
+	for _, b := range beacons {
+		stats.Total++
+		stats.Usages[b.Usage]++
+		stats.IngressInterfaces[b.Beacon.InIfID]++
+		stats.Origins[b.Beacon.Segment.FirstIA()]++
+		created := b.Beacon.Segment.Info.Timestamp.Truncate(time.Second)
+		if stats.Oldest.IsZero() || created.Before(stats.Oldest) {
+			stats.Oldest = created
+		}
+		if created.After(stats.Newest) {
+			stats.Newest = created
+		}
+	}
+	return stats
+}
+
 // matchQuery creates a matcher that matches the QueryParams with validAt time
 // that needs to be within 10s of the creation.
 func matchQuery(q *beacon.QueryParams) gomock.Matcher {
@@ -19,3 +19,17 @@ import "time"
 func (s *Server) SetNowProvider(nowProvider func() time.Time) {
 	s.nowProvider = nowProvider
 }
+
+func (c *IdempotencyCache) SetNowProvider(nowProvider func() time.Time) {
+	c.nowProvider = nowProvider
+}
+
+func (c *ResponseCache) SetNowProvider(nowProvider func() time.Time) {
+	c.nowProvider = nowProvider
+}
+
+func (s *Server) SetHealthPollInterval(interval time.Duration) {
+	s.healthPollInterval = interval
+}
+
+var WriteBeacons = writeBeacons
@@ -0,0 +1,52 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"sync"
+	"time"
+)
+
+// healthCacheTTL is the duration for which the results of the expensive
+// health checks are reused. Health probes are not limited by the concurrency
+// limit, hence they must not hit the databases on every call.
+const healthCacheTTL = 10 * time.Second
+
+// healthCache caches the result of a health check query. Only successful
+// results are cached, such that a failed query is retried by the next health
+// request. Concurrent requests for an expired result are served by a single
+// query. The zero value is ready to use.
+type healthCache[T any] struct {
+	mtx     sync.Mutex
+	value   T
+	expires time.Time
+}
+
+// get returns the cached result if it is still valid at now. Otherwise, it
+// queries and caches a new result.
+func (c *healthCache[T]) get(now time.Time, query func() (T, error)) (T, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if now.Before(c.expires) {
+		return c.value, nil
+	}
+	value, err := query()
+	if err != nil {
+		return value, err
+	}
+	c.value, c.expires = value, now.Add(healthCacheTTL)
+	return value, nil
+}
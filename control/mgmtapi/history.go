@@ -0,0 +1,70 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultHealthHistorySize is the number of health check status transitions
+// that are retained if no size is configured.
+const defaultHealthHistorySize = 256
+
+// healthHistory is a bounded log of health check status transitions. The
+// zero value is ready to use.
+type healthHistory struct {
+	mtx    sync.Mutex
+	last   map[string]Status
+	events []HealthEvent
+}
+
+// record appends an event for every check whose status differs from the last
+// recorded status of that check. Only the most recent size events are kept.
+func (h *healthHistory) record(now time.Time, checks []Check, size int) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.last == nil {
+		h.last = make(map[string]Status)
+	}
+	for _, c := range checks {
+		prev, ok := h.last[c.Name]
+		if ok && prev == c.Status {
+			continue
+		}
+		e := HealthEvent{
+			Timestamp: now.UTC(),
+			Check:     c.Name,
+			Status:    c.Status,
+		}
+		if ok {
+			e.PreviousStatus = &prev
+		}
+		h.events = append(h.events, e)
+		h.last[c.Name] = c.Status
+	}
+	if len(h.events) > size {
+		h.events = append(h.events[:0], h.events[len(h.events)-size:]...)
+	}
+}
+
+// list returns a copy of the recorded events, oldest first.
+func (h *healthHistory) list() []HealthEvent {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	return append(make([]HealthEvent, 0, len(h.events)), h.events...)
+}
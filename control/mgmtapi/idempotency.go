@@ -0,0 +1,284 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	api "github.com/scionproto/scion/private/mgmtapi"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header that identifies retries of
+	// the same write request.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses that are replayed from the
+	// idempotency cache instead of executing the request again.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	defaultIdempotencyTTL  = 5 * time.Minute
+	defaultIdempotencySize = 1024
+)
+
+// IdempotencyCache remembers the responses to write requests that carry an
+// Idempotency-Key header, such that retries of the request return the original
+// response instead of executing the request again. Responses with a server
+// error status are not remembered, such that the request can be retried. The
+// zero value is ready to use.
+type IdempotencyCache struct {
+	// TTL is the duration for which a response is replayed. If it is not
+	// positive, a default of five minutes is used.
+	TTL time.Duration
+	// Size is the maximum number of remembered responses. If it is not
+	// positive, a default size is used.
+	Size int
+
+	// nowProvider can be set during tests to control the current time.
+	nowProvider func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*idempotentEntry
+	// order contains the keys of the entries in insertion order.
+	order []string
+}
+
+type idempotentEntry struct {
+	// fingerprint is the hash of the request that created the entry.
+	fingerprint [sha256.Size]byte
+	created     time.Time
+	// done indicates that the response is available. Until then, the request
+	// is still being executed.
+	done   bool
+	status int
+	header http.Header
+	body   []byte
+}
+
+// Middleware applies the idempotency cache to write requests. Read requests
+// and requests without an Idempotency-Key header are passed through.
+func (c *IdempotencyCache) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		idempotencyKey := r.Header.Get(IdempotencyKeyHeader)
+		if idempotencyKey == "" || !isWriteMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			if requestTooLarge(w, err) {
+				return
+			}
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusBadRequest,
+				Title:  "unable to read request body",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		// The key is scoped to the endpoint, such that reusing a key for a
+		// different endpoint never replays an unrelated response.
+		key := r.Method + " " + r.URL.Path + " " + idempotencyKey
+		fingerprint := sha256.Sum256(append([]byte(r.URL.RawQuery+"\n"), body...))
+		entry, fresh := c.lookup(key, fingerprint)
+		switch {
+		case entry == nil:
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef("the idempotency key was used for a different request"),
+				Status: http.StatusUnprocessableEntity,
+				Title:  "idempotency key reused",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		case !fresh && !entry.done:
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef("a request with the same idempotency key is in progress"),
+				Status: http.StatusConflict,
+				Title:  "request in progress",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		case !fresh:
+			for name, values := range entry.header {
+				w.Header()[name] = values
+			}
+			w.Header().Set(IdempotentReplayedHeader, "true")
+			w.WriteHeader(entry.status)
+			_, _ = w.Write(entry.body)
+			return
+		}
+
+		rec := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+		completed := false
+		defer func() {
+			// If the handler panics, the entry is removed such that the
+			// request can be retried. The panic is passed on to the outer
+			// handlers.
+			if !completed {
+				c.remove(key, entry)
+			}
+		}()
+		next.ServeHTTP(rec, r)
+		completed = true
+		c.complete(key, entry, rec)
+	})
+}
+
+// lookup returns the entry for the key. If there is no valid entry, a new
+// pending entry is inserted and returned as fresh. An existing entry is
+// returned as a copy taken under the lock, such that it can be read while the
+// original request completes. If the existing entry was created by a
+// different request, nil is returned.
+func (c *IdempotencyCache) lookup(
+	key string,
+	fingerprint [sha256.Size]byte,
+) (*idempotentEntry, bool) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	c.expire(now)
+	if entry, ok := c.entries[key]; ok {
+		if entry.fingerprint != fingerprint {
+			return nil, false
+		}
+		snapshot := *entry
+		return &snapshot, false
+	}
+	if c.entries == nil {
+		c.entries = make(map[string]*idempotentEntry)
+	}
+	size := c.Size
+	if size <= 0 {
+		size = defaultIdempotencySize
+	}
+	for len(c.order) >= size {
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+	entry := &idempotentEntry{fingerprint: fingerprint, created: now}
+	c.entries[key] = entry
+	c.order = append(c.order, key)
+	return entry, true
+}
+
+// complete stores the recorded response in the entry, or removes the entry if
+// the response must not be replayed.
+func (c *IdempotencyCache) complete(key string, entry *idempotentEntry, rec *recordingWriter) {
+	if rec.status >= http.StatusInternalServerError {
+		c.remove(key, entry)
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.done = true
+	entry.status = rec.status
+	entry.header = rec.Header().Clone()
+	entry.body = rec.body.Bytes()
+}
+
+// remove removes the entry of the key, unless it was already replaced by a
+// newer entry.
+func (c *IdempotencyCache) remove(key string, entry *idempotentEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[key] != entry {
+		return
+	}
+	delete(c.entries, key)
+	for i, k := range c.order {
+		if k == key {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// expire removes the entries that are older than the TTL. Entries are expired
+// in insertion order, which is also the order of their creation time.
+func (c *IdempotencyCache) expire(now time.Time) {
+	ttl := c.TTL
+	if ttl <= 0 {
+		ttl = defaultIdempotencyTTL
+	}
+	for len(c.order) > 0 {
+		entry := c.entries[c.order[0]]
+		if now.Sub(entry.created) < ttl {
+			return
+		}
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+}
+
+func (c *IdempotencyCache) now() time.Time {
+	if c.nowProvider != nil {
+		return c.nowProvider()
+	}
+	return time.Now()
+}
+
+// isWriteMethod indicates whether requests with the method modify state.
+func isWriteMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordingWriter records the response while passing it on to the client.
+type recordingWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush flushes the response to the client if the underlying writer supports
+// it, such that streamed responses are not held back by the recording.
+func (w *recordingWriter) Flush() {
+	w.wroteHeader = true
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying writer for http.ResponseController.
+func (w *recordingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,308 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/scionproto/scion/control/mgmtapi"
+)
+
+func TestIdempotencyCache(t *testing.T) {
+	type request struct {
+		Method string
+		Path   string
+		Key    string
+		Body   string
+		// Advance is added to the current time before the request is sent.
+		Advance time.Duration
+	}
+	type response struct {
+		Status   int
+		Body     string
+		Replayed bool
+	}
+
+	testCases := map[string]struct {
+		TTL       time.Duration
+		Size      int
+		Status    int
+		Requests  []request
+		Responses []response
+	}{
+		"replayed": {
+			Requests: []request{
+				{Method: http.MethodPost, Path: "/trcs", Key: "a", Body: "trc"},
+				{Method: http.MethodPost, Path: "/trcs", Key: "a", Body: "trc"},
+			},
+			Responses: []response{
+				{Status: http.StatusOK, Body: "call 1"},
+				{Status: http.StatusOK, Body: "call 1", Replayed: true},
+			},
+		},
+		"without key": {
+			Requests: []request{
+				{Method: http.MethodPost, Path: "/trcs", Body: "trc"},
+				{Method: http.MethodPost, Path: "/trcs", Body: "trc"},
+			},
+			Responses: []response{
+				{Status: http.StatusOK, Body: "call 1"},
+				{Status: http.StatusOK, Body: "call 2"},
+			},
+		},
+		"read request": {
+			Requests: []request{
+				{Method: http.MethodGet, Path: "/trcs", Key: "a"},
+				{Method: http.MethodGet, Path: "/trcs", Key: "a"},
+			},
+			Responses: []response{
+				{Status: http.StatusOK, Body: "call 1"},
+				{Status: http.StatusOK, Body: "call 2"},
+			},
+		},
+		"different keys": {
+			Requests: []request{
+				{Method: http.MethodDelete, Path: "/segments/1", Key: "a"},
+				{Method: http.MethodDelete, Path: "/segments/1", Key: "b"},
+			},
+			Responses: []response{
+				{Status: http.StatusOK, Body: "call 1"},
+				{Status: http.StatusOK, Body: "call 2"},
+			},
+		},
+		"different endpoints": {
+			Requests: []request{
+				{Method: http.MethodDelete, Path: "/segments/1", Key: "a"},
+				{Method: http.MethodDelete, Path: "/segments/2", Key: "a"},
+			},
+			Responses: []response{
+				{Status: http.StatusOK, Body: "call 1"},
+				{Status: http.StatusOK, Body: "call 2"},
+			},
+		},
+		"reused key": {
+			Requests: []request{
+				{Method: http.MethodPost, Path: "/trcs", Key: "a", Body: "trc"},
+				{Method: http.MethodPost, Path: "/trcs", Key: "a", Body: "other"},
+			},
+			Responses: []response{
+				{Status: http.StatusOK, Body: "call 1"},
+				{Status: http.StatusUnprocessableEntity},
+			},
+		},
+		"expired": {
+			TTL: time.Minute,
+			Requests: []request{
+				{Method: http.MethodPost, Path: "/trcs", Key: "a"},
+				{Method: http.MethodPost, Path: "/trcs", Key: "a", Advance: 30 * time.Second},
+				{Method: http.MethodPost, Path: "/trcs", Key: "a", Advance: 30 * time.Second},
+			},
+			Responses: []response{
+				{Status: http.StatusOK, Body: "call 1"},
+				{Status: http.StatusOK, Body: "call 1", Replayed: true},
+				{Status: http.StatusOK, Body: "call 2"},
+			},
+		},
+		"evicted": {
+			Size: 1,
+			Requests: []request{
+				{Method: http.MethodPost, Path: "/trcs", Key: "a"},
+				{Method: http.MethodPost, Path: "/trcs", Key: "b"},
+				{Method: http.MethodPost, Path: "/trcs", Key: "a"},
+			},
+			Responses: []response{
+				{Status: http.StatusOK, Body: "call 1"},
+				{Status: http.StatusOK, Body: "call 2"},
+				{Status: http.StatusOK, Body: "call 3"},
+			},
+		},
+		"client error replayed": {
+			Status: http.StatusBadRequest,
+			Requests: []request{
+				{Method: http.MethodPost, Path: "/trcs", Key: "a"},
+				{Method: http.MethodPost, Path: "/trcs", Key: "a"},
+			},
+			Responses: []response{
+				{Status: http.StatusBadRequest, Body: "call 1"},
+				{Status: http.StatusBadRequest, Body: "call 1", Replayed: true},
+			},
+		},
+		"server error not replayed": {
+			Status: http.StatusInternalServerError,
+			Requests: []request{
+				{Method: http.MethodPost, Path: "/trcs", Key: "a"},
+				{Method: http.MethodPost, Path: "/trcs", Key: "a"},
+			},
+			Responses: []response{
+				{Status: http.StatusInternalServerError, Body: "call 1"},
+				{Status: http.StatusInternalServerError, Body: "call 2"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+			cache := &api.IdempotencyCache{TTL: tc.TTL, Size: tc.Size}
+			cache.SetNowProvider(func() time.Time { return now })
+			status := tc.Status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			calls := 0
+			handler := cache.Middleware(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					// The handler must still see the full request body.
+					_, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					calls++
+					w.WriteHeader(status)
+					fmt.Fprintf(w, "call %d", calls)
+				},
+			))
+
+			require.Len(t, tc.Responses, len(tc.Requests))
+			for i, req := range tc.Requests {
+				now = now.Add(req.Advance)
+				r := httptest.NewRequest(req.Method, req.Path, strings.NewReader(req.Body))
+				if req.Key != "" {
+					r.Header.Set(api.IdempotencyKeyHeader, req.Key)
+				}
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, r)
+
+				expected := tc.Responses[i]
+				assert.Equal(t, expected.Status, rr.Code, "request %d", i)
+				if expected.Body != "" {
+					assert.Equal(t, expected.Body, rr.Body.String(), "request %d", i)
+				}
+				replayed := rr.Header().Get(api.IdempotentReplayedHeader) == "true"
+				assert.Equal(t, expected.Replayed, replayed, "request %d", i)
+			}
+		})
+	}
+}
+
+func TestIdempotencyCacheInProgress(t *testing.T) {
+	cache := &api.IdempotencyCache{}
+	started, release := make(chan struct{}), make(chan struct{})
+	handler := cache.Middleware(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+			w.WriteHeader(http.StatusNoContent)
+		},
+	))
+	send := func() *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodDelete, "/beacons/1", nil)
+		r.Header.Set(api.IdempotencyKeyHeader, "a")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, r)
+		return rr
+	}
+
+	first := make(chan *httptest.ResponseRecorder)
+	go func() { first <- send() }()
+	<-started
+	assert.Equal(t, http.StatusConflict, send().Code)
+	close(release)
+	assert.Equal(t, http.StatusNoContent, (<-first).Code)
+
+	rr := send()
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	assert.Equal(t, "true", rr.Header().Get(api.IdempotentReplayedHeader))
+}
+
+func TestIdempotencyCachePanic(t *testing.T) {
+	cache := &api.IdempotencyCache{}
+	calls := 0
+	handler := cache.Middleware(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				panic("handler failed")
+			}
+			w.WriteHeader(http.StatusNoContent)
+		},
+	))
+	send := func() *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodDelete, "/beacons/1", nil)
+		r.Header.Set(api.IdempotencyKeyHeader, "a")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, r)
+		return rr
+	}
+
+	assert.Panics(t, func() { send() })
+	// The retry is executed instead of being rejected as in progress.
+	rr := send()
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	assert.Empty(t, rr.Header().Get(api.IdempotentReplayedHeader))
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotencyCacheConcurrent(t *testing.T) {
+	cache := &api.IdempotencyCache{}
+	var calls atomic.Int32
+	handler := cache.Middleware(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			// Keep the request in progress for a moment, such that the
+			// concurrent requests observe both the pending and the
+			// completed entry.
+			time.Sleep(10 * time.Millisecond)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id":1}`)
+		},
+	))
+
+	const requests = 32
+	codes := make([]int, requests)
+	var wg sync.WaitGroup
+	for i := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := httptest.NewRequest(http.MethodPost, "/trcs", strings.NewReader("trc"))
+			r.Header.Set(api.IdempotencyKeyHeader, "a")
+			// Spread the requests over the lifetime of the first one.
+			time.Sleep(time.Duration(i) * time.Millisecond)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, r)
+			codes[i] = rr.Code
+			if rr.Code == http.StatusCreated {
+				assert.Equal(t, `{"id":1}`, rr.Body.String())
+			}
+		}()
+	}
+	wg.Wait()
+	// The request is executed once. The retries are either replayed or
+	// rejected while the request is in progress.
+	assert.EqualValues(t, 1, calls.Load())
+	for _, code := range codes {
+		assert.Contains(t, []int{http.StatusCreated, http.StatusConflict}, code)
+	}
+}
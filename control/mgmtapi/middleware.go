@@ -0,0 +1,380 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/scionproto/scion/pkg/log"
+	"github.com/scionproto/scion/pkg/metrics"
+	"github.com/scionproto/scion/pkg/private/prom"
+	api "github.com/scionproto/scion/private/mgmtapi"
+)
+
+// defaultMaxRequestBodySize is the maximum size of a request body if no limit
+// is configured.
+const defaultMaxRequestBodySize = 1 << 20
+
+type bodyLimitKey struct{}
+
+// LimitRequestBody is a middleware that limits the size of request bodies to
+// the configured maximum. Reading beyond the limit fails, and the request is
+// rejected with status 413. It must be installed before any middleware that
+// reads the request body.
+func (s *Server) LimitRequestBody(next http.Handler) http.Handler {
+	limit := s.MaxRequestBodySize
+	if limit <= 0 {
+		limit = defaultMaxRequestBodySize
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > limit {
+			requestTooLarge(w, &http.MaxBytesError{Limit: limit})
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		// The limit is remembered, such that it also applies to the
+		// decompressed request body.
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), bodyLimitKey{}, limit)))
+	})
+}
+
+// concurrencyRetryAfter is the delay after which a client should retry a
+// request that was rejected because of the concurrency limit.
+const concurrencyRetryAfter = time.Second
+
+// LimitConcurrency is a middleware that limits the number of requests that are
+// handled concurrently to the configured maximum. A request beyond the limit
+// waits for the configured queue timeout, and is rejected with status 503 and
+// a Retry-After header if no other request finishes in time. Health, liveness
+// and readiness probes are never limited, such that they keep working while the
+// API is overloaded. Long polls of the health, i.e., requests with the wait
+// parameter, are limited like all other requests, as they are held open.
+func (s *Server) LimitConcurrency(next http.Handler) http.Handler {
+	if s.MaxConcurrentRequests <= 0 {
+		return next
+	}
+	sem := make(chan struct{}, s.MaxConcurrentRequests)
+	timeout := s.ConcurrencyQueueTimeout
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isProbe(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !acquire(r.Context(), sem, timeout) {
+			w.Header().Set("Retry-After",
+				strconv.Itoa(int(concurrencyRetryAfter/time.Second)))
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(fmt.Sprintf(
+					"more than %d requests are in flight", cap(sem),
+				)),
+				Status: http.StatusServiceUnavailable,
+				Title:  "too many concurrent requests",
+				Type:   api.StringRef(api.ServiceUnavailable),
+			})
+			return
+		}
+		defer func() { <-sem }()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// acquire acquires a slot of the semaphore. It waits for at most the timeout,
+// or until the context is done. It reports whether the slot was acquired.
+func acquire(ctx context.Context, sem chan struct{}, timeout time.Duration) bool {
+	select {
+	case sem <- struct{}{}:
+		return true
+	default:
+	}
+	if timeout <= 0 {
+		return false
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case sem <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// isProbe indicates whether the request is a request to one of the health,
+// readiness or liveness endpoints that are polled by probes. Requests that wait
+// for a change of the health are not probes.
+func isProbe(r *http.Request) bool {
+	if r.URL.Query().Has("wait") {
+		return false
+	}
+	for _, probe := range []string{"/health", "/health/history", "/readyz", "/livez"} {
+		if strings.HasSuffix(r.URL.Path, probe) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddSecurityHeaders is a middleware that sets the standard security headers
+// on all responses if they are enabled. The headers are set before the request
+// is handled, such that they are also part of the problem responses. The
+// Content-Type of the response is not affected.
+func (s *Server) AddSecurityHeaders(next http.Handler) http.Handler {
+	if !s.SecurityHeaders {
+		return next
+	}
+	hsts := s.StrictTransportSecurity
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := w.Header()
+		h.Set("X-Content-Type-Options", "nosniff")
+		h.Set("X-Frame-Options", "DENY")
+		if hsts != "" {
+			h.Set("Strict-Transport-Security", hsts)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestTooLarge writes a problem with status 413 if the error indicates that
+// the request body exceeds the limit. It reports whether it did so.
+func requestTooLarge(w http.ResponseWriter, err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	if !errors.As(err, &maxBytesErr) {
+		return false
+	}
+	ErrorResponse(w, Problem{
+		Detail: api.StringRef(fmt.Sprintf(
+			"the request body exceeds the limit of %d bytes", maxBytesErr.Limit,
+		)),
+		Status: http.StatusRequestEntityTooLarge,
+		Title:  "request body too large",
+		Type:   api.StringRef(api.PayloadTooLarge),
+	})
+	return true
+}
+
+// DecompressRequestBody is a middleware that transparently decompresses request
+// bodies that are sent with "Content-Encoding: gzip". Requests with a malformed
+// gzip stream are rejected with a bad request problem.
+func DecompressRequestBody(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.EqualFold(r.Header.Get("Content-Encoding"), "gzip") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		raw, err := decompress(w, r)
+		if err != nil {
+			if requestTooLarge(w, err) {
+				return
+			}
+			ErrorResponse(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusBadRequest,
+				Title:  "malformed gzip request body",
+				Type:   api.StringRef(api.BadRequest),
+			})
+			return
+		}
+		r.Header.Del("Content-Encoding")
+		r.Header.Del("Content-Length")
+		r.ContentLength = int64(len(raw))
+		r.Body = io.NopCloser(bytes.NewReader(raw))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// decompress reads the full gzip stream, such that a corrupted stream is
+// detected before the request is handed to the handler. If the request body is
+// limited, the limit also applies to the decompressed body.
+func decompress(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	defer r.Body.Close()
+	zr, err := gzip.NewReader(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	var body io.ReadCloser = zr
+	if limit, ok := r.Context().Value(bodyLimitKey{}).(int64); ok {
+		body = http.MaxBytesReader(w, zr, limit)
+	}
+	return io.ReadAll(body)
+}
+
+// RecoverPanic is a middleware that recovers from panics in the handlers. The
+// panic is logged together with the request ID and the stack trace, and the
+// request is answered with a generic internal error problem. The details of the
+// panic are never part of the response. Panics with http.ErrAbortHandler are
+// passed on, such that the response is aborted as intended.
+func RecoverPanic(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+			log.FromCtx(r.Context()).Error("Management API handler panicked",
+				"request_id", middleware.GetReqID(r.Context()),
+				"method", r.Method,
+				"path", r.URL.Path,
+				"panic", rec,
+				"stack", string(debug.Stack()),
+			)
+			ErrorResponse(w, Problem{
+				Status: http.StatusInternalServerError,
+				Title:  "internal server error",
+				Type:   api.StringRef(api.InternalError),
+			})
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// otherHandler is the handler name that is reported for requests that do not
+// match an operation of the API, e.g., requests for the spec or unknown paths.
+const otherHandler = "other"
+
+// InstrumentHandlers is a middleware that reports the number of requests and
+// the time it takes to handle them, per handler and status class of the
+// response, e.g., "2xx". The handler is the name of the server method that
+// implements the requested operation, e.g., "GetBeacons". It should be
+// installed before RecoverPanic, such that recovered panics are reported as
+// internal errors.
+func (s *Server) InstrumentHandlers(next http.Handler) http.Handler {
+	if s.Requests == nil && s.RequestDuration == nil {
+		return next
+	}
+	handlers := handlerNames()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+		labels := requestLabels{
+			Handler: handlers.lookup(r),
+			Status:  statusClass(ww.Status()),
+		}
+		metrics.CounterInc(metrics.CounterWith(s.Requests, labels.Expand()...))
+		metrics.HistogramObserve(
+			metrics.HistogramWith(s.RequestDuration, labels.Expand()...),
+			time.Since(start).Seconds(),
+		)
+	})
+}
+
+// RequestsLabels exposes the labels required by the Requests and the
+// RequestDuration metrics.
+func RequestsLabels() []string {
+	return []string{"handler", prom.LabelStatus}
+}
+
+type requestLabels struct {
+	Handler string
+	Status  string
+}
+
+func (l requestLabels) Expand() []string {
+	return []string{
+		"handler", l.Handler,
+		prom.LabelStatus, l.Status,
+	}
+}
+
+// statusClass returns the class of the status code, e.g., "4xx" for 404. A
+// handler that does not write a header implicitly responds with 200.
+func statusClass(status int) string {
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return fmt.Sprintf("%dxx", status/100)
+}
+
+// operationHandlers maps the method and the path of the operations of the API,
+// e.g., "GET /beacons", to the names of the handlers that implement them.
+type operationHandlers map[string]string
+
+// handlerNames derives the handler names from the operation IDs in the spec,
+// the same way the server interface is generated, e.g., "get-beacons" is
+// handled by GetBeacons.
+func handlerNames() operationHandlers {
+	swagger, err := GetSwagger()
+	if err != nil {
+		panic(err)
+	}
+	handlers := make(operationHandlers)
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			var name strings.Builder
+			for _, part := range strings.FieldsFunc(op.OperationID, func(r rune) bool {
+				return r == '-' || r == '_'
+			}) {
+				name.WriteString(strings.ToUpper(part[:1]) + part[1:])
+			}
+			handlers[method+" "+path] = name.String()
+		}
+	}
+	return handlers
+}
+
+// lookup returns the name of the handler of the request.
+func (h operationHandlers) lookup(r *http.Request) string {
+	path, ok := h.path(r)
+	if !ok {
+		return otherHandler
+	}
+	return h[r.Method+" "+path]
+}
+
+// path returns the path of the operation that matches the request, as it is
+// listed in the spec, e.g., "/beacons/{segment-id}". The routes of the API are
+// usually mounted below a base URL, e.g., "/api/v1". Thus, the leading segments
+// of the matched route pattern are stripped until it matches the path of an
+// operation.
+func (h operationHandlers) path(r *http.Request) (string, bool) {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.Routes == nil {
+		return "", false
+	}
+	tctx := chi.NewRouteContext()
+	if !rctx.Routes.Match(tctx, r.Method, r.URL.Path) {
+		return "", false
+	}
+	for pattern := tctx.RoutePattern(); pattern != ""; {
+		if _, ok := h[r.Method+" "+pattern]; ok {
+			return pattern, true
+		}
+		_, rest, ok := strings.Cut(pattern[1:], "/")
+		if !ok {
+			break
+		}
+		pattern = "/" + rest
+	}
+	return "", false
+}
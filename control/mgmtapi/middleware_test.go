@@ -0,0 +1,397 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scionproto/scion/pkg/metrics"
+	api "github.com/scionproto/scion/private/mgmtapi"
+)
+
+func TestDecompressRequestBody(t *testing.T) {
+	compress := func(t *testing.T, data string) []byte {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		_, err := zw.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		return buf.Bytes()
+	}
+	valid := compress(t, "-----BEGIN CERTIFICATE-----")
+
+	testCases := map[string]struct {
+		Body           []byte
+		Encoding       string
+		ExpectedStatus int
+		ExpectedBody   string
+	}{
+		"uncompressed": {
+			Body:           []byte("plain"),
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   "plain",
+		},
+		"gzip": {
+			Body:           valid,
+			Encoding:       "gzip",
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   "-----BEGIN CERTIFICATE-----",
+		},
+		"malformed header": {
+			Body:           []byte("not gzip"),
+			Encoding:       "gzip",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		"truncated stream": {
+			Body:           valid[:len(valid)-4],
+			Encoding:       "gzip",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+	}
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var got []byte
+			h := DecompressRequestBody(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					assert.Empty(t, r.Header.Get("Content-Encoding"))
+					var err error
+					got, err = io.ReadAll(r.Body)
+					require.NoError(t, err)
+				},
+			))
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(tc.Body))
+			if tc.Encoding != "" {
+				req.Header.Set("Content-Encoding", tc.Encoding)
+			}
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+			assert.Equal(t, tc.ExpectedStatus, rr.Result().StatusCode)
+			if tc.ExpectedStatus != http.StatusOK {
+				assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+				return
+			}
+			assert.Equal(t, tc.ExpectedBody, string(got))
+		})
+	}
+}
+
+func TestLimitRequestBody(t *testing.T) {
+	compress := func(t *testing.T, data []byte) []byte {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		_, err := zw.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		return buf.Bytes()
+	}
+	large := bytes.Repeat([]byte("a"), 64)
+
+	testCases := map[string]struct {
+		Body           []byte
+		Encoding       string
+		UnknownLength  bool
+		ExpectedStatus int
+	}{
+		"within limit": {
+			Body:           large[:16],
+			ExpectedStatus: http.StatusOK,
+		},
+		"exceeds limit": {
+			Body:           large,
+			ExpectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		"exceeds limit without length": {
+			Body:           large,
+			UnknownLength:  true,
+			ExpectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		"compressed within limit": {
+			Body:           compress(t, large[:16]),
+			Encoding:       "gzip",
+			ExpectedStatus: http.StatusOK,
+		},
+		"decompressed exceeds limit": {
+			Body:           compress(t, large),
+			Encoding:       "gzip",
+			ExpectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := &Server{MaxRequestBodySize: 48}
+			h := s.LimitRequestBody(DecompressRequestBody(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					if _, err := io.ReadAll(r.Body); err != nil {
+						require.True(t, requestTooLarge(w, err), err)
+					}
+				},
+			)))
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(tc.Body))
+			if tc.UnknownLength {
+				req.ContentLength = -1
+			}
+			if tc.Encoding != "" {
+				req.Header.Set("Content-Encoding", tc.Encoding)
+			}
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+			assert.Equal(t, tc.ExpectedStatus, rr.Result().StatusCode)
+			if tc.ExpectedStatus != http.StatusOK {
+				assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+				assert.Contains(t, rr.Body.String(), "limit of 48 bytes")
+				assert.Contains(t, rr.Body.String(), api.PayloadTooLarge)
+			}
+		})
+	}
+}
+
+func TestLimitConcurrency(t *testing.T) {
+	// newHandler returns a handler that blocks until release is closed. It
+	// signals on started once a request is handled.
+	newHandler := func(s *Server) (http.Handler, chan struct{}, chan struct{}) {
+		started, release := make(chan struct{}, 2), make(chan struct{})
+		h := s.LimitConcurrency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			started <- struct{}{}
+			<-release
+		}))
+		return h, started, release
+	}
+	serve := func(h http.Handler, path string) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+		return rr
+	}
+
+	t.Run("rejected beyond limit", func(t *testing.T) {
+		h, started, release := newHandler(&Server{MaxConcurrentRequests: 1})
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- serve(h, "/api/v1/beacons") }()
+		<-started
+
+		rr := serve(h, "/api/v1/beacons")
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+		assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+
+		close(release)
+		assert.Equal(t, http.StatusOK, (<-done).Code)
+		assert.Equal(t, http.StatusOK, serve(h, "/api/v1/beacons").Code)
+	})
+	t.Run("probes exempt", func(t *testing.T) {
+		h, started, release := newHandler(&Server{MaxConcurrentRequests: 1})
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- serve(h, "/api/v1/beacons") }()
+		<-started
+
+		probe := make(chan *httptest.ResponseRecorder)
+		go func() { probe <- serve(h, "/api/v1/health") }()
+		<-started
+		close(release)
+		assert.Equal(t, http.StatusOK, (<-probe).Code)
+		assert.Equal(t, http.StatusOK, (<-done).Code)
+	})
+	t.Run("long poll limited", func(t *testing.T) {
+		h, started, release := newHandler(&Server{MaxConcurrentRequests: 1})
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- serve(h, "/api/v1/health?wait=30s") }()
+		<-started
+
+		rr := serve(h, "/api/v1/health?wait=30s")
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		close(release)
+		assert.Equal(t, http.StatusOK, (<-done).Code)
+	})
+	t.Run("queued", func(t *testing.T) {
+		h, started, release := newHandler(&Server{
+			MaxConcurrentRequests:   1,
+			ConcurrencyQueueTimeout: time.Minute,
+		})
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- serve(h, "/api/v1/beacons") }()
+		<-started
+
+		queued := make(chan *httptest.ResponseRecorder)
+		go func() { queued <- serve(h, "/api/v1/beacons") }()
+		close(release)
+		assert.Equal(t, http.StatusOK, (<-done).Code)
+		assert.Equal(t, http.StatusOK, (<-queued).Code)
+	})
+	t.Run("unlimited", func(t *testing.T) {
+		h, _, release := newHandler(&Server{})
+		close(release)
+		assert.Equal(t, http.StatusOK, serve(h, "/api/v1/beacons").Code)
+	})
+}
+
+func TestRecoverPanic(t *testing.T) {
+	t.Run("panic", func(t *testing.T) {
+		h := RecoverPanic(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var segment *Segment
+			_ = segment.Hops[0]
+		}))
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/beacons", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"status": 500,
+			"title": "internal server error",
+			"type": "/problems/internal-error"
+		}`, rr.Body.String())
+	})
+	t.Run("no panic", func(t *testing.T) {
+		h := RecoverPanic(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/beacons", nil))
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+	t.Run("abort handler", func(t *testing.T) {
+		h := RecoverPanic(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		}))
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+		})
+	})
+}
+
+func TestAddSecurityHeaders(t *testing.T) {
+	problem := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ErrorResponse(w, Problem{
+			Status: http.StatusNotFound,
+			Title:  "not found",
+			Type:   api.StringRef(api.NotFound),
+		})
+	})
+	testCases := map[string]struct {
+		Server   *Server
+		Expected map[string]string
+	}{
+		"disabled": {
+			Server: &Server{StrictTransportSecurity: "max-age=60"},
+			Expected: map[string]string{
+				"X-Content-Type-Options":    "",
+				"X-Frame-Options":           "",
+				"Strict-Transport-Security": "",
+			},
+		},
+		"enabled": {
+			Server: &Server{SecurityHeaders: true},
+			Expected: map[string]string{
+				"X-Content-Type-Options":    "nosniff",
+				"X-Frame-Options":           "DENY",
+				"Strict-Transport-Security": "",
+			},
+		},
+		"enabled with hsts": {
+			Server: &Server{
+				SecurityHeaders:         true,
+				StrictTransportSecurity: "max-age=31536000; includeSubDomains",
+			},
+			Expected: map[string]string{
+				"X-Content-Type-Options":    "nosniff",
+				"X-Frame-Options":           "DENY",
+				"Strict-Transport-Security": "max-age=31536000; includeSubDomains",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			h := tc.Server.AddSecurityHeaders(problem)
+			h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/beacons", nil))
+
+			assert.Equal(t, http.StatusNotFound, rr.Code)
+			assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+			for header, value := range tc.Expected {
+				assert.Equal(t, value, rr.Header().Get(header), header)
+			}
+		})
+	}
+}
+
+func TestInstrumentHandlers(t *testing.T) {
+	requests := metrics.NewTestCounter()
+	durations := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "test_request_duration_seconds",
+	}, RequestsLabels())
+	s := &Server{
+		Requests:        requests,
+		RequestDuration: metrics.NewPromHistogram(durations),
+	}
+	r := chi.NewRouter()
+	r.Use(s.InstrumentHandlers)
+	r.Use(RecoverPanic)
+	r.Get("/", func(w http.ResponseWriter, r *http.Request) {})
+	HandlerFromMuxWithBaseURL(s, r, "/api/v1")
+	s.Info = func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("info"))
+	}
+	s.LogLevel = func(w http.ResponseWriter, r *http.Request) {
+		panic("log level")
+	}
+
+	for _, path := range []string{
+		"/api/v1/info",
+		"/api/v1/info",
+		"/api/v1/beacons?usages=unknown",
+		"/api/v1/log/level",
+		"/",
+		"/api/v1/unknown",
+	} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	expected := map[requestLabels]float64{
+		{Handler: "GetInfo", Status: "2xx"}:     2,
+		{Handler: "GetBeacons", Status: "4xx"}:  1,
+		{Handler: "GetLogLevel", Status: "5xx"}: 1,
+		{Handler: "other", Status: "2xx"}:       1,
+		{Handler: "other", Status: "4xx"}:       1,
+	}
+	for labels, count := range expected {
+		assert.Equal(t, count, metrics.CounterValue(requests.With(labels.Expand()...)), labels)
+	}
+	assert.Equal(t, len(expected), testutil.CollectAndCount(durations))
+}
+
+func TestHandlerNames(t *testing.T) {
+	handlers := handlerNames()
+	assert.Equal(t, "GetBeacons", handlers["GET /beacons"])
+	assert.Equal(t, "DeleteBeacons", handlers["DELETE /beacons"])
+	server := reflect.TypeOf(&Server{})
+	for op, name := range handlers {
+		_, ok := server.MethodByName(name)
+		assert.True(t, ok, "%s is handled by unknown method %s", op, name)
+	}
+}
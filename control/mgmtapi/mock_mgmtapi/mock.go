@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/scionproto/scion/control/mgmtapi (interfaces: BeaconStore,Healther)
+// Source: github.com/scionproto/scion/control/mgmtapi (interfaces: BeaconPolicyProvider,BeaconSelector,BeaconStore,Healther)
 
 // Package mock_mgmtapi is a generated GoMock package.
 package mock_mgmtapi
@@ -9,10 +9,86 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	beacon "github.com/scionproto/scion/control/beacon"
 	mgmtapi "github.com/scionproto/scion/control/mgmtapi"
-	beacon "github.com/scionproto/scion/private/storage/beacon"
+	beacon0 "github.com/scionproto/scion/private/storage/beacon"
 )
 
+// MockBeaconPolicyProvider is a mock of BeaconPolicyProvider interface.
+type MockBeaconPolicyProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconPolicyProviderMockRecorder
+}
+
+// MockBeaconPolicyProviderMockRecorder is the mock recorder for MockBeaconPolicyProvider.
+type MockBeaconPolicyProviderMockRecorder struct {
+	mock *MockBeaconPolicyProvider
+}
+
+// NewMockBeaconPolicyProvider creates a new mock instance.
+func NewMockBeaconPolicyProvider(ctrl *gomock.Controller) *MockBeaconPolicyProvider {
+	mock := &MockBeaconPolicyProvider{ctrl: ctrl}
+	mock.recorder = &MockBeaconPolicyProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconPolicyProvider) EXPECT() *MockBeaconPolicyProviderMockRecorder {
+	return m.recorder
+}
+
+// BeaconPolicy mocks base method.
+func (m *MockBeaconPolicyProvider) BeaconPolicy() mgmtapi.BeaconPolicyData {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeaconPolicy")
+	ret0, _ := ret[0].(mgmtapi.BeaconPolicyData)
+	return ret0
+}
+
+// BeaconPolicy indicates an expected call of BeaconPolicy.
+func (mr *MockBeaconPolicyProviderMockRecorder) BeaconPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeaconPolicy", reflect.TypeOf((*MockBeaconPolicyProvider)(nil).BeaconPolicy))
+}
+
+// MockBeaconSelector is a mock of BeaconSelector interface.
+type MockBeaconSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconSelectorMockRecorder
+}
+
+// MockBeaconSelectorMockRecorder is the mock recorder for MockBeaconSelector.
+type MockBeaconSelectorMockRecorder struct {
+	mock *MockBeaconSelector
+}
+
+// NewMockBeaconSelector creates a new mock instance.
+func NewMockBeaconSelector(ctrl *gomock.Controller) *MockBeaconSelector {
+	mock := &MockBeaconSelector{ctrl: ctrl}
+	mock.recorder = &MockBeaconSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconSelector) EXPECT() *MockBeaconSelectorMockRecorder {
+	return m.recorder
+}
+
+// SelectedBeacons mocks base method.
+func (m *MockBeaconSelector) SelectedBeacons(arg0 context.Context) ([]beacon.Selection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectedBeacons", arg0)
+	ret0, _ := ret[0].([]beacon.Selection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectedBeacons indicates an expected call of SelectedBeacons.
+func (mr *MockBeaconSelectorMockRecorder) SelectedBeacons(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectedBeacons", reflect.TypeOf((*MockBeaconSelector)(nil).SelectedBeacons), arg0)
+}
+
 // MockBeaconStore is a mock of BeaconStore interface.
 type MockBeaconStore struct {
 	ctrl     *gomock.Controller
@@ -36,6 +112,21 @@ func (m *MockBeaconStore) EXPECT() *MockBeaconStoreMockRecorder {
 	return m.recorder
 }
 
+// CountBeacons mocks base method.
+func (m *MockBeaconStore) CountBeacons(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountBeacons", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountBeacons indicates an expected call of CountBeacons.
+func (mr *MockBeaconStoreMockRecorder) CountBeacons(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountBeacons", reflect.TypeOf((*MockBeaconStore)(nil).CountBeacons), arg0)
+}
+
 // DeleteBeacon mocks base method.
 func (m *MockBeaconStore) DeleteBeacon(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -50,11 +141,26 @@ func (mr *MockBeaconStoreMockRecorder) DeleteBeacon(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBeacon", reflect.TypeOf((*MockBeaconStore)(nil).DeleteBeacon), arg0, arg1)
 }
 
+// DeleteBeacons mocks base method.
+func (m *MockBeaconStore) DeleteBeacons(arg0 context.Context, arg1 *beacon0.QueryParams) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBeacons", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBeacons indicates an expected call of DeleteBeacons.
+func (mr *MockBeaconStoreMockRecorder) DeleteBeacons(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBeacons", reflect.TypeOf((*MockBeaconStore)(nil).DeleteBeacons), arg0, arg1)
+}
+
 // GetBeacons mocks base method.
-func (m *MockBeaconStore) GetBeacons(arg0 context.Context, arg1 *beacon.QueryParams) ([]beacon.Beacon, error) {
+func (m *MockBeaconStore) GetBeacons(arg0 context.Context, arg1 *beacon0.QueryParams) ([]beacon0.Beacon, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBeacons", arg0, arg1)
-	ret0, _ := ret[0].([]beacon.Beacon)
+	ret0, _ := ret[0].([]beacon0.Beacon)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -65,6 +171,21 @@ func (mr *MockBeaconStoreMockRecorder) GetBeacons(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeacons", reflect.TypeOf((*MockBeaconStore)(nil).GetBeacons), arg0, arg1)
 }
 
+// Stats mocks base method.
+func (m *MockBeaconStore) Stats(arg0 context.Context, arg1 *beacon0.QueryParams) (beacon0.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", arg0, arg1)
+	ret0, _ := ret[0].(beacon0.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockBeaconStoreMockRecorder) Stats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockBeaconStore)(nil).Stats), arg0, arg1)
+}
+
 // MockHealther is a mock of Healther interface.
 type MockHealther struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,183 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/scionproto/scion/private/mgmtapi"
+)
+
+const defaultResponseCacheSize = 256
+
+// ResponseCache caches the successful responses of read requests for the
+// operations that have a TTL configured. Until the TTL expires, the cached
+// response is returned without invoking the handler. Write requests and health
+// requests are never cached, and neither are responses that are marked with
+// "Cache-Control: no-store". Requests with an If-None-Match header are answered
+// with 304 Not Modified if it matches the ETag of the cached response. Requests
+// with other conditional headers bypass the cache. The middleware must be installed on the router
+// that serves the API, such that the operation of a request can be matched.
+type ResponseCache struct {
+	// TTLs maps the paths of the operations, as they are listed in the spec,
+	// e.g., "/trcs", to the duration for which their responses are cached. The
+	// paths are relative to the base URL the API is mounted at. Requests to
+	// operations without a positive TTL are not cached.
+	TTLs map[string]time.Duration
+	// Size is the maximum number of cached responses. If it is not positive, a
+	// default size is used.
+	Size int
+
+	// nowProvider can be set during tests to control the current time.
+	nowProvider func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+	// order contains the keys of the entries in insertion order.
+	order []string
+}
+
+type cachedResponse struct {
+	expires time.Time
+	header  http.Header
+	body    []byte
+}
+
+// Middleware applies the response cache to the requests.
+func (c *ResponseCache) Middleware(next http.Handler) http.Handler {
+	if len(c.TTLs) == 0 {
+		return next
+	}
+	operations := handlerNames()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+		path, ok := operations.path(r)
+		ttl := c.TTLs[path]
+		if !ok || ttl <= 0 || path == "/health" || hasPrecondition(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		// The Accept header is part of the key, because it selects the
+		// encoding of the response.
+		key := r.Method + " " + r.URL.RequestURI() + " " + strings.Join(r.Header.Values("Accept"), ",")
+		if entry := c.get(key); entry != nil {
+			etag := entry.header.Get("ETag")
+			if etag != "" && api.ETagMatches(r.Header.Get("If-None-Match"), etag) {
+				for _, name := range []string{"ETag", "Vary", "Cache-Control"} {
+					if values := entry.header.Values(name); len(values) != 0 {
+						w.Header()[http.CanonicalHeaderKey(name)] = values
+					}
+				}
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			for name, values := range entry.header {
+				w.Header()[name] = values
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(entry.body)
+			return
+		}
+		rec := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		if rec.status != http.StatusOK || noStore(rec.Header()) {
+			return
+		}
+		c.put(key, &cachedResponse{
+			expires: c.now().Add(ttl),
+			header:  rec.Header().Clone(),
+			body:    rec.body.Bytes(),
+		})
+	})
+}
+
+// hasPrecondition indicates whether the request has conditional headers that
+// the cache does not evaluate. These requests are passed to the handler.
+func hasPrecondition(r *http.Request) bool {
+	for _, name := range []string{
+		"If-Match", "If-Modified-Since", "If-Unmodified-Since", "If-Range",
+	} {
+		if r.Header.Get(name) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// noStore indicates whether the response must not be cached.
+func noStore(h http.Header) bool {
+	for _, v := range h.Values("Cache-Control") {
+		for _, directive := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(directive), "no-store") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// get returns the unexpired entry for the key, or nil if there is none.
+func (c *ResponseCache) get(key string) *cachedResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expires) {
+		return nil
+	}
+	return entry
+}
+
+// put stores the entry for the key. Expired entries are removed, and if the
+// cache is full, the oldest entries are evicted.
+func (c *ResponseCache) put(key string, entry *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]*cachedResponse)
+	}
+	now := c.now()
+	order := c.order[:0]
+	for _, k := range c.order {
+		if k == key || !now.Before(c.entries[k].expires) {
+			delete(c.entries, k)
+			continue
+		}
+		order = append(order, k)
+	}
+	c.order = order
+	size := c.Size
+	if size <= 0 {
+		size = defaultResponseCacheSize
+	}
+	for len(c.order) >= size {
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+	c.entries[key] = entry
+	c.order = append(c.order, key)
+}
+
+func (c *ResponseCache) now() time.Time {
+	if c.nowProvider != nil {
+		return c.nowProvider()
+	}
+	return time.Now()
+}
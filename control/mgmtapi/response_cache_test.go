@@ -0,0 +1,261 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/scionproto/scion/control/mgmtapi"
+)
+
+func TestResponseCache(t *testing.T) {
+	type request struct {
+		Method string
+		Target string
+		Accept string
+		// Advance is added to the current time before the request is sent.
+		Advance time.Duration
+	}
+
+	ttls := map[string]time.Duration{
+		"/ca":     time.Minute,
+		"/trcs":   time.Minute,
+		"/health": time.Minute,
+	}
+	testCases := map[string]struct {
+		// Base is the base URL the API is mounted at. If it is empty,
+		// "/api/v1" is used.
+		Base     string
+		Size     int
+		Status   int
+		Requests []request
+		Bodies   []string
+	}{
+		"cached": {
+			Requests: []request{
+				{Method: http.MethodGet, Target: "/ca"},
+				{Method: http.MethodGet, Target: "/ca", Advance: 59 * time.Second},
+			},
+			Bodies: []string{"call 1", "call 1"},
+		},
+		"expired": {
+			Requests: []request{
+				{Method: http.MethodGet, Target: "/ca"},
+				{Method: http.MethodGet, Target: "/ca", Advance: time.Minute},
+			},
+			Bodies: []string{"call 1", "call 2"},
+		},
+		"other base": {
+			Base: "/mgmt",
+			Requests: []request{
+				{Method: http.MethodGet, Target: "/ca"},
+				{Method: http.MethodGet, Target: "/ca"},
+			},
+			Bodies: []string{"call 1", "call 1"},
+		},
+		"route without TTL": {
+			Requests: []request{
+				{Method: http.MethodGet, Target: "/signer"},
+				{Method: http.MethodGet, Target: "/signer"},
+			},
+			Bodies: []string{"call 1", "call 2"},
+		},
+		"different query": {
+			Requests: []request{
+				{Method: http.MethodGet, Target: "/trcs?isd=1"},
+				{Method: http.MethodGet, Target: "/trcs?isd=2"},
+				{Method: http.MethodGet, Target: "/trcs?isd=1"},
+			},
+			Bodies: []string{"call 1", "call 2", "call 1"},
+		},
+		"different accept": {
+			Requests: []request{
+				{Method: http.MethodGet, Target: "/ca"},
+				{Method: http.MethodGet, Target: "/ca", Accept: "application/cbor"},
+			},
+			Bodies: []string{"call 1", "call 2"},
+		},
+		"write request": {
+			Requests: []request{
+				{Method: http.MethodPost, Target: "/trcs"},
+				{Method: http.MethodPost, Target: "/trcs"},
+			},
+			Bodies: []string{"call 1", "call 2"},
+		},
+		"health": {
+			Requests: []request{
+				{Method: http.MethodGet, Target: "/health"},
+				{Method: http.MethodGet, Target: "/health"},
+			},
+			Bodies: []string{"call 1", "call 2"},
+		},
+		"error not cached": {
+			Status: http.StatusInternalServerError,
+			Requests: []request{
+				{Method: http.MethodGet, Target: "/ca"},
+				{Method: http.MethodGet, Target: "/ca"},
+			},
+			Bodies: []string{"call 1", "call 2"},
+		},
+		"no-store": {
+			Requests: []request{
+				{Method: http.MethodGet, Target: "/ca?debug=true"},
+				{Method: http.MethodGet, Target: "/ca?debug=true"},
+			},
+			Bodies: []string{"call 1", "call 2"},
+		},
+		"evicted": {
+			Size: 1,
+			Requests: []request{
+				{Method: http.MethodGet, Target: "/ca"},
+				{Method: http.MethodGet, Target: "/trcs"},
+				{Method: http.MethodGet, Target: "/ca"},
+			},
+			Bodies: []string{"call 1", "call 2", "call 3"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC)
+			cache := &api.ResponseCache{TTLs: ttls, Size: tc.Size}
+			cache.SetNowProvider(func() time.Time { return now })
+			status := tc.Status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			calls := 0
+			h := func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.URL.Query().Get("debug") == "true" {
+					w.Header().Set("Cache-Control", "private, no-store")
+				}
+				w.Header().Set("Content-Type", "text/plain")
+				w.WriteHeader(status)
+				fmt.Fprintf(w, "call %d", calls)
+			}
+			base := tc.Base
+			if base == "" {
+				base = "/api/v1"
+			}
+			handler := chi.NewRouter()
+			handler.Use(cache.Middleware)
+			handler.Route(base, func(r chi.Router) {
+				for _, path := range []string{"/ca", "/trcs", "/signer", "/health"} {
+					r.Get(path, h)
+				}
+				r.Post("/trcs", h)
+			})
+
+			for i, req := range tc.Requests {
+				now = now.Add(req.Advance)
+				r := httptest.NewRequest(req.Method, base+req.Target, nil)
+				if req.Accept != "" {
+					r.Header.Set("Accept", req.Accept)
+				}
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, r)
+
+				assert.Equal(t, status, rr.Code, "request %d", i)
+				assert.Equal(t, tc.Bodies[i], rr.Body.String(), "request %d", i)
+				assert.Equal(t, "text/plain", rr.Header().Get("Content-Type"), "request %d", i)
+			}
+		})
+	}
+}
+
+func TestResponseCacheConditional(t *testing.T) {
+	cache := &api.ResponseCache{TTLs: map[string]time.Duration{"/ca": time.Minute}}
+	calls := 0
+	handler := chi.NewRouter()
+	handler.Use(cache.Middleware)
+	handler.Get("/ca", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-Modified-Since") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprintf(w, "call %d", calls)
+	})
+
+	testCases := []struct {
+		Header http.Header
+		Status int
+		Body   string
+		Calls  int
+	}{
+		{Status: http.StatusOK, Body: "call 1", Calls: 1},
+		{
+			Header: http.Header{"If-None-Match": {`"v1"`}},
+			Status: http.StatusNotModified,
+			Calls:  1,
+		},
+		{
+			Header: http.Header{"If-None-Match": {`"v0"`}},
+			Status: http.StatusOK,
+			Body:   "call 1",
+			Calls:  1,
+		},
+		{
+			Header: http.Header{"If-Modified-Since": {"Fri, 01 Jan 2021 08:00:00 GMT"}},
+			Status: http.StatusNotModified,
+			Calls:  2,
+		},
+	}
+	for i, tc := range testCases {
+		r := httptest.NewRequest(http.MethodGet, "/ca", nil)
+		for name, values := range tc.Header {
+			r.Header[name] = values
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, r)
+
+		assert.Equal(t, tc.Status, rr.Code, "request %d", i)
+		assert.Equal(t, tc.Body, rr.Body.String(), "request %d", i)
+		assert.Equal(t, `"v1"`, rr.Header().Get("ETag"), "request %d", i)
+		assert.Equal(t, tc.Calls, calls, "request %d", i)
+	}
+}
+
+func TestResponseCacheFlush(t *testing.T) {
+	cache := &api.ResponseCache{TTLs: map[string]time.Duration{"/beacons": time.Minute}}
+	handler := chi.NewRouter()
+	handler.Use(cache.Middleware)
+	handler.Get("/beacons", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"id": "1"}`)
+		// Streamed responses are flushed through the response controller,
+		// which relies on the writer to be unwrappable.
+		assert.NoError(t, http.NewResponseController(w).Flush())
+		fmt.Fprintln(w, `{"id": "2"}`)
+		w.(http.Flusher).Flush()
+	})
+
+	for i := 0; i < 2; i++ {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/beacons", nil))
+		assert.Equal(t, http.StatusOK, rr.Code, "request %d", i)
+		assert.Equal(t, "{\"id\": \"1\"}\n{\"id\": \"2\"}\n", rr.Body.String(), "request %d", i)
+		if i == 0 {
+			assert.True(t, rr.Flushed)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"math"
+	"time"
+
+	seg "github.com/scionproto/scion/pkg/segment"
+	"github.com/scionproto/scion/pkg/segment/iface"
+)
+
+const (
+	// scoreReferenceLatency is the announced latency of a path at which the
+	// latency score is 0.5.
+	scoreReferenceLatency = 100 * time.Millisecond
+	// scoreReferenceBandwidth is the announced bandwidth in Kbit/s of a path
+	// at which the bandwidth score is 0.5.
+	scoreReferenceBandwidth = 1000000
+)
+
+// defaultBeaconScoreWeights are the weights of the beacon quality score if no
+// weights are configured.
+var defaultBeaconScoreWeights = BeaconScoreWeights{
+	Hops:      1,
+	Validity:  1,
+	Latency:   1,
+	Bandwidth: 1,
+}
+
+// BeaconScoreWeights are the weights of the components of the beacon quality
+// score. Every component is a score between 0 and 1, higher is better:
+//
+//   - Hops is 1/n for a beacon with n AS entries.
+//   - Validity is the fraction of the lifetime of the beacon that remains.
+//   - Latency is r/(r+l) for the sum l of the latencies announced in the static
+//     info extension, with a reference latency r of 100ms.
+//   - Bandwidth is b/(b+r) for the smallest bandwidth b announced in the static
+//     info extension, with a reference bandwidth r of 1Gbit/s.
+//
+// The quality score is the weighted mean of the components, rounded to three
+// decimals. The latency and bandwidth components only count if the beacon
+// announces them. Negative weights are treated as zero.
+type BeaconScoreWeights struct {
+	Hops      float64
+	Validity  float64
+	Latency   float64
+	Bandwidth float64
+}
+
+// beaconScore computes the quality score of the segment at the given time.
+func beaconScore(s *seg.PathSegment, now time.Time, weights BeaconScoreWeights) float64 {
+	if weights == (BeaconScoreWeights{}) {
+		weights = defaultBeaconScoreWeights
+	}
+	var sum, total float64
+	add := func(weight, score float64) {
+		if weight <= 0 {
+			return
+		}
+		sum += weight * score
+		total += weight
+	}
+	add(weights.Hops, 1/float64(max(len(s.ASEntries), 1)))
+	var validity float64
+	if lifetime := s.MinExpiry().Sub(s.Info.Timestamp); lifetime > 0 {
+		remaining := s.MinExpiry().Sub(now)
+		validity = min(max(float64(remaining)/float64(lifetime), 0), 1)
+	}
+	add(weights.Validity, validity)
+	if latency, ok := announcedLatency(s); ok {
+		add(weights.Latency, float64(scoreReferenceLatency)/
+			float64(scoreReferenceLatency+latency))
+	}
+	if bandwidth, ok := announcedBandwidth(s); ok {
+		add(weights.Bandwidth, float64(bandwidth)/
+			float64(bandwidth+scoreReferenceBandwidth))
+	}
+	if total == 0 {
+		return 0
+	}
+	return math.Round(sum/total*1000) / 1000
+}
+
+// announcedLatency sums the intra-AS and inter-AS latencies that are announced
+// along the segment. It reports false if no latency is announced.
+func announcedLatency(s *seg.PathSegment) (time.Duration, bool) {
+	var latency time.Duration
+	var ok bool
+	for _, as := range s.ASEntries {
+		info := as.Extensions.StaticInfo
+		if info == nil {
+			continue
+		}
+		hf := as.HopEntry.HopField
+		if l, found := info.Latency.Intra[iface.ID(hf.ConsIngress)]; found && hf.ConsIngress != 0 {
+			latency, ok = latency+l, true
+		}
+		if l, found := info.Latency.Inter[iface.ID(hf.ConsEgress)]; found && hf.ConsEgress != 0 {
+			latency, ok = latency+l, true
+		}
+	}
+	return latency, ok
+}
+
+// announcedBandwidth returns the smallest intra-AS or inter-AS bandwidth in
+// Kbit/s that is announced along the segment. It reports false if no
+// bandwidth is announced.
+func announcedBandwidth(s *seg.PathSegment) (uint64, bool) {
+	var bandwidth uint64 = math.MaxUint64
+	var ok bool
+	for _, as := range s.ASEntries {
+		info := as.Extensions.StaticInfo
+		if info == nil {
+			continue
+		}
+		hf := as.HopEntry.HopField
+		if b, found := info.Bandwidth.Intra[iface.ID(hf.ConsIngress)]; found && hf.ConsIngress != 0 {
+			bandwidth, ok = min(bandwidth, b), true
+		}
+		if b, found := info.Bandwidth.Inter[iface.ID(hf.ConsEgress)]; found && hf.ConsEgress != 0 {
+			bandwidth, ok = min(bandwidth, b), true
+		}
+	}
+	return bandwidth, ok
+}
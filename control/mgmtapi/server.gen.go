@@ -13,21 +13,60 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Delete the matching SCION beacons
+	// (DELETE /beacons)
+	DeleteBeacons(w http.ResponseWriter, r *http.Request, params DeleteBeaconsParams)
 	// List the SCION beacons
 	// (GET /beacons)
 	GetBeacons(w http.ResponseWriter, r *http.Request, params GetBeaconsParams)
+	// Report the age distribution of the beacons
+	// (GET /beacons/age-histogram)
+	GetBeaconAgeHistogram(w http.ResponseWriter, r *http.Request, params GetBeaconAgeHistogramParams)
+	// Beacons that violate invariants
+	// (GET /beacons/anomalies)
+	GetBeaconAnomalies(w http.ResponseWriter, r *http.Request)
+	// Minimal set of beacons covering all interfaces
+	// (GET /beacons/cover)
+	GetBeaconCover(w http.ResponseWriter, r *http.Request)
+	// Report the latest beacon of every origin AS
+	// (GET /beacons/heartbeat)
+	GetBeaconHeartbeat(w http.ResponseWriter, r *http.Request, params GetBeaconHeartbeatParams)
+	// Get the beaconing policy
+	// (GET /beacons/policy)
+	GetBeaconPolicy(w http.ResponseWriter, r *http.Request)
+	// Reconcile the beacons with an expected set
+	// (POST /beacons/reconcile)
+	ReconcileBeacons(w http.ResponseWriter, r *http.Request)
+	// Preview the beacon selection
+	// (GET /beacons/selected)
+	GetSelectedBeacons(w http.ResponseWriter, r *http.Request)
+	// Report the freshness of the beacons
+	// (GET /beacons/sla)
+	GetBeaconSla(w http.ResponseWriter, r *http.Request, params GetBeaconSlaParams)
+	// Summarize the currently valid beacons
+	// (GET /beacons/stats)
+	GetBeaconStats(w http.ResponseWriter, r *http.Request, params GetBeaconStatsParams)
+	// Validate a beacon query
+	// (POST /beacons/validate)
+	ValidateBeaconsQuery(w http.ResponseWriter, r *http.Request, params ValidateBeaconsQueryParams)
 	// Delete the SCION beacon
 	// (DELETE /beacons/{segment-id})
 	DeleteBeacon(w http.ResponseWriter, r *http.Request, segmentId SegmentID)
 	// Get the SCION beacon description
 	// (GET /beacons/{segment-id})
-	GetBeacon(w http.ResponseWriter, r *http.Request, segmentId SegmentID)
+	GetBeacon(w http.ResponseWriter, r *http.Request, segmentId SegmentID, params GetBeaconParams)
 	// Get the SCION beacon blob
 	// (GET /beacons/{segment-id}/blob)
 	GetBeaconBlob(w http.ResponseWriter, r *http.Request, segmentId SegmentID)
+	// Segments registered from the beacon
+	// (GET /beacons/{segment-id}/segments)
+	GetBeaconSegments(w http.ResponseWriter, r *http.Request, segmentId SegmentID)
 	// Information about the CA.
 	// (GET /ca)
-	GetCa(w http.ResponseWriter, r *http.Request)
+	GetCa(w http.ResponseWriter, r *http.Request, params GetCaParams)
+	// Preview the certificate chain issued for a renewal request.
+	// (POST /ca/renew/preview)
+	PreviewCaRenewal(w http.ResponseWriter, r *http.Request)
 	// List the certificate chains
 	// (GET /certificates)
 	GetCertificates(w http.ResponseWriter, r *http.Request, params GetCertificatesParams)
@@ -40,18 +79,39 @@ type ServerInterface interface {
 	// Prints the TOML configuration file.
 	// (GET /config)
 	GetConfig(w http.ResponseWriter, r *http.Request)
+	// Reload the hot-reloadable configuration.
+	// (POST /config/reload)
+	ReloadConfig(w http.ResponseWriter, r *http.Request)
 	// Indicate the service health.
 	// (GET /health)
-	GetHealth(w http.ResponseWriter, r *http.Request)
+	GetHealth(w http.ResponseWriter, r *http.Request, params GetHealthParams)
+	// List the health checks that changed since a point in time.
+	// (GET /health/changes)
+	GetHealthChanges(w http.ResponseWriter, r *http.Request, params GetHealthChangesParams)
+	// List the status transitions of the health checks.
+	// (GET /health/history)
+	GetHealthHistory(w http.ResponseWriter, r *http.Request)
 	// Basic information page about the control service process.
 	// (GET /info)
 	GetInfo(w http.ResponseWriter, r *http.Request)
+	// List the interfaces with their beaconing activity
+	// (GET /interfaces)
+	GetInterfaces(w http.ResponseWriter, r *http.Request)
+	// Indicate whether the service is alive.
+	// (GET /livez)
+	GetLiveness(w http.ResponseWriter, r *http.Request)
 	// Get logging level
 	// (GET /log/level)
 	GetLogLevel(w http.ResponseWriter, r *http.Request)
 	// Set logging level
 	// (PUT /log/level)
 	SetLogLevel(w http.ResponseWriter, r *http.Request)
+	// Logging level and the levels it can be set to.
+	// (GET /loglevel)
+	GetLogLevelOptions(w http.ResponseWriter, r *http.Request)
+	// Indicate whether the service is ready.
+	// (GET /readyz)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
 	// List the SCION path segments
 	// (GET /segments)
 	GetSegments(w http.ResponseWriter, r *http.Request, params GetSegmentsParams)
@@ -66,34 +126,127 @@ type ServerInterface interface {
 	GetSegmentBlob(w http.ResponseWriter, r *http.Request, segmentId SegmentID)
 	// Prints information about the AS Certificate used to sign the control-plane message.
 	// (GET /signer)
-	GetSigner(w http.ResponseWriter, r *http.Request)
+	GetSigner(w http.ResponseWriter, r *http.Request, params GetSignerParams)
 	// Get the certificate chain blob
 	// (GET /signer/blob)
 	GetSignerChain(w http.ResponseWriter, r *http.Request)
+	// Get a certificate signing request for the signer
+	// (GET /signer/csr)
+	GetSignerCsr(w http.ResponseWriter, r *http.Request)
+	// Summarize the status of the control-plane signer.
+	// (GET /signer/status)
+	GetSignerStatus(w http.ResponseWriter, r *http.Request)
+	// List the TRCs that verify the control-plane signer.
+	// (GET /signer/trust)
+	GetSignerTrust(w http.ResponseWriter, r *http.Request)
+	// List all signers that are available to sign control-plane messages.
+	// (GET /signers)
+	GetSigners(w http.ResponseWriter, r *http.Request, params GetSignersParams)
+	// Take a snapshot token
+	// (GET /snapshot)
+	GetSnapshot(w http.ResponseWriter, r *http.Request)
+	// Summarize the status of the control service.
+	// (GET /status)
+	GetStatus(w http.ResponseWriter, r *http.Request)
 	// Prints the contents of the AS topology file.
 	// (GET /topology)
 	GetTopology(w http.ResponseWriter, r *http.Request)
 	// List the TRCs
 	// (GET /trcs)
 	GetTrcs(w http.ResponseWriter, r *http.Request, params GetTrcsParams)
+	// Compare two TRCs
+	// (GET /trcs/diff)
+	GetTrcDiff(w http.ResponseWriter, r *http.Request, params GetTrcDiffParams)
 	// Get the TRC
 	// (GET /trcs/isd{isd}-b{base}-s{serial})
 	GetTrc(w http.ResponseWriter, r *http.Request, isd int, base int, serial int)
 	// Get the TRC blob
 	// (GET /trcs/isd{isd}-b{base}-s{serial}/blob)
 	GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, base int, serial int)
+	// Get the latest TRCs as trust anchor bundle
+	// (GET /trust-anchor)
+	GetTrustAnchor(w http.ResponseWriter, r *http.Request)
+	// Build information of the control service binary.
+	// (GET /version)
+	GetVersion(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Delete the matching SCION beacons
+// (DELETE /beacons)
+func (_ Unimplemented) DeleteBeacons(w http.ResponseWriter, r *http.Request, params DeleteBeaconsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List the SCION beacons
 // (GET /beacons)
 func (_ Unimplemented) GetBeacons(w http.ResponseWriter, r *http.Request, params GetBeaconsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Report the age distribution of the beacons
+// (GET /beacons/age-histogram)
+func (_ Unimplemented) GetBeaconAgeHistogram(w http.ResponseWriter, r *http.Request, params GetBeaconAgeHistogramParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Beacons that violate invariants
+// (GET /beacons/anomalies)
+func (_ Unimplemented) GetBeaconAnomalies(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Minimal set of beacons covering all interfaces
+// (GET /beacons/cover)
+func (_ Unimplemented) GetBeaconCover(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report the latest beacon of every origin AS
+// (GET /beacons/heartbeat)
+func (_ Unimplemented) GetBeaconHeartbeat(w http.ResponseWriter, r *http.Request, params GetBeaconHeartbeatParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the beaconing policy
+// (GET /beacons/policy)
+func (_ Unimplemented) GetBeaconPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reconcile the beacons with an expected set
+// (POST /beacons/reconcile)
+func (_ Unimplemented) ReconcileBeacons(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview the beacon selection
+// (GET /beacons/selected)
+func (_ Unimplemented) GetSelectedBeacons(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report the freshness of the beacons
+// (GET /beacons/sla)
+func (_ Unimplemented) GetBeaconSla(w http.ResponseWriter, r *http.Request, params GetBeaconSlaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Summarize the currently valid beacons
+// (GET /beacons/stats)
+func (_ Unimplemented) GetBeaconStats(w http.ResponseWriter, r *http.Request, params GetBeaconStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Validate a beacon query
+// (POST /beacons/validate)
+func (_ Unimplemented) ValidateBeaconsQuery(w http.ResponseWriter, r *http.Request, params ValidateBeaconsQueryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete the SCION beacon
 // (DELETE /beacons/{segment-id})
 func (_ Unimplemented) DeleteBeacon(w http.ResponseWriter, r *http.Request, segmentId SegmentID) {
@@ -102,7 +255,7 @@ func (_ Unimplemented) DeleteBeacon(w http.ResponseWriter, r *http.Request, segm
 
 // Get the SCION beacon description
 // (GET /beacons/{segment-id})
-func (_ Unimplemented) GetBeacon(w http.ResponseWriter, r *http.Request, segmentId SegmentID) {
+func (_ Unimplemented) GetBeacon(w http.ResponseWriter, r *http.Request, segmentId SegmentID, params GetBeaconParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -112,9 +265,21 @@ func (_ Unimplemented) GetBeaconBlob(w http.ResponseWriter, r *http.Request, seg
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Segments registered from the beacon
+// (GET /beacons/{segment-id}/segments)
+func (_ Unimplemented) GetBeaconSegments(w http.ResponseWriter, r *http.Request, segmentId SegmentID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Information about the CA.
 // (GET /ca)
-func (_ Unimplemented) GetCa(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) GetCa(w http.ResponseWriter, r *http.Request, params GetCaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview the certificate chain issued for a renewal request.
+// (POST /ca/renew/preview)
+func (_ Unimplemented) PreviewCaRenewal(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -142,9 +307,27 @@ func (_ Unimplemented) GetConfig(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Reload the hot-reloadable configuration.
+// (POST /config/reload)
+func (_ Unimplemented) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Indicate the service health.
 // (GET /health)
-func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request, params GetHealthParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the health checks that changed since a point in time.
+// (GET /health/changes)
+func (_ Unimplemented) GetHealthChanges(w http.ResponseWriter, r *http.Request, params GetHealthChangesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the status transitions of the health checks.
+// (GET /health/history)
+func (_ Unimplemented) GetHealthHistory(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -154,6 +337,18 @@ func (_ Unimplemented) GetInfo(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the interfaces with their beaconing activity
+// (GET /interfaces)
+func (_ Unimplemented) GetInterfaces(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Indicate whether the service is alive.
+// (GET /livez)
+func (_ Unimplemented) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get logging level
 // (GET /log/level)
 func (_ Unimplemented) GetLogLevel(w http.ResponseWriter, r *http.Request) {
@@ -166,6 +361,18 @@ func (_ Unimplemented) SetLogLevel(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Logging level and the levels it can be set to.
+// (GET /loglevel)
+func (_ Unimplemented) GetLogLevelOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Indicate whether the service is ready.
+// (GET /readyz)
+func (_ Unimplemented) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List the SCION path segments
 // (GET /segments)
 func (_ Unimplemented) GetSegments(w http.ResponseWriter, r *http.Request, params GetSegmentsParams) {
@@ -192,7 +399,7 @@ func (_ Unimplemented) GetSegmentBlob(w http.ResponseWriter, r *http.Request, se
 
 // Prints information about the AS Certificate used to sign the control-plane message.
 // (GET /signer)
-func (_ Unimplemented) GetSigner(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) GetSigner(w http.ResponseWriter, r *http.Request, params GetSignerParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -202,6 +409,42 @@ func (_ Unimplemented) GetSignerChain(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get a certificate signing request for the signer
+// (GET /signer/csr)
+func (_ Unimplemented) GetSignerCsr(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Summarize the status of the control-plane signer.
+// (GET /signer/status)
+func (_ Unimplemented) GetSignerStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the TRCs that verify the control-plane signer.
+// (GET /signer/trust)
+func (_ Unimplemented) GetSignerTrust(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all signers that are available to sign control-plane messages.
+// (GET /signers)
+func (_ Unimplemented) GetSigners(w http.ResponseWriter, r *http.Request, params GetSignersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Take a snapshot token
+// (GET /snapshot)
+func (_ Unimplemented) GetSnapshot(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Summarize the status of the control service.
+// (GET /status)
+func (_ Unimplemented) GetStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Prints the contents of the AS topology file.
 // (GET /topology)
 func (_ Unimplemented) GetTopology(w http.ResponseWriter, r *http.Request) {
@@ -214,6 +457,12 @@ func (_ Unimplemented) GetTrcs(w http.ResponseWriter, r *http.Request, params Ge
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Compare two TRCs
+// (GET /trcs/diff)
+func (_ Unimplemented) GetTrcDiff(w http.ResponseWriter, r *http.Request, params GetTrcDiffParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the TRC
 // (GET /trcs/isd{isd}-b{base}-s{serial})
 func (_ Unimplemented) GetTrc(w http.ResponseWriter, r *http.Request, isd int, base int, serial int) {
@@ -226,6 +475,18 @@ func (_ Unimplemented) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd in
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the latest TRCs as trust anchor bundle
+// (GET /trust-anchor)
+func (_ Unimplemented) GetTrustAnchor(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Build information of the control service binary.
+// (GET /version)
+func (_ Unimplemented) GetVersion(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -235,6 +496,66 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// DeleteBeacons operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBeacons(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteBeaconsParams
+
+	// ------------- Optional query parameter "start_isd_as" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_isd_as", r.URL.Query(), &params.StartIsdAs)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_isd_as", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "usages" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "usages", r.URL.Query(), &params.Usages)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "usages", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ingress_interface" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ingress_interface", r.URL.Query(), &params.IngressInterface)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ingress_interface", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "valid_at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "valid_at", r.URL.Query(), &params.ValidAt)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "valid_at", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBeacons(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetBeacons operation middleware
 func (siw *ServerInterfaceWrapper) GetBeacons(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -252,6 +573,14 @@ func (siw *ServerInterfaceWrapper) GetBeacons(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "start_isd" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_isd", r.URL.Query(), &params.StartIsd)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_isd", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "usages" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "usages", r.URL.Query(), &params.Usages)
@@ -268,6 +597,14 @@ func (siw *ServerInterfaceWrapper) GetBeacons(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "from_neighbor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from_neighbor", r.URL.Query(), &params.FromNeighbor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from_neighbor", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "valid_at" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "valid_at", r.URL.Query(), &params.ValidAt)
@@ -276,6 +613,14 @@ func (siw *ServerInterfaceWrapper) GetBeacons(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "future_ok" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "future_ok", r.URL.Query(), &params.FutureOk)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "future_ok", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "all" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
@@ -284,6 +629,14 @@ func (siw *ServerInterfaceWrapper) GetBeacons(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "expired_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expired_only", r.URL.Query(), &params.ExpiredOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expired_only", Err: err})
+		return
+	}
+
 	// ------------- Optional query parameter "desc" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "desc", r.URL.Query(), &params.Desc)
@@ -300,34 +653,104 @@ func (siw *ServerInterfaceWrapper) GetBeacons(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBeacons(w, r, params)
-	}))
+	// ------------- Optional query parameter "signed_with" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "signed_with", r.URL.Query(), &params.SignedWith)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signed_with", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "loops_only" -------------
 
-// DeleteBeacon operation middleware
-func (siw *ServerInterfaceWrapper) DeleteBeacon(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "loops_only", r.URL.Query(), &params.LoopsOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "loops_only", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "explain" -------------
 
-	// ------------- Path parameter "segment-id" -------------
-	var segmentId SegmentID
+	err = runtime.BindQueryParameter("form", true, false, "explain", r.URL.Query(), &params.Explain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "explain", Err: err})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "segment-id", chi.URLParam(r, "segment-id"), &segmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expand", r.URL.Query(), &params.Expand)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "segment-id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expand", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dedupe" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dedupe", r.URL.Query(), &params.Dedupe)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dedupe", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_mtu" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_mtu", r.URL.Query(), &params.MinMtu)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_mtu", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "snapshot" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "snapshot", r.URL.Query(), &params.Snapshot)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshot", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "names" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "names", r.URL.Query(), &params.Names)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "names", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "score" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "score", r.URL.Query(), &params.Score)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "score", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteBeacon(w, r, segmentId)
+		siw.Handler.GetBeacons(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -337,23 +760,25 @@ func (siw *ServerInterfaceWrapper) DeleteBeacon(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetBeacon operation middleware
-func (siw *ServerInterfaceWrapper) GetBeacon(w http.ResponseWriter, r *http.Request) {
+// GetBeaconAgeHistogram operation middleware
+func (siw *ServerInterfaceWrapper) GetBeaconAgeHistogram(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "segment-id" -------------
-	var segmentId SegmentID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBeaconAgeHistogramParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "segment-id", chi.URLParam(r, "segment-id"), &segmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "buckets" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "buckets", r.URL.Query(), &params.Buckets)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "segment-id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "buckets", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBeacon(w, r, segmentId)
+		siw.Handler.GetBeaconAgeHistogram(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -363,23 +788,12 @@ func (siw *ServerInterfaceWrapper) GetBeacon(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetBeaconBlob operation middleware
-func (siw *ServerInterfaceWrapper) GetBeaconBlob(w http.ResponseWriter, r *http.Request) {
+// GetBeaconAnomalies operation middleware
+func (siw *ServerInterfaceWrapper) GetBeaconAnomalies(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "segment-id" -------------
-	var segmentId SegmentID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "segment-id", chi.URLParam(r, "segment-id"), &segmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "segment-id", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBeaconBlob(w, r, segmentId)
+		siw.Handler.GetBeaconAnomalies(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -389,12 +803,12 @@ func (siw *ServerInterfaceWrapper) GetBeaconBlob(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCa operation middleware
-func (siw *ServerInterfaceWrapper) GetCa(w http.ResponseWriter, r *http.Request) {
+// GetBeaconCover operation middleware
+func (siw *ServerInterfaceWrapper) GetBeaconCover(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCa(w, r)
+		siw.Handler.GetBeaconCover(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -404,14 +818,521 @@ func (siw *ServerInterfaceWrapper) GetCa(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetCertificates operation middleware
-func (siw *ServerInterfaceWrapper) GetCertificates(w http.ResponseWriter, r *http.Request) {
+// GetBeaconHeartbeat operation middleware
+func (siw *ServerInterfaceWrapper) GetBeaconHeartbeat(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCertificatesParams
+	var params GetBeaconHeartbeatParams
+
+	// ------------- Optional query parameter "silent_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "silent_after", r.URL.Query(), &params.SilentAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "silent_after", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBeaconHeartbeat(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBeaconPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetBeaconPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBeaconPolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReconcileBeacons operation middleware
+func (siw *ServerInterfaceWrapper) ReconcileBeacons(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReconcileBeacons(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSelectedBeacons operation middleware
+func (siw *ServerInterfaceWrapper) GetSelectedBeacons(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSelectedBeacons(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBeaconSla operation middleware
+func (siw *ServerInterfaceWrapper) GetBeaconSla(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBeaconSlaParams
+
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", r.URL.Query(), &params.Window)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBeaconSla(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBeaconStats operation middleware
+func (siw *ServerInterfaceWrapper) GetBeaconStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBeaconStatsParams
+
+	// ------------- Optional query parameter "top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "top", r.URL.Query(), &params.Top)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "top", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBeaconStats(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ValidateBeaconsQuery operation middleware
+func (siw *ServerInterfaceWrapper) ValidateBeaconsQuery(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ValidateBeaconsQueryParams
+
+	// ------------- Optional query parameter "start_isd_as" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_isd_as", r.URL.Query(), &params.StartIsdAs)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_isd_as", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start_isd" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_isd", r.URL.Query(), &params.StartIsd)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_isd", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "usages" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "usages", r.URL.Query(), &params.Usages)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "usages", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ingress_interface" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ingress_interface", r.URL.Query(), &params.IngressInterface)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ingress_interface", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from_neighbor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from_neighbor", r.URL.Query(), &params.FromNeighbor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from_neighbor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "valid_at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "valid_at", r.URL.Query(), &params.ValidAt)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "valid_at", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "future_ok" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "future_ok", r.URL.Query(), &params.FutureOk)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "future_ok", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "expired_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expired_only", r.URL.Query(), &params.ExpiredOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expired_only", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "desc" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "desc", r.URL.Query(), &params.Desc)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "desc", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "signed_with" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "signed_with", r.URL.Query(), &params.SignedWith)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "signed_with", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "loops_only" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "loops_only", r.URL.Query(), &params.LoopsOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "loops_only", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "explain" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "explain", r.URL.Query(), &params.Explain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "explain", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expand", r.URL.Query(), &params.Expand)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expand", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dedupe" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dedupe", r.URL.Query(), &params.Dedupe)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dedupe", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_mtu" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_mtu", r.URL.Query(), &params.MinMtu)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_mtu", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "snapshot" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "snapshot", r.URL.Query(), &params.Snapshot)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshot", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "names" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "names", r.URL.Query(), &params.Names)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "names", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "score" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "score", r.URL.Query(), &params.Score)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "score", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateBeaconsQuery(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteBeacon operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBeacon(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "segment-id" -------------
+	var segmentId SegmentID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "segment-id", chi.URLParam(r, "segment-id"), &segmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "segment-id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBeacon(w, r, segmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBeacon operation middleware
+func (siw *ServerInterfaceWrapper) GetBeacon(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "segment-id" -------------
+	var segmentId SegmentID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "segment-id", chi.URLParam(r, "segment-id"), &segmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "segment-id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBeaconParams
+
+	// ------------- Optional query parameter "include_blob" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_blob", r.URL.Query(), &params.IncludeBlob)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_blob", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_peers" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_peers", r.URL.Query(), &params.IncludePeers)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_peers", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBeacon(w, r, segmentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBeaconBlob operation middleware
+func (siw *ServerInterfaceWrapper) GetBeaconBlob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "segment-id" -------------
+	var segmentId SegmentID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "segment-id", chi.URLParam(r, "segment-id"), &segmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "segment-id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBeaconBlob(w, r, segmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBeaconSegments operation middleware
+func (siw *ServerInterfaceWrapper) GetBeaconSegments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "segment-id" -------------
+	var segmentId SegmentID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "segment-id", chi.URLParam(r, "segment-id"), &segmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "segment-id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBeaconSegments(w, r, segmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCa operation middleware
+func (siw *ServerInterfaceWrapper) GetCa(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCaParams
+
+	// ------------- Optional query parameter "debug" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "debug", r.URL.Query(), &params.Debug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "debug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCa(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PreviewCaRenewal operation middleware
+func (siw *ServerInterfaceWrapper) PreviewCaRenewal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewCaRenewal(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCertificates operation middleware
+func (siw *ServerInterfaceWrapper) GetCertificates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCertificatesParams
 
 	// ------------- Optional query parameter "isd_as" -------------
 
@@ -437,6 +1358,22 @@ func (siw *ServerInterfaceWrapper) GetCertificates(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Optional query parameter "include_superseded" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_superseded", r.URL.Query(), &params.IncludeSuperseded)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_superseded", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "expiring_between" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "expiring_between", r.URL.Query(), &params.ExpiringBetween)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expiring_between", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetCertificates(w, r, params)
 	}))
@@ -490,7 +1427,177 @@ func (siw *ServerInterfaceWrapper) GetCertificateBlob(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCertificateBlob(w, r, chainId)
+		siw.Handler.GetCertificateBlob(w, r, chainId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReloadConfig operation middleware
+func (siw *ServerInterfaceWrapper) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReloadConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHealthParams
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "check" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "check", r.URL.Query(), &params.Check)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "check", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "checks" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "checks", r.URL.Query(), &params.Checks)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "checks", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetHealthChanges operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthChanges(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHealthChangesParams
+
+	// ------------- Required query parameter "since" -------------
+
+	if paramValue := r.URL.Query().Get("since"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealthChanges(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetHealthHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealthHistory(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInfo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetInterfaces operation middleware
+func (siw *ServerInterfaceWrapper) GetInterfaces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInterfaces(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -500,12 +1607,12 @@ func (siw *ServerInterfaceWrapper) GetCertificateBlob(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Request) {
+// GetLiveness operation middleware
+func (siw *ServerInterfaceWrapper) GetLiveness(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConfig(w, r)
+		siw.Handler.GetLiveness(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -515,12 +1622,12 @@ func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+// GetLogLevel operation middleware
+func (siw *ServerInterfaceWrapper) GetLogLevel(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
+		siw.Handler.GetLogLevel(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -530,12 +1637,12 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Request) {
+// SetLogLevel operation middleware
+func (siw *ServerInterfaceWrapper) SetLogLevel(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetInfo(w, r)
+		siw.Handler.SetLogLevel(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -545,12 +1652,12 @@ func (siw *ServerInterfaceWrapper) GetInfo(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLogLevel operation middleware
-func (siw *ServerInterfaceWrapper) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+// GetLogLevelOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetLogLevelOptions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLogLevel(w, r)
+		siw.Handler.GetLogLevelOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -560,12 +1667,12 @@ func (siw *ServerInterfaceWrapper) GetLogLevel(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// SetLogLevel operation middleware
-func (siw *ServerInterfaceWrapper) SetLogLevel(w http.ResponseWriter, r *http.Request) {
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetLogLevel(w, r)
+		siw.Handler.GetReadiness(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -600,6 +1707,14 @@ func (siw *ServerInterfaceWrapper) GetSegments(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetSegments(w, r, params)
 	}))
@@ -693,8 +1808,21 @@ func (siw *ServerInterfaceWrapper) GetSegmentBlob(w http.ResponseWriter, r *http
 func (siw *ServerInterfaceWrapper) GetSigner(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSignerParams
+
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSigner(w, r)
+		siw.Handler.GetSigner(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -719,6 +1847,125 @@ func (siw *ServerInterfaceWrapper) GetSignerChain(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetSignerCsr operation middleware
+func (siw *ServerInterfaceWrapper) GetSignerCsr(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSignerCsr(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSignerStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetSignerStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSignerStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSignerTrust operation middleware
+func (siw *ServerInterfaceWrapper) GetSignerTrust(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSignerTrust(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSigners operation middleware
+func (siw *ServerInterfaceWrapper) GetSigners(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSignersParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSigners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) GetSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSnapshot(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetTopology operation middleware
 func (siw *ServerInterfaceWrapper) GetTopology(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -770,6 +2017,56 @@ func (siw *ServerInterfaceWrapper) GetTrcs(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetTrcDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetTrcDiff(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrcDiffParams
+
+	// ------------- Required query parameter "a" -------------
+
+	if paramValue := r.URL.Query().Get("a"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "a"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "a", r.URL.Query(), &params.A)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "a", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "b" -------------
+
+	if paramValue := r.URL.Query().Get("b"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "b"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "b", r.URL.Query(), &params.B)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "b", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrcDiff(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetTrc operation middleware
 func (siw *ServerInterfaceWrapper) GetTrc(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -858,6 +2155,36 @@ func (siw *ServerInterfaceWrapper) GetTrcBlob(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetTrustAnchor operation middleware
+func (siw *ServerInterfaceWrapper) GetTrustAnchor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrustAnchor(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVersion(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -971,9 +2298,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/beacons", wrapper.DeleteBeacons)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/beacons", wrapper.GetBeacons)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/beacons/age-histogram", wrapper.GetBeaconAgeHistogram)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/beacons/anomalies", wrapper.GetBeaconAnomalies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/beacons/cover", wrapper.GetBeaconCover)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/beacons/heartbeat", wrapper.GetBeaconHeartbeat)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/beacons/policy", wrapper.GetBeaconPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/beacons/reconcile", wrapper.ReconcileBeacons)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/beacons/selected", wrapper.GetSelectedBeacons)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/beacons/sla", wrapper.GetBeaconSla)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/beacons/stats", wrapper.GetBeaconStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/beacons/validate", wrapper.ValidateBeaconsQuery)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/beacons/{segment-id}", wrapper.DeleteBeacon)
 	})
@@ -983,9 +2343,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/beacons/{segment-id}/blob", wrapper.GetBeaconBlob)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/beacons/{segment-id}/segments", wrapper.GetBeaconSegments)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/ca", wrapper.GetCa)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/ca/renew/preview", wrapper.PreviewCaRenewal)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/certificates", wrapper.GetCertificates)
 	})
@@ -998,18 +2364,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/config", wrapper.GetConfig)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/config/reload", wrapper.ReloadConfig)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/changes", wrapper.GetHealthChanges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health/history", wrapper.GetHealthHistory)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/info", wrapper.GetInfo)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/interfaces", wrapper.GetInterfaces)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/livez", wrapper.GetLiveness)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/log/level", wrapper.GetLogLevel)
 	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/log/level", wrapper.SetLogLevel)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/loglevel", wrapper.GetLogLevelOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/readyz", wrapper.GetReadiness)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/segments", wrapper.GetSegments)
 	})
@@ -1028,18 +2415,45 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/signer/blob", wrapper.GetSignerChain)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/signer/csr", wrapper.GetSignerCsr)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/signer/status", wrapper.GetSignerStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/signer/trust", wrapper.GetSignerTrust)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/signers", wrapper.GetSigners)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/snapshot", wrapper.GetSnapshot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/status", wrapper.GetStatus)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/topology", wrapper.GetTopology)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/trcs", wrapper.GetTrcs)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trcs/diff", wrapper.GetTrcDiff)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/trcs/isd{isd}-b{base}-s{serial}", wrapper.GetTrc)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/trcs/isd{isd}-b{base}-s{serial}/blob", wrapper.GetTrcBlob)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trust-anchor", wrapper.GetTrustAnchor)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/version", wrapper.GetVersion)
+	})
 
 	return r
 }
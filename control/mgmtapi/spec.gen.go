@@ -18,85 +18,328 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x8bXPbNrbwX8Fw90M7K8myY29rzTwfFNlp9WyTeGx1d6ZNrgORRyIaCGAB0Laur/77",
-	"HbyQAklQouwkze5tJh8sCjw472840GMU81XGGTAlo9FjJEBmnEkwH17i5Bp+z0Eq/SnmTAEzf+IsoyTG",
-	"inB29JvkTD+TcQorrP/6q4BFNIr+crQFfWS/lUc3CrMEi+RSCC6izWbTixKQsSCZBhaN9J5IuE03vWjK",
-	"FAiG6ZdDoNgR3YC4A4GKhT23geUM4Njuiil9u4hGv+7ZFZYrjfqm9xhlgmcgFLE8JmwpQMpbordd4Bj0",
-	"wzpGZgkqlyC+QCoFNDdYDKJepNYZRKNIr1iC0IzLJV7aHXbhZen42a7VNGrWEwFJNPq1ANEL4Pi+3JLP",
-	"f4NYRRv9hCiqH91Mpm/foAyrtC8t3SjmTCqRx5oih7ZG0m7/A6hrp3b/38myyqN5ye39tDSocC83MS62",
-	"N9Rr4MDylaE7uxWwJFIJo2BRL0r4Pas/i7mA+jONNl7aTx5DxpTye0iQ3Q8ZvnpSk0oQtqwhZJVDweoQ",
-	"GWoYxaY/Eam0omC3+dzbXHq7YyHwOupFOSO/5zC1OyqRw6YXTcZNYcQg1O0dpiQhar0Pt38W6za9KOOU",
-	"xHvfuLKrtLnlVlD7DDov5eneuP0I61uSdHzxH7CeXjS0pti8AbSko1fjREjBJpptC+2ooMnIhEhF2DIn",
-	"MoXkluGVWdPQCSKTW7xXCaYyGcs6DzBdcqPYD3iVGaW4nFzcjEOa9xzW9aLD1aHG7gAvSso98AHyGqh7",
-	"duexH/kuNSSpFJOA5yFS5iD2keWLubviVt5qVT+HQQtVsUa7E20vBdGoNAjcK2vzthVzN27UVbHz+mdr",
-	"kTHPBus8wB4XDT9Q/CReTi+qVrXAZy/w8BRHvWjBxQqraBSl8NB35rVLdNMEmH5k8GxY5SSF+GPAc2CF",
-	"94sN4o8XeqHJcBQmtJlZjJOE6D8xRYRZ1IlNKLbEhfAqnFUV2hu8MqlJCpiqFMUagyosIwgkyZKBQPgO",
-	"E4rnFEI7CMAuFajucW2eowUXFj5aYEJzAftxlgqrXHZID/WqumY5j+Rg9KwEPG360ZI8KUgO6E0hDp0z",
-	"lmy/8uSqY+4W4isBoMlcoe1qpLc1tOvsr87mxp4WqUAE12/IJm+LjMEHbDKFTmmI1dVNLa94LuNLjjuk",
-	"/TQzX62wWHsY28UIs8RDvoUtRcbZZE9asm0Xvo65dXzdyz6aIO5IXIqrZmdN7HgW8NJ+cVCq+elJKPE/",
-	"KF+oO9Ai4lYzfUfJFdY8dhl9yrMQ+hZuxTse9xeL4XA0HB0fD3X6hJUusKJR9F/v3iV/63/zK+4vhv3z",
-	"94/HvdPN6NvHk0310bf/o9f91XOj05uL/vhmj+/8iS9/gjugTW7S4nFN/flySdgS2a97ZTmQwDxfGp4s",
-	"dLoBph5877sb900NhRpvLdhQlnhVJsZ1O8WE3VKyAEVWVdFH352kw9VQ7t21BiO4veBzCqtAmGmLGijN",
-	"V5ghATjR/hvBQ0YxMzqNZAaxDnFIcaRSIhGP41wIYNuyNbMbIpVihYhEKdBskVP9BuUmNvqrtDUvyR0g",
-	"nBg74gyl/F4vzgSPAZIB+pcgSgFDhKFLtqREpuatEj/tMYEtCQMQsodymWNK14hxhWROFCRmBeMMKYhT",
-	"RmJMtS/5CCmnCQjrUfRqjR4l/w1JNdxMOGNga1vFjZOeYwlIczxBPFch9SRMKsxC5f4Y/Xw9RQIWYLlm",
-	"2VToujTMKbncyt0egsFygOZrEz/YEmG0ENjabglMIC6QzOd9XaxbiXniWWcwQK/xGs0B5RKSmoAE58pu",
-	"SmT5EmEWP56LGFDMk1pkPnILj+KSZ31jUX9R/COwvjalvhZc33Cvb7lXZlW5IP2SM7ujfJWpsxTQj7PZ",
-	"VREjNGZoCQwE1vKfrw3aXJAlYUjazo8NtLtUuELb2fBFL1rhB7LSfuPs/LwXrQizn46Hw5Cvdg6tqQEy",
-	"5UIrZxnhmoL5o5W+iGs/s52JnH2gKVzgnGoZ4jnP1WhOMfsY9brovu1M0HXdCHx+IM70Aqt9plH4oDy+",
-	"3ZEEEjS+mg7Q2yzjTpl9S7LeizB0/WrS/+774Xc9RIx3YkBUCgIJiPlqBSyx7851SVkgahiu+ZVxwpT+",
-	"Glsf2S/FkfA418Zn92FcoCXlcyMSS1+Z11XE3M14DjCRtvzKqmIoPhS9y0Z8gIeMuNbX6HGLQIIVGOsN",
-	"qUPKs+6dLZ0LBRLKDv0Ji7KtWimW6jbPNFpJd0T1c6nwKuv6SqgW3QLp+dyq4eS4EuyglvnWnrrUUdxS",
-	"5QNLbg/sIx3KZGBLmzTXkirzvLBER0xFq49DjlEqLNTts1LZJKqB6flsKDFutASezPtGV2B+epacniZ7",
-	"uwLu/T357I2pmpuyxfI2rrYZD2hVVU24Kjq7IdouQWRlXed87boX2uXNrieoaLBU3dXJ8OSkPzzuD09n",
-	"w/PR2fnoxYtffGbstj8Rd2hEzq4nVv/Mcna7FDiG2wwE4UkgCbie2EQGS6RELpXNYYjUft+8iuyrPUOZ",
-	"1liKFUhliIwxY1y9Y0U2VAEyeOepxpxzCrh5FFFxATW5lRSHafH7f5wpwSnSOTcUzRSvrAyqaOXUq+kf",
-	"isdVfpnVaAXSnC3s83hlYRTa3SVlRU2VYSmtESSwFDgxXnCBCdUPK7XVdmWt1+ISudKzmGwkeKpys+1D",
-	"1ru7zy6Vg+T63fGKS/j+HL08R6fnaHKCTl7p/+cTdHGBhhfoZIzOvkPjc3Rxib6/NF+doVcv0PAcHQ/R",
-	"xbFvODLDMST9qjOpUz27ngScRa5SLojOQu7gFssDjpnKyFAPx+Yg7NOAqqhf6Cyku0P4NM1k7+RhS2Yv",
-	"xMYq8p65atexJ4DMridPbs87gpvINwJbN0SsytbOX7GEW5av5jb+7A7dRCYdulQSBME0BPRFc3nT9KJe",
-	"Bak6vBr7Q4HVI5pnnPLlem9ntv7iPz0VqzKMcXWLF6pG2fMCooY5hwUX0AB6/ESg9Sb3doeeR4LHzIJi",
-	"Fyab3NxsXJ+sWdNeTcsKx6ZYRRxzhWTUjHBFiTm+mmpbBCEtrOFgODjWPOEZMJyRaBS9GAwHJ7a7mBoR",
-	"HNnzbvP3ElRLt3uLjVtuK04sAH1k/J4VVWLsMCrCDJqlgATInCqpEwNdDi4IVSC2zQSTfKLxTQ+RxgCH",
-	"Ti/MSXxtlAO9XCNXKfcQphTlzCQN5fm9NLgJULlgkGg8iERzSPEd4aLAJE4xW0KC7olKDfQPmNIPZtMP",
-	"xqPdYvUBZVjgFSgQpk2u1dekD9MkGkU/gHrp+Kd5Wiw0cy61LNFQ6TqyfFGgaTmEk8QQrvEiLKZ5Auie",
-	"0CTGIpHom+G3aM5VWurF9ObCIDm+8VpU1Zyy1kwmGoXfcxDaQ9tTqXrS320sqAzydfpe2xZOOUZh5ycK",
-	"sRWC2JL9ltF1U5mKt3XOTKl51QFyLQuqtfGeUKrlV4rXJ73bXMr7ME/KUZ5u3KiPBe2fSCJVZE/CaDQH",
-	"iXyMyt7Z38/OXpx53bNhKCQ0kvui1kZYofuUxGlDOkYUxgAGaLpAOZNgXIDrGpken0LaYWp/qesCneg7",
-	"IzMNphRLhBmCxQJihcjCWNb/W2Aq4UOj+DnuHx/3T85mxyejk+HobDg4O/mlRWcLq6zwo5sLb8rG2llB",
-	"s4AlFgnV4uILv5ozx2QC7AcNfdCCHKa0glfZyjN0h6qeOk7/SsH00BRHArQfB9clFgpxkYBA32AZAzON",
-	"6nnpAr9tw0hDfyZKY6UEmecK9H6Fulh/rrVEo2ZFbzQmB/TB9ysfbI9SFvHB+T+/sW4dxIIIaQ7LqtpR",
-	"qQSDTowLFaaw3jsqSqoKSL/xVPOHtdd3zfaVSva+Vx0MPRkODxrIDI3zHTrg1qwXNsH0I3ymvcIqTrV2",
-	"VaL9QAM9tcSEMCiJPvJGYc0oqO3Mt6YRWgR4Kf35Q/1akZQcPbrWUp8kGytdCipwEnBhnjfgbyP7ktwB",
-	"KxtV04tmKLcgHA/3BPPZtkeHphfV3KRs3mnXqR8TluXKGQeR9shC23iKGcIemKKRrgknicmQMMoELMiD",
-	"8UE6IJbi8T21ZUrhf603XuTUpAvmO/8FXQ4kiBtXTgSi7jBXb2+tm9hDg+MTNF8rKBBwJOJY5Zj6fDT9",
-	"HHjIKE+gdCvGUnWK6RlqKcjIz6ZtydBxJNlvpEq1Nh5CEuMqAqZ32lQTN1jqGIZkHscg5SKndP00Fe9F",
-	"Z11eKaezqzbRorUho+iFk/MfbGD261Uzy7o9+PQB78hf/yCNn+fK6nR5VOVrW3VDeMCxomvEWbFxr0hK",
-	"TG5vUCGylhV+hYo5/GRD+uG58IB7rzjFymjNsx17oYKVLWr9k64u/mhO+by1Eg3upN/QxcHV5WsELOY6",
-	"Ndqh5y/1Bg1d/7dTk4d+Bqv+gtBak6Ov/728/GH6Bl2NZz+im8sfXl++mZnH75hhnOXDYDB4x8zjyzcX",
-	"obXRHiUykvo8yjO3MgpqTYw99WjIeIKjz2htk3HQtMoggt4W+DyfMdOtjSIzCGDYNBkPPMbEWfaRFHzZ",
-	"HpF0aOW4Eo6udUAnd9Cc721p8LxjOzo8oQaPTfgH6FUudGWz4gJ675h24XpxhqXUSQ4WisQ5xcINBhBb",
-	"aG0rVIP0Fsd3zCFZFqoISxt2BmiMXDlT4FPONZiySgcHnUu9Yz7PerX6z2ZHtn2nP99h4o7uTMLT1Dyf",
-	"/w3/Eqzxn9x4+eSFcZditlEpPjeudRyWLUfym2VNaxHT1GbPIFsQdCMjfzvMJRQzgcH7cVYxxe5yKIDr",
-	"fgs/ejRLi6poZ7Rsju7rugC7isjN6e/X6halrgbJAqsnh8jyEsVnTZvsJZaAzBr3Dr46vWmV6mFa0y3R",
-	"aqqOybDsmb5OuHSFKG0K9iSlCmdjX5NidUi0JpfXs+mr6WQ8u3S50/jGV6RqqtVcvRPUZHwIqKiDStcz",
-	"t69cr+vZYEW5OVuQ5c6E0K7YK3IFD+ooo+5uWyPqlcHyC2V/V4IwZSvi2dvXPyFLaO4a0lobK3kgX63K",
-	"BHl7KyNo2lcCpC6pvYsx1ckQhClny23jDB4gzhUkzdsuDWa7qx6f0XHXrqSE5LHjFsknSMoTUo51y8pO",
-	"vjyKuy1GHsUpb5uGTu2diH8v/XyJJYl95qIML8ErVGpVgr2CIGWr1lK+PCqvm7Sxqryp8hk1rNzji/FS",
-	"ez5au1LT4FEvyvIAU25qTDHwX/Jk/UX4UVwE8vffRubNf5SUbrpISWuy6xN1nqfwB2jbpioOn6bALEFg",
-	"JwRqI8VoymQGsXKN2oTckcRr6UuXyOlCHdmLTZCgOwL3QZd/U1B74PRDaMD5y88szECsCMMU7UDqpEDq",
-	"pBWpyrj0YSh9kSK6MvN+QBldOwusaOrg662oA9h6xuoe1az16QeNlQH4g48bizsjTzp98bf+vKeNVSfV",
-	"+cyx+tr/6ZPH4G0Jw8KvwZDKY8wvh0Hrj0e1n4763Ata9DMPSSv2tCPa/QecHx34W2DFr3K1nQlV9Lql",
-	"5vq6+gz7by91jxeHnFpWdmztpu3Svj9PMK+wSou7YE8/x6xI4qvuibXh26qk5Q24tkra3ZH7nC7D7vCl",
-	"O2YkeGw6vkF+G7S4o6/55Hcr+vammLvH1XbSarn71Aa66ZVX7b6lTW45OHG9/T9b1p9u2OCgHrPybr20",
-	"mVN5M+YzGlS5xx/RhHYUlOP84xtU8GV3N1qJuEMnxF0etX5uZu6KXnOu0MRve9vOBOA4NfcfDr5/0jKd",
-	"MEBvM3uTia7tVZLZ9aTsrjjHbG4kSAXYzAKYCXcPb84g3BCfaeq7hermcECll1DW+YHfxmj8jJQNy9oR",
-	"Rl/36X55n++ApoTbdk7BCOpTTiZreG1eQMTyiMjkkchk058/6lp205eP9jrdpmPy16baLRFgJuJOh6NW",
-	"Wdozup1XDEsNqcLUBHYDetwZpmVWN6ih242fs8SZXU9CWje7nnzCEUm9yZP065AKo03JiiqjSD5Mj8UU",
-	"G63a1/l4/k8NfGIiNrueuDzol9/G929/G//99ezyflrLmraroqCKfuL8qIQY0FV7Jfmu0IVc0GgUpUpl",
-	"o6Ojx5RLtRk9ZlyojbkULoh21PbXArlUtfs5PMbUPDY/eixqX78Ynp6daJt8X6LR+N2FOxBrZTqUAqj5",
-	"gSrFw93qehUcNU8DdkGbXF39Y4pWWBkF8sBZxjSBTUwWhMZXUwQP5a+BWGAuOfGxcklTACmWmJFI6ePk",
-	"Hd5vf90hANUdRG/eb/43AAD//y7RBnW/XgAA",
+	"H4sIAAAAAAAC/+y9e3fjNpI4+lVwtPtHskurZfcjiffsH27b6fakX2M7M/dMuq8FkZCEMQVoCMhuTW5/",
+	"s/vf/WL3oAoAARKUKNv9yPllz262TZFAoVAo1Lt+H+RysZSCCa0Gh78PlrSiC6ZZBX89ZzSX4q8rVq2P",
+	"ytI8KZjKK77UXIrB4eBM5OWqYGQC7ylSsRmtipIpReSUsI9LXlHzKqGiIHnF8A/NF2xIXq+UJkJqMmEk",
+	"l4sJF6wgt1zPyfiGlry4ono8HGQDbib6lwFhkA0EXbDB4YCW5SAbqHzOFhShmtJVqQeHU1oqlg30emle",
+	"m0hZMioGnz5l4VKOV5WSVXs1+JxQsw69qgw8XJCxYB/1VQ6/jc2y9JyRZcVuuFwpsqQzNiSXc0ZKrjQX",
+	"M5JLoblYMUXoVLMKXi+p0hZJyREycjvn+ZxwRXjBhOZTzgoyWROuFVGy0uSarRVg0Tw5OzFTrlTmEa/n",
+	"VBNaMUKLghUZqdi0YmrOCiIrUrGFvMHFTJi+ZUyQQgLq1ZxPNYJDZ0zhQnCpZMlh3HphWsKfZvfcIqa8",
+	"Uhq+JSW/ZmSsBF2qudRjHErqOasIbB6pKSszkCxppXm+KmlFxmaFY1jd2OzIOCOLFSAMJlF0wcymTGXV",
+	"mNQQkV7RslwT9jEvV4rfMEtCcjpVDAmIfaSLZckGhwO2/su8OD57dvbPs9vX/zzVr09O9evLv/36+mT2",
+	"7PXJ0bPXJ8/pmRh1EB3iJaI7S2dKV1zMmmR2worVkiXITJYlXSrW3jyDaXcI/MoV+9eKiRxwPpdLu0lS",
+	"lGuiVsulrMwnN7RcMUM+Y/PKeEjeTgm7MWifVXK1NN+qVT53U2b4vZljIZUmFcuZ0OWarJYFNeNJAaMh",
+	"QBmhQkhNI9jEajFhlRk4t+spSLFaljynmqkY7wamDqQWiKQdkKryNkr/PmdAaVqSyixbWcoxJ0dWBavI",
+	"d1TlTBSGjidrYvnF98NOqFR+DwZz+nFJRZHa+cWC7ilmjoJBpkGvwaCEF2hJppyVRfM0m1Ux6jcP9x83",
+	"fJyXVKmxeQ/PKvzNpzxHTmuPKX5ozlAuK2ZYgpBiz/w73if4ugMlDNfUe6NODfNnxVtRrtuIME8Jb1we",
+	"sOo5vWF4cRi640M2rJmcnFo2SQU5uiBM6Grt3iUTNjVr08jAKia0vWcu51wBYxWaCYNOw0dys22rJdFS",
+	"llzMNt5GtCz/V1crNjaIq+8mx7MVK1muPZCTNYFXuDYrJJQUfDplAM8tXQ+7sWtWcWWO5f0Ir6Rc4Ich",
+	"wk/YZDWbGeqnvBiSsylRTGdESA+3ITd37Q3JmVCa0SIDfFZMLaVQjOCYE6bIXN7CT4pVN6wC3FbLihmi",
+	"No+bHH/DsgHeu6/450ou3jA+m09SN/rZxcne0YUhHEqEfYscXQwJEGAtteSM3wDXA8ZnTw0sakpzpvDq",
+	"46oeI8aWOZD+J3d15VJM+WxV4cVrBtRyKUs5W2+iNi5mFVPqyk8en9D9vel0NDocHe7v73fgdFrJxZWD",
+	"JsLsf1ZsOjgc/MejWuR7hL+qR2eqOFIt5K70qmJvr9uIPcqvhbwtWTFjeG7rc+HPGvK0cu2WP4XRukgB",
+	"f72S1/cghjNE3pnDXUpghTfqvSW8iDB8kIautS0RlAv6kS9Wi8Hhs6dPHz/NBgsu8O+Rh9h8OGNVE+JX",
+	"fMF1G8zXOGBwyXbIChmhGq/w/dFoVB/s6NhaRqssEWpaBgMvqM7ncC06RiAM452ShWGn7iG8lRHqhEMn",
+	"ixnZ2Ipiv5qZNNFyhncxMk8vN1deJjOnyXxC9LySq9ncX9X2RjT8ONyS/dEovSklIC+5EQYbwT7sb90H",
+	"KZdqw12FZz3eBSPoU2DxpZTL5mXFhb0fFqtS82XJ3JXFmcJbzkt4lkt5yb8akotAWCNcFCBVEUoWXDm+",
+	"Ajd812kyEKn73iavuXitVwnqRLSSJdVz8vry11jMUA3uejuXivl3HZpg8QtalkzpcIwASUYfsE9LLq6V",
+	"V170nC0ywpWh/ZJRhRrMjN8wYeWixmUWHAoPM1fuXHj+7A5MTH1PfujgCQsurhZ6lRKJOqjsDV0wlWCm",
+	"VroGIIysHEjZ5guPBZnTMrqW5mxtmYG4BkExI1SRglVwnZmbILp44oHMPfhSLi01R+8Vkim4nyqmZHmD",
+	"CDV/e0UgwpGRjtI4ErDiBIY6SO4tsIg2it508yvPEdU1Xy7r3WxwlAdgjRvUTcvm4quki28hH4wZV+8b",
+	"48KI7Qka0tqoCJT8a0VLI3uqHMRhadXAUHfAn7jRBhbLlQ4ppV58fRAdyhaUC4MNL966m8IQxUrkAeo1",
+	"1TwnXEwlYR81E4pLkVlLiGYih2/JhIrilhd67m0iVM8zcmtkFwMV6CteiHJXjhE5ec56EiCsdQcCvOAz",
+	"wYq/cz1v49j8Ro2UQmg5kxXX80WbbTW5nyEPz6ekYLXmomCq+qwjA6uH7mZiqg0IMAlalhEDrVg/Hjc4",
+	"PT65ONq7eHl08PRZx42C0F4ZaPsrgRfWHJTApf2FaHnNRGRzm6zJ+FFgSHoeaCjM8HfQnaluWaPcN8Ed",
+	"w6uIXFFbRJ6Zz1lumCadUS6UJlzXUwFPuWUVCwxpioucRfOkbDa88uqnkQ+Y0HjmrAqutDl6nr1eM7Yk",
+	"c26erg28K0UmCRDQfNcBgGXTZuCYKw9enOUXL188vz46OuraVLc//XdUVjrJfSo+WWkG+i/KPRVTq1Lj",
+	"tiEvhsuJkrzDCjIG68dvhwWvWG4G/jBG6lBD8opqVjnzyKRi9Jpojjcjo1XJDc8S5vBdeJOYfdlMP64t",
+	"0eOMjA3NKE0XS/OH0rTSV1wVV1SZv42p9sqawczfLckfHqriKpcr4ayWc7m0f+Nu+yUQrgjjIA+PqcrH",
+	"5DtnegKJGKydkUEnAsdQH6uUs7y25MTYDDuXy2ioAMh4nJrJF3A55toMrWrxQ1cUjGjRcPUaO4erzZP2",
+	"mxrvVwbpV7BekNyImstKz6lAxh68eYhIiSg5xMohVXnWeL2LvGUVk7aXfwfhNg+yLTRvZj9TCYMe/GKQ",
+	"F6hoWcMLYq+HULrvVv/DhcZiaMcK3fv99NGtepBb6pHasFhrUvFSkdlwWhSgWOdUeMPeLS+LnFaFIt+N",
+	"vicTqef+EjcoM3vfQMxRqVklqOY3rFwbdXNJtXlCrOkWtC3FxaxkZPxfY2t7U3DxxfqGCoAFfwPXAJwU",
+	"3Mi+uNGkYsuKKSY0kFKGXwXit3FShJbF8X+NM8KGs2FGxrUp5r/GnUaaLl9CuM/3s9H8quiMqW4ljZal",
+	"vGUFWcF7jiDryzaps9UGaPu1QXxZwqd2ICtPAPO+5YD/WloJ0fHbYLW8qtiMK40ndpANCnkr4mcf0njC",
+	"yXpjCPFiUdJA1N+MHHCUuLwu3XVAqPZ3V4wewAUIEiCIrYRiIH6IyN5smAnII1yRlWLFpoNeW5WH5Ij4",
+	"Gym2lQF2VahbW7HA4Ftpi3WAC+UhikZvR6NakmVJzZkxsrtmgorcHEtRyNshuZRktqJVLQHlpcyvzb0E",
+	"i/i3FIywqpLGYWfh8XA6e/E/WW7u2pUAbjf2RjxcmkEECK3mqEd6V04rFFHJLa1E0+IzOBgd7O/t7+8d",
+	"PL3cPzg8GB0+HQ2fHvyj4zA502NEJlNZLageHA7MruwZwBNs/lM2cCChv5sW58bdpoBMrABn/kmX6Nni",
+	"Ujz6p5JgYe9HkheaioJWxanBJE4Zk99zWpDKTvopG4DxUtDyywHgZiQXaMx3LzpsBpEAAElZvp0ODn/b",
+	"MiubLQzon7LfB8tKLlmlOeIYnUxt39gGz1XmZSijzaExUUhxBX8NyZkmPGH1iDme84FZndWbTszpzKWw",
+	"6nxWf650tcqB6u0ICpdkWaW9OMxYdvf80UZX2f8635yl/YZrjqtg2pj63dLaBJsN8mq91PKKznjJuv2g",
+	"CUUw1DYDJ0dSlfTsP1C/qSILWbBKtNcPnmUq8LKAC4GHmvtw0DY0ZgPvK0ahcpPJB6MIIo260zse6E21",
+	"X5oL58BxZpBtW4hu6f9FZ3rDP9CUoLKEd6CH4yGiywBHwbiLlPX1XdLq+lB21TZqwtsH8DrlRkpDRd1K",
+	"lmamhNW0vSJrnbpSaSvWX/Hn0B7koRsB4PsZmfOZoQfYTa1Z5RiA+cLbjhaMeiYCk9XxI7vYuPB5beAK",
+	"zVfxL96YNSTHnh3WJ8kZT/FlG34DlE+0vAUh2YxnwN5OnbAgKz2EWB8NDdbrm0+uJmXARXDhA7j0jOhl",
+	"NvHqhtPE2bPuQ4OKowtYbcP47J03KDAFHPaW1h7VDA07YENO2KOH5GgSkhlve+esnUTIW+EDkNzXnZ5R",
+	"csNpMMgB+S6f87L4PsVQPQO8qhlgL9Ofah8tUDQw3qTLLtj3YCX4slkv1wzB67TdtRZoH9Cqomvz98or",
+	"DDtJ0oYGecUKEOidVN5mfB/8hHJihMPBJ/OEawD14vjs7Rs41Xv2MjXXBF605k5EbAy83H40Y89X+TU6",
+	"BBpSxLZLI/DDAaJhnIhkfkgxqNVyyaqriVyJotstS2cN9r1pmsHTxUilNiaY6kqxXIpC3X1K85cdJJr9",
+	"8SjtUYi2M1hzGqzM4jvYy+fBdcwFXP8zB8ygRQTBjr7kSstZRRftTcWvE1hAKoAlc5FXjCrDmcKTxisC",
+	"INNqHZ2T7TReE1nisMiyYFUfMvOMHr4wf4og8jOAbLMkAS6oTfNZXbO0UUZefw9HfnKwdctxnsxj3K00",
+	"2OCjGQP7IBh2WyK52rDHQi5ouW5vL4UfeMpacQnc/4ZWnPprs56M3HBZurDCXbYWIfmFiyK1ubURsQ3Q",
+	"KViVlQ6DmC0C5nLpjMstGa6P1pkNeLFVaUP2eHZiXo9slUnzBbl1tojgEjafkdov0A80r+EnNLQwfrsd",
+	"WqiYNheXeSgrPuO98dEgTV4MQjCibcqadtuapFqsCYnIUU5AXVspF+glIcHbAeyYliKo1ynQhr2+Qnvh",
+	"Ve1liC97pCimnF2RG3p372ZkLNgMDKB4qd7Q0n/PetEkjwPPNUO/Y0bG/2aVvEIgu2CiOobHudlwHDdG",
+	"e2n1B1Zi82YfZxPa8s0CTaxorOJipVmwCvNmk7prwmbCmLZ/G3Sgf5ANWigdZIMAGe6v8JMm1IMPLbp1",
+	"VHNMRcENQao2y7NizhVP3e1nJ6p5pwPNQtxBaHIgZydkWbEp/5i5sAZz5fqIZrgIe/PGiLfEPLFxFEPo",
+	"E1e/j4+gbUC7D9mxvGHV3TGFJv/aNOsxYnlPoGCLWjlZO1cq6iUPgatssBK5WQsravl3M+xRSKj7AiQo",
+	"udKEivhS77vXtayXlCj6bnDHeoJ9B5eCcZ4wHYo+8BGQQRlGJ3VTwAkrmbt1YyIozC+suOot3dfWHvtp",
+	"ypDSWHQ8SbC+c/BYW6a+Kq/tLDg0Xj8dK/qZga72LlpMQ/hBB1niWuU3PPf+s5qf29CatVUcDdKNBQN8",
+	"rJF+8Y//7/+tuInOvLjl+t+sKjFOv8WtcDVXOwofG21aDVsWeKA3Q/s0aT9DP1hv91e4m/Wqgp2sNyK8",
+	"oskUt6l7H18wfW79AX9RKQKdeDP4dunTDFvR24QFr5JaTlZTwkQu8VBHl7E9laFJz3oCzIvjR/iaevS7",
+	"fXGPF58eTUo5GYMBQlmDP5lQxZ49qWcB49GSFvDHd+c/H5Mnz578mBFlYxWefL/d9GR9u1dmutoC5cW8",
+	"yVpvl/AsEj90bsNLRis9YTSh96NomThGr1DSsShcGjYBr5KjC89IJ2vrEd5Rj/DwvIUhU3eB4qVhpCCz",
+	"JOJjau09yLdywE7YWoqizm2xgHNFcNSGoavLnhCC0G1QuB8oXWaGn3qYGSIUdYCb+Q0OTnO0tZ5F+u3d",
+	"dNU0d67NlmesG1egWtVhVz6BtRt/AXqsVXrUkLSN7AoD1TF5dWLERhV+Rz5pdCWDt6tdVbrEsiKU91cm",
+	"cYs3pOt1IFE1rSh6XjE1l2XSl9TUH11QRWv5WbTbHrwuSoOrQ9R01k1l/iI8dnLLHY2VYSZSJMym/UP3",
+	"9zupHvJSKvelJTttXgsEBdVfd6ARz+h9cYj7RQOVAPn9w4odnsp6YKI3Hb2TJc/XKalD6SvF9JXi/2ab",
+	"cGAFAgjNM0PQGdUMU8DR4dMIUE9hJXfq7M4zmsVyc26N5Id6mk1RiXN52nOi56PfZYxI+hm/ME5S+vGq",
+	"EWfYbUavX4xYXmA+AUsaBK0XkWobLWPwbD7quIUT4Gy37t8drODGyUhl7MzgXryN0X6w/yyNeHySssYu",
+	"Ac3EvBAv/Z2lq0gh6pD04NesQb9JEkvv42Z0erpp2/6WNZA1+VsM48q2ncKfPUk2BAYT5ACReybHqvtu",
+	"MxGO5g0VxtGlQyGoupqUNL8uuUrFVl8wbxdaox95uWS0ssGQNXUmfINRHOJWz6BZ1AZAbIzwXQDZ326Z",
+	"wK02+uJVycRMz7tPSxxwXM8Nwacmta7BcrbecM2ZG1vSxEzWJIKA/pBsCIRrYer+Vn9JnbktOpwRlz6l",
+	"mirCQaxcUqVwfB1nF9h6JJjzTkkRJn6DQRY2jihNJyUjBdWUoDBnkNa0xqQLWPzC1nWYv4/jiWIlfexN",
+	"shBEixKLzVUd4lIOXLkiDx3nKUqm7xzV/BpUD0hkwScHb0lDqofQFdQuCK29guEWosFXChadnu0nJsjy",
+	"3LzMDXmrPZbsEh23J4KGi6NxRENaBAuzinrlW/WbIVBBOjOQLhOZQ63hK0YLgmejp8qTzI85nU5ZbjwQ",
+	"AR3HJ2NzPkIWB4x31TmoQ+m3EByMRqhu0VxnEPvWyyME8Mr6ABKWL/O8du+qRkpBE1ZVA2tIJB1DEw1i",
+	"1GubSKd8AoJLI+iKGEqtD2JcrhZUXSfwDXExZMI1/P55DrePak5TLtUb5pz4aPU6wCrF4tqpKkHC3B39",
+	"t43UhgS/jJGbuTQds8jwKj1zRUVavlbiAsA7btRzlkuR85IFwdzx1Zb0Ml14J5bDhrkfIm/T3RxHC/rx",
+	"DD/CugQbfTLO2bZ1aWpVJla24EqZbdnkh2quKg5T5KIpU7DMPYyC26xt+GGcafZQ3xHurwFzY9/cAjK/",
+	"BZFDAn4EAcz+3DAP+LWpTfFTF6+OXpiCXu19hzTVTZo6vNAMHoPqYOmrGd6/Ap2pPezPFc3dqeweOGsG",
+	"7zaiVX/64WkiOHXmFtiow4H2E3dZVBjf3xEU59fV/0JTmpabTR3mhR0QuDWWq+9QDUKD9waZj+HCnXcL",
+	"iDcu1EzMY2E5Pa3rwm3VTy5eHZ2zpZVrmka5xbLkVORsFxKhC2m9xd6H3wryhnKCkHIXLMeXXwTgVSN8",
+	"uHJlKpJBcV3Uttu5Se80gHM1WW9IjK4VpqaICR83FSU0FqbIFJeeQrfb3iWr3PGr/V6euHZwe3lmkxb3",
+	"djgt9zsg6a8xjS2h+MBzF5eKOI/pD+IFrKzd07eGk3Ub8nab9D5eNLvsFkgBFXoi6eIRXVyhV3jnhaY6",
+	"Ed/gUsmvOj20b9pp54EPL+GXqE04HVk33Rp4m8maU9HKLwhPSORr2eGUNJw/ibMi2C1Tuqf3DV+us3oc",
+	"pZBlxXKujEG9B9vrp6waL1tvwPDlLwOYlstuOnobEI1PBgM3YiIkzHxqbebtYmo2i0cEgQG+lC+WP11w",
+	"XVvZ6liIeiiX3bEbzYSergTB3CcKPJHS0JEhnj4j8HaIQXhgkpSdA9ug3Bc1w8Exk0n5VCYoVyqnEEuK",
+	"b+yIIFCwO/DTEcoeJKUEmmaLLcXEFSanaKrNy3l/VghQmgW5ENQ+me7GZVix5rPAYREF4dv8e5zP7sWg",
+	"MxQ1QNvdHaj+TDVna9LUDluZTiHq5z4FeChxX2zaC1jormQ2+FTP/srWonF1DyYB3sPjhaSYDVaC/2vF",
+	"rHKvqxXz8HAx63LnOg+1cWj5iOR0dN0NLb1s7D8LK5LZ6oQlu6HoDDTEBbyxkYSUFGtSkHQLOX0g6vZH",
+	"9gU1GSEIPrtk5kjgjgR+3nb1cYblGFw4QGgk2JEt2R1Nmi88GLvsqf8s2NMe+5aabYd9S8zaz4+c3JsQ",
+	"47usHb/D0GEXRtBn8cn5dlh9at47L7/B1pJHu4M6ujC35Vhu2f1t+AnOUsttbjBi/f61hMEFYeC+SPHe",
+	"46PERcMqfeWMyNvO1d/ce+6Qb/2iPoNqhXBsM+GtPLj2i6trtu4TB41v/8LWZyetnXaTtwb168gamEgZ",
+	"dY8N2qAmBOtSqWYrruasuMJiJ7+3z8OOUYEhuMavlsgmTprH7oG6bOCR0JscmkH7bVxkdRCWHz6xvBbo",
+	"AdkH6Cch10jt1Jym4ke5UqvtkUvhNvcn3OirTvKzEHSsKjdg91rb84qzaWKBW/cavsZt7oeNJinu8P4S",
+	"3P6s6PZ3U9CgK7twXzSULjAZ/yNXWqVKmLiR8UPl8oWsxpd2jN+bqoFdtLYyGDhk0WZ/SH6nvT07acQF",
+	"0aeP6egJDRXyOfu4Z4/7JlI68z7mFJc4NkU1E5yMarqdjFh+fWJehIAQTXlCiDgqCm57ZXCBoDdDDAcp",
+	"uBzzbOg+FM0bc0ZLPceKoPFYqF5DlEJF6A3lJY1KaoRSCVWp2J1zeA6ECOOTKeVlM9x70OGQ0CvVo7yT",
+	"eatJWZZD2jEy3IGAml7iko/dkhN047YDk5cs2sMUJ6y6G1gSmVnmgtRv+/giDGdsoLk9J+THnbNS0qLL",
+	"v5nPqUiaM07qv3y+Hb4bZInZuKwhOV0s9ZrwOC8PlYaCY5AUft0RHlHHU//oCrRuj5Jo7JFbSkcKWgxV",
+	"BVhJYe0Fk3+5ePvG5qC1MTZjcsF0tZVL2XFeuNc/NePBtutH7Ty4zhjTo/KWrhUZ208aJRR/bmZqbQ4w",
+	"9UuMQA7watfmMsCiYAJZuYJYXCsSh6h1Ydn0cMLw5vbKmnPl/l1MuvrhpyfPvk8m/ua0Mh2bmCS5lFUB",
+	"UezOPcirdFntITmFAt8mVhgsJdGnilAyfie50GMPj9GSw6LgjRrV+lZiRS+DCTvCKy7YBezAOFiWECzH",
+	"zmBztkh627EgbFgqRK50a4FQNmPBtb1nG35tnK6/ZadxHFLm1T4EWe9wmjTr3/sSqV9Jgi7jQqRb3LLN",
+	"g5ow9XnkJgwmUgF3JlSR8W+lFDOuVwXLSEk1/OvDGDi2J5zMnBBXpHlpv1bunYA0ht3IPbX1+xwpwreG",
+	"ZGUVDWF/qQtqBHHn5tO+uA4xkEB3wC9a2MWbMXXxsDwVC+ZMhuHt1t/cjQJT2sF699vfX/sW6NDMvVos",
+	"aLUOIMaXsUWjB74DLcf17dsPO8e2NqqZpM5rCFFlWR8MXNwfbxuy3dpxcxYQEzToK8tD4l5OBZkwF2Vt",
+	"TgqkAWLvGlmWQKdqNcHygw58dceQuTBFrb1jLzdiq2OjTm9Y0gng5PO0IJyS0u4iDLvmlle7UfGuVL/j",
+	"buuKCsv7zI7LCXQvKx5q02pxu+FVWqlwahA9torCuIsvsTVAex/ZjWvY2uu0hDSxTSq1Q29ag0oe5A3r",
+	"cEn17YXMPbfdDn8LVPtxCCo2B3GANXTENnQyEUnnKzkm6pC5nwgX5JcJ149Uz+4nXgniiaIJWaOo6YJR",
+	"sMUavmMrTo5D5zqmgEQlJZtZdqNROuErShV9+HRjka/TqfkiB0vygpclt6boh8DckPwsK0wvbYV2cF8V",
+	"1EIWNRw7unBufdb40o/JegwZFFLdZYeeDJ/2KtSZTru4/DWc19VoSlMWTeA5KAbbrG28A5F1lHjdbGpJ",
+	"9LGq0wFSK8A4jDu2t+quZgGtlKfx57ZQqWqnXvtFDyYVxpFeja7290d7+33LjC4Zq6561VI5O3HrMN8Y",
+	"NgCbzEX07OiCjHFMbBXSWCv01DC/j9slWJJlgoKxeh/4tKh/Lst4/0RMYbaGtz1HrCM93fqGkTaClO5g",
+	"qLo5i+3CwtwftltDnH7l4GlQPhaXDmu4wliIO64CgopgpPHuAJi8cdTwHRh4vYEWwxpmtuy3XZf5wf3D",
+	"DDX4EGxj/dOWenp1JkZYpNUFplM9dwg16Endkp42L7x0lqqKo64giWkuV9W9ah0E5joXL2njKn1BTTPH",
+	"LhGC1mPVfeS2V+aEtdXlfzbJmt6y1L1EX5/RhaEGjNcHzEWllJMlIfpF0xkKverI9F4vWXyXqE2Na+Nu",
+	"0YbZpebrd2n1nqjzorFFqq/uVcchJJH2mCHycGFZgtpD31uixJvv2z/xXnZqsgHRYdl93FS32BzHvvbS",
+	"AZqneJse0KgB14LSNQnqzLCwSXeDw8H//f598d973/1G96ajvZ8+/L6fPfl0+P3vB5/iR9//P+/fF//9",
+	"n4H7ySZ7bPY5vZKzV+yGlW0sle5xQx6VmBGNP9fMF3KlgVFOpXkMfTAilmt/2cxwcdgUzhykbwES1Rtg",
+	"Z0QpQ8CHg+2QZTii2oIDb34GoweU7gQzbihkQYCtlZjRUHzDqolsdBJsIdG0dwlx2UyA7OkqcVtllxOW",
+	"7AkXYjvpJ62mb9hHDd0dU0UdzHHsSL4HuyMwqJZBwbcWDJppsMp2kWp1tDnYG+3vjR5fjn46fPrT4ePH",
+	"/+jNwKm6yuNAkR2CDTZVOUZ8hOVH+GIpbawc+nsN77o8P46yQqNlPYZlPbnDsnSV9wgluTw/ToTfBDvW",
+	"qBDcQJafJmbSupIlNEfyuwZHYMJyuWAK+TOLC3SliKorxBNwd1XyKUvXo3llf3GUA57/ou3dB+/PfLWg",
+	"AlLRoWaDQW68Cz8cdJajiQHpjpLbCaBU7srB058OeqSvNBDTCWCKfb6r5KRki1TZ0I5ogSbqWF1lg6gl",
+	"y83SfHN/mWPQW62ELHFCL93PWbmcrkrzhVEJNIveMifFpJ4TWoANSgoyl7e2FFPOjJD394przYTB4amY",
+	"lVzN4atwawkTMy4Yq1RGVgq7DUNB5RWkIpg3hBREs3zuWtnRazaHgmnKF/gAjYX/u5nfdGx9dhKaaRjn",
+	"/IQqrK9cELnSKQriQul0ht8R+fX8DJtBMuHkdl+DAFUnj+VO7GKzMsNwbGVKSqYVtcWNglaXYG/fg1IT",
+	"WoYDYI0i8poahyZG+sYbVEmpcVKu/Ee+S/SqyhnJZdFQ9B/ZFx/lHmd7cIv9B/Qk3TP3257ZOGBvxR5i",
+	"zzO+VcX3PGY2R3e0a728vLx859wyBjIyY4JVYUkzmzilsGMXWsg2kXAcxjp6nNU9Ip/+9FPYIbKjThRy",
+	"zjYFQBtPomqnUntjvjbRO5vwr2Kjz6JWkFyPUDqRK304Kam4HmR9aB+zAcp1TbeqhQ8syGKpD8q6ftQB",
+	"3m54wQpy9O5sSN4u8SrWMjpJ9p4W5Pzn470ffhz94MySwjZJq8wdtmCi8LUgCuYABYQbfC1BqtGSUOSR",
+	"e347CpmvFt4TLWRFZqWcwJbg+rxBNtrmfodnhyPS5dJEUkzdD67nXNtbEolA/YQTiAPp7V+RySTZe/Z5",
+	"6AfoklaKXdk2iukECbMVCuoArwRWy7mdc7PVzFYG7m0UbjSWs5W3DY8qoJt8yTQr1x0xU77z+T75LtQV",
+	"vz/0NRF8xbs+JWci59/n7jQB9JDsZeTwtC0S0+51R5wtE8Wu9tddyaujrNoreN7c9Ebz4faV0KxIdAfb",
+	"SzHImuViAjR4iFtBsHfGfSsOdvLkafHkSbE1DtaXhtloibBvqefrS3uZNONyMD5vl8ojSC4J6jfZKQ82",
+	"2Gr5QEO1WkvZfEebCrn5BClXDAHEHLS5JbYSG0DUHUkbHmW53Jjs2uZziWijpI9k58r5n6crZJ0m023L",
+	"xXdQKvEdMzrWOvh1aeE+j3NSd41xbnZosfMal48r+Dmuc8Ph7hBSY+sX/0YYEemGVGYRmphCofECTf9+",
+	"I4AypZsdbbirM2KeuZfa01h3T8GhoOC4dnyaz5pve0UQjGH2m6BjrJslQLLz67iRBtnAvWaOBA6RbC5z",
+	"f/7q85DsvmVJjpsq79y87PCs1ZRklLXAmt1dUdXK3l2OozzRe/IIhXQeNgI9PspcjK6X4W23di5m5l9y",
+	"ucTuKGRVi/nN/pIKoSGFZNaznWtwkJPjo/hIbNQUcnrFhPmx2FKz0k5HoUW9ncb0DxdSZ3ZdhIkCZHFl",
+	"m2PbRgtW+3s62k+nnuwWN2MLLVY79G/G9033xsb22DqG8HvDbYUPzQFpFCgYWnNf//mt1a81va3QbiyS",
+	"kbf/7OKkAYx5xTABTwxd4UPRhsZWQiVLjh5Iux91Ix8wINodTsYWddqav2Vj7sGdjbmCfdRXu1JZYJNv",
+	"b/VFt13WTJaIeDBbQ0P6BJXF/dvWESipdjZ6s50OFU1a2dE8bV8XV7PKOBOXrOIy1azv/BgtVFQRXa2U",
+	"RuMUB7MqfErw07pnfllTfE6FkPq9mLDEIMP3YntzhF6W8vRattnPg1i77uPQdREAXEwly11+Xbp2aPg8",
+	"e0nSW5lk+fJ6y3XjuS8ytrVrIBSbhxDVRUbyUipGtAwwm4G9h670nAkNVGHvemCm8ap6tOKQ14Ms3NoA",
+	"m9uoqTb3pAnp0iCrTUf3y1LWVd7f5hPAcXl+vL3VWjNLHCYL0HB5fqyMT5VP184kkycwswUlBpQ75PB6",
+	"LraZ3FO07WlsThWZMCbCZNrJukn3kxU6mpXmZdmf/FOmg4iYWjgJCj/H2DCmY9GzsK4vDG0UGvhwlyJW",
+	"1yxxTb9dUmNEhV/BOUSVwgwDO9e4rhoPhW55s5bLi7P84uWL59dHR0fbo9QBiKxedKiAu8X5l1pItK3E",
+	"TsF22+ba7nEj2cc8Jgum4oo9HRD60IDU7PaycGqUwRUaZgo2q2gBljmTUGvLrdY4qt9spFHEglxbgAus",
+	"OXV2euM43b9vTHK5ITeKzFQ//kSe/0Se/ESOD8jBz+Z/fzomJydkdEIOjsjTH8jRT+TklPx4Cj89JT8/",
+	"JqOfyP6InOyH1KqWNGfFXmzgaq46yUDMjSArrrGLKlW7hB3F0aK1yQmqYD3MUBH5/X6Xfsue/z1MSr8f",
+	"JVxmlkJjDHx8HWwzal6eH9+5aEM6qiIOk4DBST9AvnIhkzvc9dZCW5+yis1WJa32bqTuOBv3Jg5r00wW",
+	"M+moYRJvCUiO/YuWxBuDyXuJ092DWBp66GTXTxqIoAMzxoetIKsTPp0mm6mmjC/hh0FH/sDhaktWXp4f",
+	"9840bC++xckwG28LPHGKjxkD1IKIFoiA3wzkBZ9OWeWLVpkPjYR4R7Dt1ieAd8UL7oDMKa9QqHswXDap",
+	"pMAbvi6w4FDdVcOHT60/WdWYuwVbkOo4Hx0E1vvCmPR+Mzi3OyIKT8GnbPCvlaxWix4f/xVerHe9L+e6",
+	"PD92zMt9nDy5jdUE23Gy+xacnbQ3YEIVu7J5UFs7SXFV9EhnU6zitEwN+nh7D0RlqC8Eqjleg0mnHIXR",
+	"oqMdStPf5oSEyY5L2MhyG5u++3kI67hN7nw/boDRZgZsrUbT/PBvAeXHaxIy6Nf7UFZQaZJEptbPGw26",
+	"f8dBGygKZsiCJQTk51ZsNfQU/f2NVYqb0tVTmTh6K14WHX0Uw6ZJJsqI25ZJXJjwL6Mkm681+MT6a8oz",
+	"rq9wtEQ1Fa57zVTj+qfiWfFk9OTZweMfGX36dPLsh+loVDx5PKUHPzx+9uPj0cGzZ6Of8mdJSOTVDeKm",
+	"DYlFmlv+C0mqlTBLiqefyf3hwZNhssdE37FxlY3s+9Fw/2A42kogbo5oMaFUb7Z3s7X20ycbv992zr07",
+	"85Z29N8765319GG4n6+cpsh3795eXGbk3a/mP0eXxy9B6jk5fXV6efo9WIKw6g0VZHxWsMVSQmLt3i9s",
+	"PSZzRk2nLHLOvMOeuqEbAtU1W7s0MWqjErFCvm12FIRN0tL62hTLyIJW1667uXmlBkLvnbNlSdescIBk",
+	"hAulGS0MIOwjy1eu/I0His4oF0PABqsI2DaU76xT2fGGg7b10+LPhP4NAkIZjIaj4T6Yf5dM0CUfHA4e",
+	"D0fDA0ywmcOJdR3hcb9KplmqPJZ5DgFcuHFR5SFsUmUWgs2zsK2awnLo9g9orZhMUp/aAjEOGb468aUk",
+	"sxWtCkSL0gSgc6/dzqVvEVEHIxt7c55DBGVWVyWSwsFBFitwsRPFNMxQ1CurC8AzTca0LLFFva88RMXa",
+	"JnziWGYjDOuDc3BWeDQ998V3lrSiC2aWD86shmdiQ/sy7QAbkr/bNmQ1IajVcgkF1je2qOFmDtfqCrXm",
+	"pvMeb9TepqiWLdJI8xZ/rZ5Krga2OVFlWVcE9w1rzJY3sn/6FED/kF6Zr9/eb01R1e/E0tqhNbxoZnmm",
+	"wEiFQ9QQ+ajpZ0+fPn4axE0nMx92QjdWV6E6OIU+NrHlz9rf29/fO3h6uX9weDA6fDoaPj34RwfF+D5y",
+	"4Tr6CR4beIg/4uf26rFe9/B0Ea4wmQy6XplTaz1euVxMuHBcN/wE9dvEKmhZRgvwUdpTWiqW8Bd8yAaO",
+	"yQNfPBiNBhCCJ7SNEYZagBhO/eifNq5pF9oDbBjEwH3Z2evEvBU2kPuUDZ6MRl1TeJgfPTc1EOFSMZ88",
+	"7fMJpHgKWpq9G9iY/HrbIIvP8HnDf6M7APwDM8PhbHLr4IORhZjuqHZV3/4tKr4W8la4kPVmmATcJhWU",
+	"OFQu2zBo4Bl0czy6yFIlPVx6rgniM0SVaAE2JM/XxFJHBqS6Ehu7vGKz3Amb0xsuKweWNTQEcgEtS1tn",
+	"wJ2oMalvh7jKHca0BZGGPpgtyHm2vCQsf2cTI/BuIFyQsYvrHptrRM/J+CjP2VIfkpB6P+6JwlDwOGt1",
+	"l1K6YnSBHjbBbksumCFJ29jEFEPDyCr8BjqAmHeG5ExgSklcmS6zadqaltjzw7u2HaAWuXYJUhBKpiBy",
+	"wdR2qwz7IePf32PzjisY6f3gkBxk5D1GkuSrSsnKPHs/GA6H7wfmFzeHefzbcDj88GmcWWcdVx6HPsIQ",
+	"ypf4SRdIfiHsXBFaKhlhHPb6/9q7NK/tQSsNL4a2hIUXTHdKCqmTWr/yKGj9DFKEs2rf5cMdP3N35k4f",
+	"2evUJ5Hv+PnPlVy8sYd3x09BnT3Su04IKZtvr3f87Kgsd/ziFHmLudp3/NIUrN11x2W1KyIgNqAwvGPH",
+	"D19JuVR3WBZ0Mudi96+oKHbGIDQo3+2j11y81qtdseiiCXb7zFQ92vlUQ7j8jntl+PmO37ydThXb9aNj",
+	"YMuDHaWrfIJhArV0lawfs2PznnSrNX93JMsoKFlFMRf4KkRclE6yMWOQJTS9atbsGcPFObaCLcq15qZ3",
+	"Ny6IV8N0MIi/6DYlJ3j5zI2Ipj6Ay4CEsbW6E7KMjCXs6xgqq7rlIbwdfRQ7M8eeh8IdhOu7K9aHvAPS",
+	"JiynKwVAruH6X9DS3PCswNzeLH6DfcyZzUZwIpBIZGek2mVvqBbRNGFlEQXOmPzv3WT8znrPzaHbw34u",
+	"4maWs/YaoObFmO/+5+n483R0nQ6nP+yqAac0X1cOOa1pGrBRmgbAIzn7M+y8FeeTulKz5ratCNiXVGo8",
+	"tew+n+6i4kcae1rLTqnpnzJv/X1EZ2xvzpWWs4ousPJ/qrknqD5m8I4ukqAEGpxOVvk100bDYhbVVtum",
+	"QZKVeQyFQxQXOSNcp2qb2aHAIABFRFql70EJNun8tarsigiTienHRcFHAAYyyL6iyrxv/h/Xihijg3tt",
+	"g552NGMvPYK2GncrnmMDrLxiFNKTV0uDGztRmEEIy1N4oMl4f5E9XWT75v/mtnr8spQF89aqlIXLjpG2",
+	"cv022DcAPzX/2cf/zncpp5QNlF5jyqusFoMvYB2LUJ3gE/gWUJSn2bsZx6KTg53KPbEWXGED7tC51vM0",
+	"Cbmgpe+hsckWBq0Ei9r7wEVernxivbM+ScFUhtR+w2VJNcPCtTe04lS4IhC8qs2+WNqiDs8fkgvTmKFu",
+	"2Vdg2As1sfz5NYFCGjalf7KaOWMyer3afRj8Cr1fwlxYrNh0gNwng3sS0A6iEM6Z6LrYRVSuZaiDta5m",
+	"hjZGXkHFx3taVaP7322o382t5JXLG1Z1khYmORNKwMFASxCouhv/YsMOgAQ7ewQJxcJXpQw/iWtLmtvT",
+	"wMMKLH6Mg8CggfXVl4Ez3wEQlsMH10Gd9HsZWzv9OCryxkUwRaZDqshKOKi6KfLYvDH47OwMp9lAcgCp",
+	"O/L1Yu9NZq9jApg0pzMW7qjj8UaqmzNa6QmjupPykIFmIAT49uy493mzLTjXKiQIv42igMpXprcIoTNp",
+	"RWSqhuQ0YYk3/+B4Pqkit6wss4CeEQZ7zCDP138Oy6+Y41rkrX31di4VSwHGVVPG0POKqTkIEhUj05LO",
+	"ZgiG4iWUPgHngPHGKejItFjSXBOD+xvObg0O8HAtaaVV3cJd38rqGobEdMRyXYO8gZZf+t3ZIpsc1V6P",
+	"xDInbA15xHjsXZtePOQe1bhAL7E8XYy7XG/4po0zSkonViRp+g8/v5BRI6xbwvAk7zAm6/7xDytw2Cwn",
+	"uwtyatmon2/b4awLOyRP5gumA/kl6NXqqhIkerZaAkaBJKxsfkPLRv0LM6Cm6tq3xSfLe7dW7qDzd3Vh",
+	"gs9KHnUP7gR52ET2JjYfgCS6Nmrb/lcslyLnWGhtKVVKeYMWgniy7e5hqICrg3N2gky1oQKHGwN7aZlD",
+	"xVzpJUspEPShiAOlEdEDc97KpmPXxgmZuV1wjisGFQKWRQqcaVIBn3iPK8tNJJa5eGw0VZuEzh2Kar+b",
+	"ffe5LNYPTD5+snqbW1R0EeDdbgj7uLRVMmqbRx2gZ5vEf2bKD0CHNooJyN9ZCgEzHdJAKkihAyhb4G1H",
+	"o6qr4JkA50ygKOi33oCw//hLgnAZxPFNZOFsccpmV/+bEbAN3Vuk85sTHS2rs9Tko5jexjGctN0tza3i",
+	"889cJ0I53YHFG3kntBh1ldHxRuK6UIyPhpthorqPp8CaNIRRr9A2igY9b5qHagXVdomz1x+tPGC2bKRb",
+	"lVG6K9/vnRVkJQpXUcJx+8QlFVeY+jKqbjxnH133ooHjLCyeFdxlO1Kq+WD/S5+6VmEeGyCJNkB2G19D",
+	"NRkPGyfrHb6efHfrWSrpFqXIXprTikZnqEsn54pMjXbhUuQNeScaWuD9jbHKWXA3+2m4IkrT0oZPhZpT",
+	"sOFo1vCipqF7/xSipMAKG/T7XKxKzZelj+kE54s5qLcQqQrn0qp48AZOpjZIdRcl3aa3/B1W6Vbf6JYB",
+	"ogPEGE8YEx5VWMI00FwsUrcrLojTnVSWVsjjkUb7IcQl13UBErvQBQX8fDVZd8CBWxaUCvMPAO2DD19D",
+	"r7p4dYQkv0Gvgm0QTClrsnlYXaoefTerrdJUq3v6PgDxaAFpxSDCAW3YRvB4m1HjUHDMj3RVT7MguC9t",
+	"TjEPjX1C1VF1gt0GBeVia8S/Vjy/hjLKrjfMxPxRl/L05r3a9gAsxOCIK81zZ39ZLFeOKRm7nGk03RD8",
+	"Q4uMd2Tmc0aXhAnsqQbHVOUVXaIQz6WxTpflJn/MBezWFqbxuuUGDTT4WjywooEJmtRyeYXvmLjJsylZ",
+	"CYhDRp/sguvQMOnSWdwdxFGlDQIL90fm8NsjG1fSTh95DX2UNngIv8AJBsx2n96aBh7g3GJPWSMgbzha",
+	"204uvEz1BuX3b/YNmAVQHgTgRn5Jl4ziKDrO1YFP8SgE39MqSG7pTmpBHbayZpRlxSzRsY8UvIV+iFu6",
+	"BtrzxwUWiEQoTPg9lCq36+AqCEh+ayTlW66syT1IInLG8faRcrixMutfLUH+GZT6Z1Dqn0Gpfwal/hmU",
+	"+iC3ajuoLpH6U/P2oIjXQ9iV/f1Ho5G3Xay/WxPsHi8+9cgQbYYd1SabpqXZXW/kbLr3GhJIMbQLMnoh",
+	"rECQ00s6y8LC8qa/KWh6mHLqrs0gB8XJvoHTrs6bK7BCs7ID0/redD6IF6eXzoqX4SRMeUhDIPAnooyX",
+	"hga5oyg6425AUTtbMuzxaOStXMqq1vV49RwWbm/LYB8hJK7yYNc/AQzdMz7ZP9icobpNdL6MlxypUnV7",
+	"Bws3F8uVNlLKCoRgLFNtfaU0GMYVlAz9tmRZsSn/iMJRWbbj9Sg0ioAddD4pkLNNOyUjafnEYPfBhCrf",
+	"9JJXvvXd2UkgmBuo9w/IZK2ZA8AukeZ6RcsAaKyOmozDMmnUgbfTH5aW2b5vampUBd7FXSkOKkOCST3p",
+	"ii31JK9Wec6Umq5Am/qUDR4/fHyDs+iqtK0xOiNBunhAXfUOGE+5cDU67NZKcTcOaLwAB1/aHtl5hF1a",
+	"IAY7GgTAgTamPeyYNnzAZM2QC++Qouncj8FT3BTfiysc2CdlIvfxrJvcMnqNq8PmkLByE1zBBHP+gJpF",
+	"+1jPM189H7QgsNSXZSPYttl6wbEgmI4riECv0/HOpntvpGDxHdO4JQqOPaAQzE1M/Al8KiS4eJpZlZp9",
+	"1I9uRDFUuaFYS93j+AYDZU0g57MgNnoomWEI5ljXraFLJdFZzoVhZ65Aptqc2bmspJaT1TQFg734KOK7",
+	"orfEve0G32B7+Xbujjn7iHGLrLBMxPH4BV0TJgBjc1qCgr/WDGbl2t/gugVpW6oA7NmU1ppqa507HvHB",
+	"ZIJv485JFEeAeBBPNg4jIl6oyQGp6O04tP6Z3TR0U9d4IVqScVLafDQp5WSMebiaCMYKMG8YaQ6InCmX",
+	"KW5SbQCRx8/fnjeZRZdl3Ua1XJlZdisOsBEhvml7EOAdpHK7Ey+XDS6GLInObNjPXC5RdOZakbEBYfwZ",
+	"1m6AVZ+zMkI7d2+7MPGCade6+i+qV8rU/cesOWU8sq90gTWVkuWT0lw/ruB1dnJInk7yfJ9Nf5z8OGEH",
+	"+T79gU5+mOZ0n1y6iO1D4it97V+Ofjw01VxG/z0yoS4v5VIdkrDEC9l/vxqNHrOD8Ol+ynLsYW1LZqGm",
+	"FhR1aiTamIs1UahZaK7XQLMxJQevDTfD820Lo1ZYfpJus5kUHbaIdg8TGRbtWqOGa19VHpjr1mDBaCbz",
+	"heFf705f+0SBnaSP9s25Sfhw1bI2CCHPkXU3BJFvUk3bROQf95ZssTe1oXs109gz//P89MXZG1P77CW5",
+	"OH3x+vTNJTx+L2BvEA+mxIWAx6dvTlLvDh6M3W1mIUBUd9TTRs++pJ72pi7tQSgQLyvIghWcQnFdMDAE",
+	"dbZ6HEQrR/Q/gfbfPTKE4i53XNQP6ybMPo80CFjyqaROUTpyo9gEjq43nZksEFywso635rUFGFQFIYiL",
+	"K8IWS712jZ16zbnJ0esw9cc/63cO8NrYNrErsHQzvr98LakeYHUdobw7vuoE/pow1xSt0Z2BHJccJsWM",
+	"ECNDC4ahQabelLFJbGy6BiHGK8XIuO70NfaToCa36a463hrYdMImKzRQUo7apA84SJhWxlgecu+SL7iY",
+	"ufpCuDoTw3GtSCFvbYYK5NYumdCuC7hzSB8f+QhIyBLUEDAmZkEkSpf2UBhwvx2t4fjonirC8VHyCHmz",
+	"KXnrtjQWi6N9SFa7BZO02RLYEB9YgzHKrToD9gdXN2wMeMYalLEcHTQqgS38n2JV/e/+8GD0JCOcwl+j",
+	"4Wj/IHV/3/XQf7WoTqv/BzE3VEHHxZi1nNXaC4HW65bKhwFDyZfLa+75yaOKCXb7yAaJbsiaqJgLGwmb",
+	"J2DvMBfTZM7TrVyVBYr7Pi4IvV7hd4rPIKujVYi2jnyxXej8EbXpkDChRYdNGjZnF4vqaxWZbEPzVIsp",
+	"2UDXY3puMEDL3nkQPSTV49Pzy7Ofz46PLk/J+elffz29cEJoUJLeUhaJBdfuT3fTar2CwopNmP+ymRXH",
+	"c4ggaEN7EhvaN5AZ0teEJTTKL51nsRGtf4jciy8JWns/c9hKV9AUGEwx/GMw2jBKvr0wJE2bYlEhewkP",
+	"XJIVN1pbbFaGWmyyBURHQdH3YkNF0VRBUSsFkZ9XlZ6zaiErlr0XUkBrZ1vnCHJ3eW5a/xDoxAvqWasT",
+	"XADje2GBDMr2KnSBQJQ9ykwOnmUlb3iB0ioydOMQey9CnGVGnKZVUdYBz7yyQbTYpJTbxpbvResuMAJq",
+	"iP+WqJq0H9+5gvSD1xjuU+63vwU/pB/Qpl0Qqq8XVAtp0XZnhJmLntuITUhEcKU7wCboiM0avgIasDXk",
+	"qQpbH0LrWcFuWYWv1oHGdAEOBfBzVroWKpUNL95k768nuKfHA2uXg+YgCvQPA9G7RBTn5bENQE0LBmoF",
+	"XrtyTLM/uoiOL2JMhQkubsSJ1HMzmU9HNo1RG5V4Xf3fsFo0JJ7DSEwUfavpABxczK5sD5pBllLY+7Wn",
+	"WNCPZ/jFAVT8rv/4vEV2etkVQCrpbVVw1bnaHPfrp3x21cBKwLr9Fnr0O7zqYt422shbE9jLDyV/QPDZ",
+	"yXbO28F4Y1uWg+rOliwLzmdOIuiUdY+buPrm6KZzV3ejmn7ulTbpOLWFKnCzmHgFhY6XNFGlo28wcBJz",
+	"s+kaREyaz61YJgUjCy5WGAnxXuwWOdMOZngvOoJjtpJ82n3zLZH9bvquVVaPLkIy71Rx7dsbhzo+2mWo",
+	"QY8D59wUgRnr2NDGnu0kkzB/UIzFLHjFUOB2Zv9STjb7d7PenuM8CWcf73GXd9acmbv4Zr9xNtR0M0W8",
+	"CDS3gOu0Dx++sfUMQEiDLxe7S0BBymh6b8f3u4qj/Z6Ry7evX3kVFYY3KhuLVEu5WNRuA3j1UcVMWmK3",
+	"je+cQfid7U+AzeJgYIxJXi7BxOYzwioGgqYzl3t98syVcLptwAhVdGwKF7fxd//EBHwXitjWa6MRlKZr",
+	"MwhZljRnqdomZoV9N/gedzvMgLN1lwcJy7ch/Oj8M1+583bwxQNzN+0L5s5TtIEAJF/fItMqaIAIDBr8",
+	"10sZtrKhzavWV6v38EsIL2181nFw5tDwvFOEcfVfYHx4teF2IxQKuHnrNiZRssK9DQV70gUBsNf6NqfZ",
+	"Kylme0tZlqSwa3FZ/Y9Hatz0oqFtkCsyZ2VB5JIJshKal6ETD9TYEDwftmx1bzcRYSVdKqZsoCkENOdy",
+	"wRQmbaMq6l/22qgtzvzUil+NvvyPR6qrEgHlevM9m2wV5eupRBjHHQmiH2xeDqDA1WKSECNV2qdEaQ5B",
+	"2NOSgV+0LNu7GLTxci31gz77H/rp3ThfWtve6B7H77YWrk0jKsiqj3a/kbpkYEyix3Hwl5eX79wzI8bb",
+	"tJWK39Rubqrt4OZ4mLIahg7RSmnG9/2NoBHhhBah7bSmleMjmNRY3oH0hOIKGh92UBBMeQcSYiYm2zm/",
+	"YjKKqAere7iK5GOEy/Y70lU+TqKNqyZymJ+wcNNYNF24UBw7FaDWzZPhJJlx0Jv/2mgbO3+ooY0jhMNQ",
+	"/TD+2wDnGmQDXeV9yRnXkCbnb6jkMvJbFx9bx7QmBMCaBN/+0is6zF4GlnbCENM4LMNx6HE989iVoEAP",
+	"p25RUEYYh8iN8dtfsND6yemL86OT05Px3YNbHvcUhWtM/Hx09urszYs+6Gi4WyyjtIZRbxvWkuTbcJPV",
+	"3AkcXmMLxbjtF7dlnsO7GbcjvPvxSXT3P7K3X6cMcJrgDk0hoC5a4jexcR2BHdhxTZsgVRtt61aGGaF5",
+	"LiuUfqQrUSIrYy6xn+uKCsUx8h1xim9pCv0Cg58bpWMzohgjY7dwKClerVGIEFKD/mthy+reDHLqFuEC",
+	"3jaIM8cWmVukmvBCsoMHCVQ1ZrgCpAzJO6pQMxr7wt/juj6brYHv7TVaEhCZjCFIrSbKUJZwo6tEo8g4",
+	"Fv0fnUVeRc42Wmfu1jKyn4znrE6hzFZsFfzoH0vG+/wXgKPQBN96GR3ZkCAfIKz9VVpOjcgeTTc09rlt",
+	"41/2GG+ryxZoACGHkNO2oJu5CkuWBo6CL1CptwwpynNMs0auAmnHiZChJoLkWfDplFWqFpHcoS7X9XT4",
+	"mUsQwhYTrAhqHjVrPYcL5cqzyA3866VF5mcnQzfRFjJM8fwuwkrvbovkOunJdTPvMqtBJ/w/mlHtOVU8",
+	"DyUybIVTx841oiXIspIGjE6LQVBHfmsQR/1uHasRlfuPLXHtAqDNXq5xxWSwoBkTsxTxdMi2/c8pFcQl",
+	"Bvn6Z+kxghUY4BVqJ5OwecLZSfI8nYX19j/bYapnCQT7tn8g1WLhj1v4E+K5Q8rpZAmNhhI2ZqZNQV3h",
+	"6CW/Yf/efrPQ9FGChG5+44NCUEiBQg2sgMBz7H6gbCcvQWcMUiiO3p2Zj804JuThjWxcmoYU/a3SKLtH",
+	"mCjs/alS1ffMFsFBQKGwXA/Jrybq/ZGxjK//jRqWvVuhq5u1kiTC57kinuxpmTwFr4xgz5QafEnFdptC",
+	"hpvSpUF1rNR+1HF3lHL2qGQ3rNx0gbySs1fwzmdEhp/ji90wxonlKq+UdnmtmyMbLFcJpFw0kPLwVdo3",
+	"4eOVhTqc/8uECX/5Xbros0uWkpuEvCEPxxX7joZOXOTw3En7GPOomI2KH7/79ZLUJ6hRSQBtJPCRNDIy",
+	"oWFp56z7lL0FgNWXOGxuqh1281u4RwOLeLR/bRE7/NULYnZPebSjWnZKjnjBbK+fXbPfxIUKg5hryTzz",
+	"l6p1aLj3wAg1YajI4xcSNcvL82MfY1nKnJYm/BfYuwmfNY6zutWmcwNzDWYHhGVvWVJhLmvNKk5Lt3TT",
+	"aGnKO1Src2a8ed/aLQh4GabNoV8QDCREBGWnC9l+1HEh90+7xfTeOPk2GVmeCihXqYhy7UNUXehsXe+5",
+	"Looj1JLlTkwt+A0vgmpkykaCQW/cgmnKS1YQE5Hf0Y2gK3U2FdBbV6B20ETmq7D8xKDTg1fpq7vHhzco",
+	"glULLmhJNgB14IA66ASKieLBQHoBTqNgw1RdKt9o8t4oaeramAfjRsgchwA97KVbX4OrZUZy114HsgWD",
+	"fG/ILUWj0LSk2Mdip4Lxrjq8gef+NeHb6ZlSsLdTjN67fzZz1utj9Xx9aT779GF78udXBq9vl+OI0wy/",
+	"3ZDqBLQBs7WPGtz27nVEw3k2VBPtqHRpt+Nu5crCqT9vocv4kuld7jL+7P/oopcJYrEo/BYO0hdPO3SW",
+	"OYJJ6uS0qmS1rVhkiL3kib5nzcj4PH2+uoUbyzF0coQ/XC2R3cokuHXfr1ZC1ygPXlEoOslRabJvNVY7",
+	"yYFSxbl63JC7lOeKZuxMIPna5+3PWl3vqJ5bSMjXr9gVUU2jbtdXvCb/uGXA2gjtPPEYSrjBH3Dhgg03",
+	"R0BzpUGMbPbMwQlU4JSEhFYbQAMHXEphi6/CT7bdVBYqmz5gq6RKE5cSS6RgXbpnM+/6s5cFqhW7jccf",
+	"sQnZ4r30P/d+Q/PbSdP8QgAljWjxhiE1ZERWG4mFT8mYliUU3FVMDx8sbYcnK/McXZAwOQ1KW2sJ0IQW",
+	"XmdVrQtSpzKfcA13Tbo0nzUuzo7kRdyIY5sP+mci4cPVNN0p0c1ud66q7l59WLSJkne/HF+Q/9gfbazB",
+	"9N3xxfn3dYmFFRrnfCfz1aTkOblm63bTTpur5c5Yg4rAHnx8cQ5nyrxS1BaMZcVvDCzBsDiK+XhZScOB",
+	"p2QplWJKcSn+p/UV14qVUzM2xpqxj0upWNHsxS3qDqiNcgt6XoH/H/oGW30RK2Z1Ub6qPifdP2DBqM0U",
+	"nCpZ9IUV9DfSbXfUez/wNllqdDTaUTKodZroRkr3NO7TGbrPl83G2cEtV68IXS7rjKyUIz6oXKLnFVNz",
+	"WWKUS/ANBpPEYXkuYKU2glFS8tlc3zLzX0JLIFo4gdYT4z0iDpS4qHUHXV+4vKPP5oSL5kkJ5QiuDeT8",
+	"BulxuLFrYx232r67cdgtV7euVkp3ktoJ0+AJYtaQ2cWGL8+PfX1cGLEukAt+2HXHXRPx3yE5WYHghE5h",
+	"7E2CYjO+bWsqOM+uc+yZl127Di7IrKI5s1WYNpDeJSz8s1MeTpOSFw3KEDn+oNoN+0aZopDBdrtdUG3I",
+	"v3gYRYPirAu78wR5fwpsAVBOSKQ7n6EeHm37YhBz63Fsy8OyIrMFlmrVodFFf8203QYTpMgq15oUk/8K",
+	"d3aMhFLJspQ3CHgH/W/1TPse2qpuou1c7HXkbYbVeqHQ1F5dfcrl6YynnJXFb4e2iIUUH0wE4zUTakhe",
+	"Uc0qAi8orNZKtG3ewWhVcgY1U1SYhGhfhiTEWrW2CYdC6issGGbzHv2khiRcyhpV+Zh8ZzXk7yG20qx7",
+	"HDu367EOqcq7fO6y0mm9e1ADd2hG75Nz026Y7KnAlaCLYNwfpaGCiosRWAsuzMiDw1HWbmrcguNNan51",
+	"zZdhNTZsku1tlPUx2QqexF6RSbSNss2wfpmS3x1Kf5fs8FU9xs1+yl+r0qYjlUhY9qwtyYEhn7TJ5zzT",
+	"9jaJpD1CdXJk11y1iyVf0mtGKPIgnHbJhQpz8oImnUG7HxdWGSdCQRagu75xTNN9yUExdl83Glwv6DWD",
+	"BvygTPipoqxv34JRB0I8zGmbRUetB6Dxfp2vVTdWD4Ls3RIsXQzJpcTUE6+OOgAzKLsOyY2CfdRXObRq",
+	"bac3Lrf6INx2fE45y82ROqH2N9ybJhlaWlDRS12JB/2UMu2yXGrTBFpyNVMa5CY5jUMdM1fF2pI+L02C",
+	"jDOBuMRrn8dt4OViVrK6+oD2K7D6Wi4XEy6saBZaASNxOzPgZMSWNGhofukCIV9AXcOAvg36Wkrh8XGA",
+	"u+tK9acdYYsuo2WT2+DSvfMZMePn+BrVn+wKwsZqUbWmzghjXeU9xGN7PNCfAxoTOZdSk+OwYg4GYDKa",
+	"z82x6QgI3b3S8JBgrDgty3UGlxKoBfbluups2kEDcIOUmjovl1WeELP7VLDgqthYvsLLRD0qr9ypUu8X",
+	"EbUuz493roFqpzUigtmoh8yDNuN1yBWGjh+ZlOBuw7dcLA056lu5lZBd70m8mN8LzDVmInd1gMLaKbZW",
+	"sc7n3uRhQ17NOOZjuLNXHASAuljOjTSPyb9Wslot/IUCYqO5YWyZaloxU1rbli1iha+kjDB1+GMuq/zE",
+	"IGOLCnlWMGHWUedCo94AF481FxkyJVwVv3NVfNqb/G5U+E976ncF0fyfOl2eG4MSakWOq+LJwd5kf08d",
+	"9FHC2hArlktRPATIk51BfjzIvmg9gsvzY9jWVLeEmkSJLc7sz8ydW0SPnmwA/cH1lCNNSkaRX7vdBV4f",
+	"N4xuChHhwd7GIbqJomdMURfP6D6HvQq54nXSg/ieHKRNBIkxzQL7Dbrfe0xEVr9RH38GA8GWw9Fhx33A",
+	"FpNmkjvR1y6Ba11E5oLXnDMVgpXNuH/IgseXVd670PGf5+OOTuTL82Pr+f3HP49u3/7z6Nnry9Pbs4a/",
+	"uH5rkDxA32hxZAfZ1yiHfH8+simQAzwme1Tkc1ltZRqx8QLbZEOOZtLfB+6AyUoUJewJBMOWEmv4VEWk",
+	"eAGC8E3IHUXXNAyHoEGBBCm10hVdutpsQYUxA1LdGZPP5gZOhEIUBA+Ls6cvWeUyS+tGFnH/Fq5VUz7+",
+	"H7KsWMFyppSsfKHF2nEEjhCo0tnwPGbebeNm87pomocGZZdUXMbIosj+5vNh+rPSulALjhTT46Y2+TGf",
+	"TXPZldJHSEifj7lY2jP7t9/JW7Z9ebALVwK69VTcOAaWlu7arRuGvQ9TSW3jVrby5Cu7Zc3U0IFGgBz9",
+	"tTwVaN0J3RRBbexv33ndZsptron00cH6b1iluBSdXD8wZNtXOyymBCPfE6UlJiteFmTBNDXL8rEZ9ZrI",
+	"z+jHrT0Y2FqRLpbAyZzXBCcwjFQuuNYdyfx/syv6jLK/nQLqjSX28TksOE6WiWt+NV/YjNO0OdWMCEll",
+	"KMauqnJwOJhrvTx89Oj3uVT60+HvZu8+DbLBDa24QTVgYu5L7zv3NHgf4PGnbGC+iX9+PHry9MAs9IOH",
+	"o12DlFVrLNBZsRLcWFqm82mbGSWJctCbRjt+9+6XM1/eIRgOqbo92DFgDEo22chMI3HgYBbPIVQWwQmg",
+	"nC8khCmIkqv9CYlR8R0TKv7/DwCVQskgrH8BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
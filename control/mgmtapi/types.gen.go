@@ -7,6 +7,15 @@ import (
 	"time"
 )
 
+// Defines values for BeaconAnomalyKind.
+const (
+	ExpiryBeforeTimestamp BeaconAnomalyKind = "expiry_before_timestamp"
+	FutureTimestamp       BeaconAnomalyKind = "future_timestamp"
+	NegativeInterval      BeaconAnomalyKind = "negative_interval"
+	ZeroExpiry            BeaconAnomalyKind = "zero_expiry"
+	ZeroTimestamp         BeaconAnomalyKind = "zero_timestamp"
+)
+
 // Defines values for BeaconUsage.
 const (
 	CoreRegistration BeaconUsage = "core_registration"
@@ -15,6 +24,14 @@ const (
 	UpRegistration   BeaconUsage = "up_registration"
 )
 
+// Defines values for HopType.
+const (
+	Core    HopType = "core"
+	Egress  HopType = "egress"
+	Ingress HopType = "ingress"
+	Peer    HopType = "peer"
+)
+
 // Defines values for LogLevelLevel.
 const (
 	Debug LogLevelLevel = "debug"
@@ -22,6 +39,13 @@ const (
 	Info  LogLevelLevel = "info"
 )
 
+// Defines values for SelectedBeaconReason.
+const (
+	Candidate SelectedBeaconReason = "candidate"
+	Diverse   SelectedBeaconReason = "diverse"
+	Shortest  SelectedBeaconReason = "shortest"
+)
+
 // Defines values for Status.
 const (
 	Degraded Status = "degraded"
@@ -29,39 +53,374 @@ const (
 	Passing  Status = "passing"
 )
 
-// Defines values for GetBeaconsParamsSort.
+// Defines values for GetBeaconSlaParamsGroupBy.
+const (
+	Origin GetBeaconSlaParamsGroupBy = "origin"
+	Usage  GetBeaconSlaParamsGroupBy = "usage"
+)
+
+// Defines values for GetSegmentsParamsGroupBy.
 const (
-	Expiration       GetBeaconsParamsSort = "expiration"
-	IngressInterface GetBeaconsParamsSort = "ingress_interface"
-	LastUpdated      GetBeaconsParamsSort = "last_updated"
-	StartIsdAs       GetBeaconsParamsSort = "start_isd_as"
-	Timestamp        GetBeaconsParamsSort = "timestamp"
+	Type GetSegmentsParamsGroupBy = "type"
 )
 
 // Beacon defines model for Beacon.
 type Beacon struct {
+	// Class Classification of the beacon, either `core` or `non_core`. It is derived from the usages of the beacon and, if they are not conclusive, from the structure of the segment. Only present if requested with `expand=class` and the classification is conclusive.
+	Class *string `json:"class,omitempty"`
+
+	// CryptoAgile Whether all AS entries are signed with one of the signature algorithms that are configured as modern. Only present if such an allowlist is configured.
+	CryptoAgile *bool `json:"crypto_agile,omitempty"`
+
+	// DuplicateCount Number of other beacons with the same sequence of hops that were collapsed into this beacon. Only present if requested with `dedupe=hops`.
+	DuplicateCount *int      `json:"duplicate_count,omitempty"`
+	Expiration     time.Time `json:"expiration"`
+	Hops           []Hop     `json:"hops"`
+	Id             SegmentID `json:"id"`
+
+	// IngressInterface Ingress interface of the beacon.
+	IngressInterface int       `json:"ingress_interface"`
+	LastUpdated      time.Time `json:"last_updated"`
+
+	// Mtu Path MTU of the beacon, i.e., the smallest MTU of the AS entries and of the links between them. Only present if the beacons were filtered by minimum MTU.
+	Mtu *int `json:"mtu,omitempty"`
+
+	// ParseWarnings Problems encountered while decoding the AS entries of the segment. Absent if all AS entries were decoded completely.
+	ParseWarnings *[]string `json:"parse_warnings,omitempty"`
+
+	// QualityScore Quality of the path between 0 and 1, higher is better. It is the weighted mean of the scores for the number of AS entries, the remaining validity, the announced latency and the announced bandwidth. Components that are not announced do not count towards the mean. Only present if requested with `score=true`.
+	QualityScore *float64 `json:"quality_score,omitempty"`
+
+	// RegisteredVia Neighboring AS and local interface through which the beacon was received, as resolved from the topology. Absent if the ingress interface is not known to the topology.
+	RegisteredVia *string `json:"registered_via,omitempty"`
+
+	// SignatureAlgorithms Signature algorithms of the AS entries, in order of the AS entries. Only present if the beacons were filtered by signature algorithm.
+	SignatureAlgorithms *[]string    `json:"signature_algorithms,omitempty"`
+	Timestamp           time.Time    `json:"timestamp"`
+	Usages              BeaconUsages `json:"usages"`
+}
+
+// BeaconAgeBucket defines model for BeaconAgeBucket.
+type BeaconAgeBucket struct {
+	// Count Number of beacons in the bucket.
+	Count int `json:"count"`
+
+	// UpperBound Maximum age of the beacons in the bucket.
+	UpperBound string `json:"upper_bound"`
+
+	// UpperBoundSeconds Maximum age of the beacons in the bucket in seconds.
+	UpperBoundSeconds int `json:"upper_bound_seconds"`
+}
+
+// BeaconAgeHistogram defines model for BeaconAgeHistogram.
+type BeaconAgeHistogram struct {
+	// Buckets Buckets in increasing order of their boundary.
+	Buckets []BeaconAgeBucket `json:"buckets"`
+
+	// Older Number of beacons that are older than the last boundary.
+	Older int `json:"older"`
+
+	// Total Number of currently valid beacons.
+	Total int `json:"total"`
+}
+
+// BeaconAnomaly defines model for BeaconAnomaly.
+type BeaconAnomaly struct {
+	// Anomalies The invariants that the beacon violates.
+	Anomalies []BeaconAnomalyKind `json:"anomalies"`
+
+	// Expiration Earliest expiration of the hop fields of the beacon.
 	Expiration time.Time `json:"expiration"`
-	Hops       []Hop     `json:"hops"`
 	Id         SegmentID `json:"id"`
 
-	// IngressInterface Ingress interface of the beacon.
-	IngressInterface int          `json:"ingress_interface"`
-	LastUpdated      time.Time    `json:"last_updated"`
-	Timestamp        time.Time    `json:"timestamp"`
-	Usages           BeaconUsages `json:"usages"`
+	// LastUpdated Time when the beacon was last stored.
+	LastUpdated time.Time `json:"last_updated"`
+
+	// Timestamp Creation time of the beacon as set by the origin.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// BeaconAnomalyKind Invariant violation of a beacon. `expiry_before_timestamp` if the beacon expires before its timestamp, `negative_interval` if the earliest expiration of the hop fields is after the latest one, `zero_expiry` if the beacon expires at its timestamp at the latest, `zero_timestamp` if the timestamp is not set, and `future_timestamp` if the timestamp is more than a minute after the time the beacon was stored.
+type BeaconAnomalyKind string
+
+// BeaconCandidates defines model for BeaconCandidates.
+type BeaconCandidates struct {
+	// SegmentIds IDs of the beacons that match the segment ID prefix, sorted in ascending order.
+	SegmentIds []SegmentID `json:"segment_ids"`
+}
+
+// BeaconCover defines model for BeaconCover.
+type BeaconCover struct {
+	// SegmentIds IDs of the selected beacons, sorted by the interface on which they were received.
+	SegmentIds []SegmentID `json:"segment_ids"`
+
+	// UncoveredInterfaces IDs of the configured interfaces without any valid beacon, sorted in ascending order.
+	UncoveredInterfaces []int `json:"uncovered_interfaces"`
+}
+
+// BeaconDeletion defines model for BeaconDeletion.
+type BeaconDeletion struct {
+	// DeletedCount Number of beacons that were deleted.
+	DeletedCount int `json:"deleted_count"`
+}
+
+// BeaconFeatureProperties defines model for BeaconFeatureProperties.
+type BeaconFeatureProperties struct {
+	// Address Civic address of the hop, if any. Only set for hops.
+	Address  *string   `json:"address,omitempty"`
+	BeaconId SegmentID `json:"beacon_id"`
+
+	// Interface Interface of the hop. Only set for hops.
+	Interface *int   `json:"interface,omitempty"`
+	IsdAs     *IsdAs `json:"isd_as,omitempty"`
 }
 
 // BeaconGetResponseJson defines model for BeaconGetResponseJson.
 type BeaconGetResponseJson struct {
 	Beacon Beacon `json:"beacon"`
+
+	// Raw Protobuf encoding of the beacon segment, i.e., the content of `/beacons/{segment-id}/blob`, in standard base64 encoding with padding (RFC 4648, section 4). Only present if requested with `include_blob=true`.
+	Raw *[]byte `json:"raw,omitempty"`
+}
+
+// BeaconHeartbeat defines model for BeaconHeartbeat.
+type BeaconHeartbeat struct {
+	// Origins Latest beacon per origin AS, sorted by ISD-AS.
+	Origins []BeaconHeartbeatOrigin `json:"origins"`
+
+	// SilentAfter Age of the most recent beacon beyond which an origin is silent.
+	SilentAfter string `json:"silent_after"`
+
+	// SilentAfterSeconds Age of the most recent beacon beyond which an origin is silent in seconds.
+	SilentAfterSeconds int `json:"silent_after_seconds"`
+}
+
+// BeaconHeartbeatOrigin defines model for BeaconHeartbeatOrigin.
+type BeaconHeartbeatOrigin struct {
+	// AgeSeconds Time since the creation of the most recent beacon in seconds. It is 0 if the beacon was created in the future.
+	AgeSeconds int   `json:"age_seconds"`
+	IsdAs      IsdAs `json:"isd_as"`
+
+	// LatestTimestamp Creation time of the most recent beacon of the origin.
+	LatestTimestamp time.Time `json:"latest_timestamp"`
+
+	// Silent Whether the most recent beacon is older than the threshold.
+	Silent bool `json:"silent"`
+}
+
+// BeaconInterfaceCount defines model for BeaconInterfaceCount.
+type BeaconInterfaceCount struct {
+	// Count Number of beacons received on the interface.
+	Count int `json:"count"`
+
+	// IngressInterface Ingress interface of the beacons.
+	IngressInterface int `json:"ingress_interface"`
+}
+
+// BeaconOriginCount defines model for BeaconOriginCount.
+type BeaconOriginCount struct {
+	// Count Number of beacons originated by the AS.
+	Count int   `json:"count"`
+	IsdAs IsdAs `json:"isd_as"`
+}
+
+// BeaconPolicy defines model for BeaconPolicy.
+type BeaconPolicy struct {
+	// BestSetSize Number of segments to propagate or register.
+	BestSetSize int `json:"best_set_size"`
+
+	// CandidateSetSize Number of segments to consider for selection.
+	CandidateSetSize int                `json:"candidate_set_size"`
+	Filter           BeaconPolicyFilter `json:"filter"`
+
+	// MaxExpirationTime Maximum expiration time of the hop fields when extending a segment.
+	MaxExpirationTime string `json:"max_expiration_time"`
+
+	// MaxExpirationTimeSeconds Maximum expiration time of the hop fields when extending a segment in seconds, rounded down.
+	MaxExpirationTimeSeconds int `json:"max_expiration_time_seconds"`
+
+	// Type The policy type.
+	Type string `json:"type"`
+}
+
+// BeaconPolicyFilter defines model for BeaconPolicyFilter.
+type BeaconPolicyFilter struct {
+	// AllowIsdLoop Whether ISD loops are allowed.
+	AllowIsdLoop bool `json:"allow_isd_loop"`
+
+	// AsBlacklist ASes that may not appear in a segment.
+	AsBlacklist []string `json:"as_blacklist"`
+
+	// IsdBlacklist ISDs that may not appear in a segment.
+	IsdBlacklist []int `json:"isd_blacklist"`
+
+	// MaxHopsLength Maximum number of hops a segment can have.
+	MaxHopsLength int `json:"max_hops_length"`
+}
+
+// BeaconQueryExplanation The query as it is passed to the beacon storage. This is a debugging aid and not a stable data format.
+type BeaconQueryExplanation struct {
+	// Dedupe Key by which duplicate beacons are collapsed.
+	Dedupe *string `json:"dedupe,omitempty"`
+
+	// Desc Whether the sort order is reversed.
+	Desc bool `json:"desc"`
+
+	// ExpiredOnly Whether only expired beacons are returned.
+	ExpiredOnly *bool `json:"expired_only,omitempty"`
+
+	// IngressInterfaces Ingress interfaces of which the beacons need to match one.
+	IngressInterfaces []int `json:"ingress_interfaces"`
+
+	// LoopsOnly Whether only beacons that contain a loop are returned.
+	LoopsOnly *bool `json:"loops_only,omitempty"`
+
+	// MinMtu Minimum path MTU the beacons are filtered by.
+	MinMtu *int `json:"min_mtu,omitempty"`
+
+	// SignedWith Signature algorithm the beacons are filtered by.
+	SignedWith *string `json:"signed_with,omitempty"`
+
+	// Snapshot Time of the snapshot the beacons are read at.
+	Snapshot *time.Time `json:"snapshot,omitempty"`
+
+	// Sort Effective sort order.
+	Sort string `json:"sort"`
+
+	// StartIsdAs ISD-AS identifiers of which the beacons need to start at one.
+	StartIsdAs []string `json:"start_isd_as"`
+
+	// StartIsdAsPrefix Prefix that the start ISD-AS of the beacons needs to start with. Only present if the start ISD-AS was given as wildcard pattern.
+	StartIsdAsPrefix *string `json:"start_isd_as_prefix,omitempty"`
+
+	// UsageMasks Usage bitmasks of which the beacons need to match one.
+	UsageMasks []int `json:"usage_masks"`
+
+	// ValidAt Time at which the beacons need to be valid. Absent if beacons are returned regardless of their validity.
+	ValidAt *time.Time `json:"valid_at,omitempty"`
+}
+
+// BeaconReconcileRequest defines model for BeaconReconcileRequest.
+type BeaconReconcileRequest struct {
+	// Ids Segment IDs of the expected beacons.
+	Ids []SegmentID `json:"ids"`
+}
+
+// BeaconReconcileResult defines model for BeaconReconcileResult.
+type BeaconReconcileResult struct {
+	// Missing IDs of the expected beacons that are not in the beacon store, in the order of the request.
+	Missing []SegmentID `json:"missing"`
+
+	// Present IDs of the expected beacons that are in the beacon store, in the order of the request.
+	Present []SegmentID `json:"present"`
+}
+
+// BeaconSLAGroup defines model for BeaconSLAGroup.
+type BeaconSLAGroup struct {
+	// Fresh Number of fresh beacons in the group.
+	Fresh int `json:"fresh"`
+
+	// FreshRatio Fraction of fresh beacons in the group, between 0 and 1.
+	FreshRatio float32 `json:"fresh_ratio"`
+
+	// Group Origin ISD-AS or usage of the beacons in the group.
+	Group string `json:"group"`
+
+	// Stale Number of stale beacons in the group.
+	Stale int `json:"stale"`
+
+	// Total Number of beacons in the group.
+	Total int `json:"total"`
+}
+
+// BeaconSLAReport defines model for BeaconSLAReport.
+type BeaconSLAReport struct {
+	// Compliance Fraction of fresh beacons among all beacons, between 0 and 1, like the `fresh_ratio` of the groups. Absent if there are no beacons.
+	Compliance *float32 `json:"compliance,omitempty"`
+
+	// Fresh Number of fresh beacons.
+	Fresh int `json:"fresh"`
+
+	// GroupBy Attribute by which the beacons are grouped.
+	GroupBy string `json:"group_by"`
+
+	// Groups Freshness per group, sorted by group.
+	Groups []BeaconSLAGroup `json:"groups"`
+
+	// Stale Number of stale beacons.
+	Stale int `json:"stale"`
+
+	// Total Number of beacons.
+	Total int `json:"total"`
+
+	// Window Window within which fresh beacons were updated.
+	Window string `json:"window"`
+
+	// WindowSeconds Window within which fresh beacons were updated in seconds.
+	WindowSeconds int `json:"window_seconds"`
+}
+
+// BeaconStats defines model for BeaconStats.
+type BeaconStats struct {
+	// DistinctOrigins Number of distinct origin ASes of the beacons.
+	DistinctOrigins int `json:"distinct_origins"`
+
+	// Interfaces Number of beacons per ingress interface, sorted by interface.
+	Interfaces []BeaconInterfaceCount `json:"interfaces"`
+
+	// Newest Creation time of the newest beacon, in second precision. Absent if there are no beacons.
+	Newest *time.Time `json:"newest,omitempty"`
+
+	// Oldest Creation time of the oldest beacon, in second precision. Absent if there are no beacons.
+	Oldest *time.Time `json:"oldest,omitempty"`
+
+	// TopOrigins Origin ASes with the most beacons, sorted by descending number of beacons and then by ISD-AS. The list is limited to the requested number of entries.
+	TopOrigins []BeaconOriginCount `json:"top_origins"`
+
+	// Total Number of currently valid beacons.
+	Total int `json:"total"`
+
+	// Usages Number of beacons per usage, sorted by usage. A beacon with multiple usages counts towards each of its usages.
+	Usages []BeaconUsageCount `json:"usages"`
 }
 
 // BeaconUsage defines model for BeaconUsage.
 type BeaconUsage string
 
+// BeaconUsageCount defines model for BeaconUsageCount.
+type BeaconUsageCount struct {
+	// Count Number of beacons with the usage.
+	Count int         `json:"count"`
+	Usage BeaconUsage `json:"usage"`
+}
+
 // BeaconUsages defines model for BeaconUsages.
 type BeaconUsages = []BeaconUsage
 
+// BeaconingPolicy defines model for BeaconingPolicy.
+type BeaconingPolicy struct {
+	// OriginationInterval Interval between originating beacons. Only relevant in core ASes.
+	OriginationInterval string `json:"origination_interval"`
+
+	// OriginationIntervalSeconds Interval between originating beacons in seconds, rounded down. Only relevant in core ASes.
+	OriginationIntervalSeconds int `json:"origination_interval_seconds"`
+
+	// Policies Propagation and registration policies used by the beacon store.
+	Policies []BeaconPolicy `json:"policies"`
+
+	// PropagationInterval Interval between propagating beacons.
+	PropagationInterval string `json:"propagation_interval"`
+
+	// PropagationIntervalSeconds Interval between propagating beacons in seconds, rounded down.
+	PropagationIntervalSeconds int `json:"propagation_interval_seconds"`
+
+	// RegistrationInterval Interval between registering segments.
+	RegistrationInterval string `json:"registration_interval"`
+
+	// RegistrationIntervalSeconds Interval between registering segments in seconds, rounded down.
+	RegistrationIntervalSeconds int `json:"registration_interval_seconds"`
+}
+
 // CA defines model for CA.
 type CA struct {
 	CertValidity Validity     `json:"cert_validity"`
@@ -87,10 +446,13 @@ type Chain struct {
 
 // ChainBrief defines model for ChainBrief.
 type ChainBrief struct {
-	Id       ChainID  `json:"id"`
-	Issuer   IsdAs    `json:"issuer"`
-	Subject  IsdAs    `json:"subject"`
-	Validity Validity `json:"validity"`
+	Id      ChainID `json:"id"`
+	Issuer  IsdAs   `json:"issuer"`
+	Subject IsdAs   `json:"subject"`
+
+	// Superseded Whether a newer chain for the same AS exists. Only present if superseded chains were requested.
+	Superseded *bool    `json:"superseded,omitempty"`
+	Validity   Validity `json:"validity"`
 }
 
 // ChainID defines model for ChainID.
@@ -114,6 +476,38 @@ type Check struct {
 // CheckData defines model for CheckData.
 type CheckData map[string]interface{}
 
+// ConfigReloadResult defines model for ConfigReloadResult.
+type ConfigReloadResult struct {
+	// Changes Description of the changes that were applied. Empty if the configuration did not change.
+	Changes []string `json:"changes"`
+}
+
+// GeoJSONFeature defines model for GeoJSONFeature.
+type GeoJSONFeature struct {
+	Geometry   GeoJSONGeometry         `json:"geometry"`
+	Properties BeaconFeatureProperties `json:"properties"`
+
+	// Type Always `Feature`.
+	Type string `json:"type"`
+}
+
+// GeoJSONFeatureCollection GeoJSON feature collection (RFC 7946) of the beacons that carry geo coordinates in their static info extension. Every hop with coordinates is a `Point` feature and every beacon with at least two such hops is a `LineString` feature connecting them in the order of the hops. Beacons without geo coordinates are omitted.
+type GeoJSONFeatureCollection struct {
+	Features []GeoJSONFeature `json:"features"`
+
+	// Type Always `FeatureCollection`.
+	Type string `json:"type"`
+}
+
+// GeoJSONGeometry defines model for GeoJSONGeometry.
+type GeoJSONGeometry struct {
+	// Coordinates Position as `[longitude, latitude]` for a `Point`, or list of positions for a `LineString`.
+	Coordinates interface{} `json:"coordinates"`
+
+	// Type Either `Point` for a hop or `LineString` for a beacon.
+	Type string `json:"type"`
+}
+
 // Health defines model for Health.
 type Health struct {
 	// Checks List of health checks.
@@ -121,6 +515,31 @@ type Health struct {
 	Status Status  `json:"status"`
 }
 
+// HealthChanges defines model for HealthChanges.
+type HealthChanges struct {
+	// Checks Current state of the health checks that changed.
+	Checks []Check `json:"checks"`
+
+	// Timestamp Time at which the health was evaluated. It can be passed as `since` to poll for subsequent changes.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// HealthEvent defines model for HealthEvent.
+type HealthEvent struct {
+	// Check Name of the health check.
+	Check          string  `json:"check"`
+	PreviousStatus *Status `json:"previous_status,omitempty"`
+	Status         Status  `json:"status"`
+
+	// Timestamp Time at which the transition was observed.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// HealthHistory defines model for HealthHistory.
+type HealthHistory struct {
+	Events []HealthEvent `json:"events"`
+}
+
 // HealthResponse defines model for HealthResponse.
 type HealthResponse struct {
 	Health Health `json:"health"`
@@ -128,8 +547,53 @@ type HealthResponse struct {
 
 // Hop defines model for Hop.
 type Hop struct {
+	// Bandwidth Bandwidth in Kbit/s announced in the static info extension for the interface of the hop, with the same meaning as `latency`. Absent if it is not announced.
+	Bandwidth *int  `json:"bandwidth,omitempty"`
 	Interface int   `json:"interface"`
 	IsdAs     IsdAs `json:"isd_as"`
+
+	// Latency Latency in milliseconds announced in the static info extension for the interface of the hop. For an ingress interface, it is the latency through the AS to the egress interface. For an egress interface, it is the latency of the link. Absent if it is not announced.
+	Latency *float64 `json:"latency,omitempty"`
+
+	// Mtu MTU of the link at the interface of the hop, as announced in the AS entries of the segment. Absent if it is not announced.
+	Mtu *int `json:"mtu,omitempty"`
+
+	// Name Name of the local interface that the interface of the hop is linked to, as derived from the topology of the local AS. Only present if requested and if the topology resolves the interface.
+	Name *string `json:"name,omitempty"`
+
+	// PeerInterface Interface ID of the peering link in the peering AS `peer_isd_as`. Only present for `peer` hops.
+	PeerInterface *int   `json:"peer_interface,omitempty"`
+	PeerIsdAs     *IsdAs `json:"peer_isd_as,omitempty"`
+
+	// Type Role of the hop in the segment. `core` is the egress interface of the core AS that originated the segment, `ingress` and `egress` are the interfaces of the hop entries of the other AS entries, and `peer` is the local interface of a peering link that is announced in a peer entry. Only present if requested.
+	Type *HopType `json:"type,omitempty"`
+}
+
+// HopType Role of the hop in the segment. `core` is the egress interface of the core AS that originated the segment, `ingress` and `egress` are the interfaces of the hop entries of the other AS entries, and `peer` is the local interface of a peering link that is announced in a peer entry. Only present if requested.
+type HopType string
+
+// InterfaceStatus defines model for InterfaceStatus.
+type InterfaceStatus struct {
+	// BeaconsLastHour Number of beacons received on the interface that were updated within the last hour.
+	BeaconsLastHour int `json:"beacons_last_hour"`
+
+	// InterfaceId Interface ID.
+	InterfaceId int `json:"interface_id"`
+
+	// LastBeacon Time at which a beacon received on the interface was last updated. Absent if no beacon was received on the interface.
+	LastBeacon *time.Time `json:"last_beacon,omitempty"`
+
+	// LinkType Type of the link as configured in the topology.
+	LinkType string `json:"link_type"`
+
+	// Mtu MTU of the link as configured in the topology.
+	Mtu           int   `json:"mtu"`
+	NeighborIsdAs IsdAs `json:"neighbor_isd_as"`
+}
+
+// InterfacesResponse defines model for InterfacesResponse.
+type InterfacesResponse struct {
+	Interfaces []InterfaceStatus `json:"interfaces"`
 }
 
 // IsdAs defines model for IsdAs.
@@ -144,9 +608,33 @@ type LogLevel struct {
 // LogLevelLevel Logging level
 type LogLevelLevel string
 
+// LogLevelOptions defines model for LogLevelOptions.
+type LogLevelOptions struct {
+	// Level Current logging level.
+	Level string `json:"level"`
+
+	// Levels Logging levels that can be set, ordered from the most to the least verbose.
+	Levels []string `json:"levels"`
+}
+
+// NextSigner defines model for NextSigner.
+type NextSigner struct {
+	// Activation Point in time at which the validity of the signer starts.
+	Activation    time.Time   `json:"activation"`
+	AsCertificate Certificate `json:"as_certificate"`
+
+	// Expiration Signer expiration imposed by chain and TRC validity.
+	Expiration time.Time `json:"expiration"`
+	TrcId      TRCID     `json:"trc_id"`
+}
+
 // Policy defines model for Policy.
 type Policy struct {
+	// ChainLifetime Lifetime of the issued certificate chains in human readable form.
 	ChainLifetime string `json:"chain_lifetime"`
+
+	// ChainLifetimeSeconds Lifetime of the issued certificate chains in seconds.
+	ChainLifetimeSeconds int `json:"chain_lifetime_seconds"`
 }
 
 // Problem defines model for Problem.
@@ -173,7 +661,10 @@ type Segment struct {
 	Hops        []Hop     `json:"hops"`
 	Id          SegmentID `json:"id"`
 	LastUpdated time.Time `json:"last_updated"`
-	Timestamp   time.Time `json:"timestamp"`
+
+	// ParseWarnings Problems encountered while decoding the AS entries of the segment. Absent if all AS entries were decoded completely.
+	ParseWarnings *[]string `json:"parse_warnings,omitempty"`
+	Timestamp     time.Time `json:"timestamp"`
 }
 
 // SegmentBrief defines model for SegmentBrief.
@@ -189,19 +680,100 @@ type SegmentBrief struct {
 // SegmentID defines model for SegmentID.
 type SegmentID = string
 
+// SegmentsByType defines model for SegmentsByType.
+type SegmentsByType struct {
+	Core []SegmentBrief `json:"core"`
+	Down []SegmentBrief `json:"down"`
+	Up   []SegmentBrief `json:"up"`
+}
+
+// SelectedBeacon defines model for SelectedBeacon.
+type SelectedBeacon struct {
+	// Hops Number of AS entries of the beacon.
+	Hops int       `json:"hops"`
+	Id   SegmentID `json:"id"`
+
+	// IngressInterface Ingress interface of the beacon.
+	IngressInterface int `json:"ingress_interface"`
+
+	// Policy Type of the policy that selected the beacon.
+	Policy string `json:"policy"`
+
+	// Reason Reason the beacon was selected. `candidate` if there were not more candidate beacons than the best set size of the policy, `shortest` if the beacon is among the shortest candidate beacons, and `diverse` if it is the candidate beacon that is most diverse from the shortest beacon.
+	Reason     SelectedBeaconReason `json:"reason"`
+	StartIsdAs IsdAs                `json:"start_isd_as"`
+}
+
+// SelectedBeaconReason Reason the beacon was selected. `candidate` if there were not more candidate beacons than the best set size of the policy, `shortest` if the beacon is among the shortest candidate beacons, and `diverse` if it is the candidate beacon that is most diverse from the shortest beacon.
+type SelectedBeaconReason string
+
+// ServiceStatus defines model for ServiceStatus.
+type ServiceStatus struct {
+	// Ca Availability of the CA, one of available, starting, stopping or unavailable. Absent if the service does not act as a CA.
+	Ca *string `json:"ca,omitempty"`
+
+	// CaEnabled Whether the service acts as a CA. If not, the CA endpoints respond with status 501.
+	CaEnabled bool   `json:"ca_enabled"`
+	Health    Health `json:"health"`
+
+	// Signer Active signer. Absent if no signer is currently valid.
+	Signer *Signer `json:"signer,omitempty"`
+
+	// Trc Latest TRC of the local ISD. Absent if no TRC is available.
+	Trc *TRCID `json:"trc,omitempty"`
+}
+
 // Signer defines model for Signer.
 type Signer struct {
 	AsCertificate Certificate `json:"as_certificate"`
 
 	// Expiration Signer expiration imposed by chain and TRC validity.
 	Expiration time.Time `json:"expiration"`
-	TrcId      TRCID     `json:"trc_id"`
+
+	// NextSigner Signer that becomes active next. Only present for the active signer if a signer with a later start of validity is available.
+	NextSigner *NextSigner `json:"next_signer,omitempty"`
+	TrcId      TRCID       `json:"trc_id"`
 
 	// TrcInGracePeriod TRC used as trust root is in grace period, and the latest TRC cannot
 	// be used as trust root.
 	TrcInGracePeriod bool `json:"trc_in_grace_period"`
 }
 
+// SignerStatus defines model for SignerStatus.
+type SignerStatus struct {
+	// ExpiresAt Signer expiration imposed by chain and TRC validity.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// InGrace TRC used as trust root is in grace period, and the latest TRC cannot be used as trust root.
+	InGrace bool `json:"in_grace"`
+
+	// Ok Whether the signer is healthy, i.e., it is neither expired, close to expiration, nor authenticated by a TRC in grace period.
+	Ok bool `json:"ok"`
+}
+
+// SignerTrust defines model for SignerTrust.
+type SignerTrust struct {
+	SubjectKeyId SubjectKeyID     `json:"subject_key_id"`
+	Trcs         []SignerTrustTRC `json:"trcs"`
+}
+
+// SignerTrustTRC defines model for SignerTrustTRC.
+type SignerTrustTRC struct {
+	Id TRCID `json:"id"`
+
+	// InGrace TRC is in grace period, i.e., it has been superseded by the latest TRC but can still be used as trust root.
+	InGrace bool `json:"in_grace"`
+}
+
+// Snapshot defines model for Snapshot.
+type Snapshot struct {
+	// TakenAt Time at which the snapshot was taken.
+	TakenAt time.Time `json:"taken_at"`
+
+	// Token Opaque token to pass as `snapshot` to beacon queries.
+	Token string `json:"token"`
+}
+
 // StandardError defines model for StandardError.
 type StandardError struct {
 	// Error Error message
@@ -233,6 +805,42 @@ type TRCBrief struct {
 	Id TRCID `json:"id"`
 }
 
+// TRCCertificate defines model for TRCCertificate.
+type TRCCertificate struct {
+	DistinguishedName string       `json:"distinguished_name"`
+	IsdAs             *IsdAs       `json:"isd_as,omitempty"`
+	SubjectKeyId      SubjectKeyID `json:"subject_key_id"`
+	Type              string       `json:"type"`
+	Validity          Validity     `json:"validity"`
+}
+
+// TRCCertificateChange defines model for TRCCertificateChange.
+type TRCCertificateChange struct {
+	A TRCCertificate `json:"a"`
+	B TRCCertificate `json:"b"`
+}
+
+// TRCCertificatesDiff defines model for TRCCertificatesDiff.
+type TRCCertificatesDiff struct {
+	// Added Certificates that are only in the second TRC.
+	Added []TRCCertificate `json:"added"`
+
+	// Changed Certificates with the same type and distinguished name that differ between the TRCs.
+	Changed []TRCCertificateChange `json:"changed"`
+
+	// Removed Certificates that are only in the first TRC.
+	Removed []TRCCertificate `json:"removed"`
+}
+
+// TRCDiff defines model for TRCDiff.
+type TRCDiff struct {
+	A            TRCID               `json:"a"`
+	B            TRCID               `json:"b"`
+	Certificates TRCCertificatesDiff `json:"certificates"`
+	Quorum       *TRCQuorumChange    `json:"quorum,omitempty"`
+	Validity     *TRCValidityChange  `json:"validity,omitempty"`
+}
+
 // TRCID defines model for TRCID.
 type TRCID struct {
 	BaseNumber   int `json:"base_number"`
@@ -240,6 +848,18 @@ type TRCID struct {
 	SerialNumber int `json:"serial_number"`
 }
 
+// TRCQuorumChange defines model for TRCQuorumChange.
+type TRCQuorumChange struct {
+	A int `json:"a"`
+	B int `json:"b"`
+}
+
+// TRCValidityChange defines model for TRCValidityChange.
+type TRCValidityChange struct {
+	A Validity `json:"a"`
+	B Validity `json:"b"`
+}
+
 // Topology defines model for Topology.
 type Topology map[string]interface{}
 
@@ -249,44 +869,338 @@ type Validity struct {
 	NotBefore time.Time `json:"not_before"`
 }
 
+// VersionInfo defines model for VersionInfo.
+type VersionInfo struct {
+	// BuildTime Time of the commit the binary was built from.
+	BuildTime *time.Time `json:"build_time,omitempty"`
+
+	// GitCommit Git commit the binary was built from.
+	GitCommit *string `json:"git_commit,omitempty"`
+
+	// GoVersion Version of the Go runtime.
+	GoVersion string `json:"go_version"`
+
+	// Version Version of the binary.
+	Version string `json:"version"`
+}
+
+// BeaconQueryAll defines model for BeaconQueryAll.
+type BeaconQueryAll = bool
+
+// BeaconQueryCursor defines model for BeaconQueryCursor.
+type BeaconQueryCursor = string
+
+// BeaconQueryDedupe defines model for BeaconQueryDedupe.
+type BeaconQueryDedupe = string
+
+// BeaconQueryDesc defines model for BeaconQueryDesc.
+type BeaconQueryDesc = bool
+
+// BeaconQueryExpand defines model for BeaconQueryExpand.
+type BeaconQueryExpand = string
+
+// BeaconQueryExpiredOnly defines model for BeaconQueryExpiredOnly.
+type BeaconQueryExpiredOnly = bool
+
+// BeaconQueryExplain defines model for BeaconQueryExplain.
+type BeaconQueryExplain = bool
+
+// BeaconQueryFromNeighbor defines model for BeaconQueryFromNeighbor.
+type BeaconQueryFromNeighbor = IsdAs
+
+// BeaconQueryFutureOk defines model for BeaconQueryFutureOk.
+type BeaconQueryFutureOk = bool
+
+// BeaconQueryIngressInterface defines model for BeaconQueryIngressInterface.
+type BeaconQueryIngressInterface = int
+
+// BeaconQueryLimit defines model for BeaconQueryLimit.
+type BeaconQueryLimit = int
+
+// BeaconQueryLoopsOnly defines model for BeaconQueryLoopsOnly.
+type BeaconQueryLoopsOnly = bool
+
+// BeaconQueryMinMtu defines model for BeaconQueryMinMtu.
+type BeaconQueryMinMtu = int
+
+// BeaconQueryNames defines model for BeaconQueryNames.
+type BeaconQueryNames = bool
+
+// BeaconQueryOffset defines model for BeaconQueryOffset.
+type BeaconQueryOffset = int
+
+// BeaconQueryScore defines model for BeaconQueryScore.
+type BeaconQueryScore = bool
+
+// BeaconQuerySignedWith defines model for BeaconQuerySignedWith.
+type BeaconQuerySignedWith = string
+
+// BeaconQuerySnapshot defines model for BeaconQuerySnapshot.
+type BeaconQuerySnapshot = string
+
+// BeaconQuerySort defines model for BeaconQuerySort.
+type BeaconQuerySort = string
+
+// BeaconQueryStartIsd defines model for BeaconQueryStartIsd.
+type BeaconQueryStartIsd = int
+
+// BeaconQueryStartIsdAs defines model for BeaconQueryStartIsdAs.
+type BeaconQueryStartIsdAs = IsdAs
+
+// BeaconQueryUsages defines model for BeaconQueryUsages.
+type BeaconQueryUsages = BeaconUsages
+
+// BeaconQueryValidAt defines model for BeaconQueryValidAt.
+type BeaconQueryValidAt = time.Time
+
 // BadRequest defines model for BadRequest.
 type BadRequest = StandardError
 
 // Internal defines model for Internal.
 type Internal = StandardError
 
-// GetBeaconsParams defines parameters for GetBeacons.
-type GetBeaconsParams struct {
-	// StartIsdAs Start ISD-AS of beacons. The address can include wildcards (0) both for the ISD and AS identifier.
+// DeleteBeaconsParams defines parameters for DeleteBeacons.
+type DeleteBeaconsParams struct {
+	// StartIsdAs Start ISD-AS of the beacons to delete. Wildcards are not supported.
 	StartIsdAs *IsdAs `form:"start_isd_as,omitempty" json:"start_isd_as,omitempty"`
 
-	// Usages Minimum allowed usages of the returned beacons. Only beacons that are allowed in all the usages in the list will be returned.
+	// Usages Only delete beacons that are allowed in all the usages in the list.
 	Usages *BeaconUsages `form:"usages,omitempty" json:"usages,omitempty"`
 
 	// IngressInterface Ingress interface id.
 	IngressInterface *int `form:"ingress_interface,omitempty" json:"ingress_interface,omitempty"`
 
-	// ValidAt Timestamp at which returned beacons are valid. If unset then the current datetime is used. This only has an effect if `all=false`.
+	// ValidAt Only delete beacons that are valid at the given timestamp.
 	ValidAt *time.Time `form:"valid_at,omitempty" json:"valid_at,omitempty"`
 
-	// All Include beacons regardless of expiration and creation time.
+	// All Delete all beacons. Required if no other filter is set, and must not be combined with other filters.
 	All *bool `form:"all,omitempty" json:"all,omitempty"`
+}
+
+// GetBeaconsParams defines parameters for GetBeacons.
+type GetBeaconsParams struct {
+	// StartIsdAs Start ISD-AS of beacons. The address can include wildcards (0) both for the ISD and AS identifier. Alternatively, a pattern ending in a single `*` selects all beacons whose start ISD-AS, in its canonical string representation, starts with the part before the `*`, e.g., `1-ff00:0:1*`.
+	StartIsdAs *BeaconQueryStartIsdAs `form:"start_isd_as,omitempty" json:"start_isd_as,omitempty"`
+
+	// StartIsd Start ISD of beacons, regardless of the AS identifier. Must not be combined with start_isd_as.
+	StartIsd *BeaconQueryStartIsd `form:"start_isd,omitempty" json:"start_isd,omitempty"`
+
+	// Usages Minimum allowed usages of the returned beacons. Only beacons that are allowed in all the usages in the list will be returned.
+	Usages *BeaconQueryUsages `form:"usages,omitempty" json:"usages,omitempty"`
+
+	// IngressInterface Ingress interface id.
+	IngressInterface *BeaconQueryIngressInterface `form:"ingress_interface,omitempty" json:"ingress_interface,omitempty"`
+
+	// FromNeighbor ISD-AS of a neighbor AS. Only beacons received on one of the interfaces to this neighbor are returned. The neighbor must be configured in the topology. Must not be combined with ingress_interface.
+	FromNeighbor *BeaconQueryFromNeighbor `form:"from_neighbor,omitempty" json:"from_neighbor,omitempty"`
+
+	// ValidAt Timestamp at which returned beacons are valid. If unset then the current datetime is used. Must not be combined with `all=true`. A timestamp in the future lists the beacons that will still be valid at that time, e.g., to plan a maintenance window. To guard against clock or time zone errors, future timestamps are rejected unless `future_ok=true` is set, and the response carries a warning.
+	ValidAt *BeaconQueryValidAt `form:"valid_at,omitempty" json:"valid_at,omitempty"`
+
+	// FutureOk Acknowledge that `valid_at` is intentionally in the future.
+	FutureOk *BeaconQueryFutureOk `form:"future_ok,omitempty" json:"future_ok,omitempty"`
+
+	// All Include beacons regardless of expiration and creation time. Must not be combined with `valid_at`.
+	All *BeaconQueryAll `form:"all,omitempty" json:"all,omitempty"`
+
+	// ExpiredOnly Only include beacons that have expired, i.e., beacons of which an AS entry expired before the current time. This is intended for cleanup tooling. Must not be combined with `all=true` or `valid_at`, which select beacons by validity in a different way.
+	ExpiredOnly *BeaconQueryExpiredOnly `form:"expired_only,omitempty" json:"expired_only,omitempty"`
+
+	// Desc Whether to reverse the sort order (ascending by default).
+	Desc *BeaconQueryDesc `form:"desc,omitempty" json:"desc,omitempty"`
+
+	// Sort Attributes by which results are sorted, as a comma-separated list of `field[:direction]` tokens. Later fields break ties of earlier ones. Supported fields are `expiration`, `timestamp`, `start_isd_as`, `last_updated`, `ingress_interface`, `isd_count` and `hop_count`. The direction is either `asc` (default) or `desc`. The value `start_isd_as` refers to the ISD-AS identifier of the first hop. The value `isd_count` refers to the number of distinct ISDs the hops traverse. The value `hop_count` refers to the number of hops. The value `expiration_time_desc` is a shorthand for `expiration:desc`.
+	Sort *BeaconQuerySort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SignedWith Signature algorithm of the AS entries. Only beacons with at least one AS entry signed with the given algorithm are returned. If set, the signature algorithms of all AS entries are included in the response.
+	SignedWith *BeaconQuerySignedWith `form:"signed_with,omitempty" json:"signed_with,omitempty"`
+
+	// LoopsOnly Only return beacons that contain a loop, i.e., beacons in which multiple AS entries have the same ISD-AS identifier. Such beacons indicate a misconfiguration.
+	LoopsOnly *BeaconQueryLoopsOnly `form:"loops_only,omitempty" json:"loops_only,omitempty"`
+
+	// Explain Debugging aid. If set, no beacons are returned. Instead, the response describes how the server interpreted the query parameters.
+	Explain *BeaconQueryExplain `form:"explain,omitempty" json:"explain,omitempty"`
+
+	// Expand Comma-separated list of optional fields that are added to each beacon. The value `class` adds the classification of the beacon as core or non-core.
+	Expand *BeaconQueryExpand `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Dedupe Collapse beacons that are listed with the same sequence of hops. The only supported value is `hops`. Of every group of such beacons, only the most recently updated one is listed, annotated with the number of collapsed duplicates.
+	Dedupe *BeaconQueryDedupe `form:"dedupe,omitempty" json:"dedupe,omitempty"`
+
+	// MinMtu Minimum path MTU of the beacons. Only beacons whose path MTU, i.e., the smallest MTU of the AS entries and of the links between them, is at least the given value are returned. If set, the path MTU is included in the response.
+	MinMtu *BeaconQueryMinMtu `form:"min_mtu,omitempty" json:"min_mtu,omitempty"`
+
+	// Snapshot Snapshot token as returned by `/snapshot`. Beacons are evaluated at the time of the snapshot, i.e., their validity and expiry are checked against it. Beacons that were refreshed since the snapshot are listed with their current content. The beacon store does not keep history, thus beacons that were removed since the snapshot are not restored.
+	Snapshot *BeaconQuerySnapshot `form:"snapshot,omitempty" json:"snapshot,omitempty"`
+
+	// Names Annotate the hops with the names of the local interfaces they are linked to, as derived from the topology of the local AS. Hops that the topology does not resolve are not annotated.
+	Names *BeaconQueryNames `form:"names,omitempty" json:"names,omitempty"`
+
+	// Score Attach a quality score to every beacon. The score is computed from the number of AS entries, the remaining validity and, if announced in the static info extension, the latency and bandwidth of the path, weighted as configured for the service.
+	Score *BeaconQueryScore `form:"score,omitempty" json:"score,omitempty"`
+
+	// Limit Maximum number of beacons that are listed, at most 1000. If set, the response includes the total number of matching beacons and, if more beacons match, a cursor for the next page. Use it together with `cursor` or `offset` to page through the sorted listing.
+	Limit *BeaconQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of matching beacons that are skipped in the sorted listing. If set, the response includes the total number of matching beacons. Mutually exclusive with `cursor`.
+	Offset *BeaconQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Cursor as returned in `next_cursor` of the previous page. The listing continues after the last beacon of the previous page, which is identified by its sort keys and its ID. Thus, beacons that are added, refreshed or removed in between do not shift the pages. The cursor pins the listing to the time of the first page like `snapshot`. The other query parameters, in particular `sort` and `desc`, must be the same as for the first page. Mutually exclusive with `offset`.
+	Cursor *BeaconQueryCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetBeaconAgeHistogramParams defines parameters for GetBeaconAgeHistogram.
+type GetBeaconAgeHistogramParams struct {
+	// Buckets Strictly increasing upper boundaries of the buckets, e.g. `1m,5m,15m,1h`.
+	Buckets *[]string `form:"buckets,omitempty" json:"buckets,omitempty"`
+}
+
+// GetBeaconHeartbeatParams defines parameters for GetBeaconHeartbeat.
+type GetBeaconHeartbeatParams struct {
+	// SilentAfter Age of the most recent beacon beyond which an origin is considered silent, e.g. `15m`.
+	SilentAfter *string `form:"silent_after,omitempty" json:"silent_after,omitempty"`
+}
+
+// GetBeaconSlaParams defines parameters for GetBeaconSla.
+type GetBeaconSlaParams struct {
+	// Window Window within which a beacon must have been updated to be considered fresh, e.g. `15m`.
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
+
+	// GroupBy Attribute by which the beacons are grouped.
+	GroupBy *GetBeaconSlaParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetBeaconSlaParamsGroupBy defines parameters for GetBeaconSla.
+type GetBeaconSlaParamsGroupBy string
+
+// GetBeaconStatsParams defines parameters for GetBeaconStats.
+type GetBeaconStatsParams struct {
+	// Top Maximum number of origin ASes that are listed in `top_origins`. If unset, the limit configured for the service is used, which is 10 by default.
+	Top *int `form:"top,omitempty" json:"top,omitempty"`
+}
+
+// ValidateBeaconsQueryParams defines parameters for ValidateBeaconsQuery.
+type ValidateBeaconsQueryParams struct {
+	// StartIsdAs Start ISD-AS of beacons. The address can include wildcards (0) both for the ISD and AS identifier. Alternatively, a pattern ending in a single `*` selects all beacons whose start ISD-AS, in its canonical string representation, starts with the part before the `*`, e.g., `1-ff00:0:1*`.
+	StartIsdAs *BeaconQueryStartIsdAs `form:"start_isd_as,omitempty" json:"start_isd_as,omitempty"`
+
+	// StartIsd Start ISD of beacons, regardless of the AS identifier. Must not be combined with start_isd_as.
+	StartIsd *BeaconQueryStartIsd `form:"start_isd,omitempty" json:"start_isd,omitempty"`
+
+	// Usages Minimum allowed usages of the returned beacons. Only beacons that are allowed in all the usages in the list will be returned.
+	Usages *BeaconQueryUsages `form:"usages,omitempty" json:"usages,omitempty"`
+
+	// IngressInterface Ingress interface id.
+	IngressInterface *BeaconQueryIngressInterface `form:"ingress_interface,omitempty" json:"ingress_interface,omitempty"`
+
+	// FromNeighbor ISD-AS of a neighbor AS. Only beacons received on one of the interfaces to this neighbor are returned. The neighbor must be configured in the topology. Must not be combined with ingress_interface.
+	FromNeighbor *BeaconQueryFromNeighbor `form:"from_neighbor,omitempty" json:"from_neighbor,omitempty"`
+
+	// ValidAt Timestamp at which returned beacons are valid. If unset then the current datetime is used. Must not be combined with `all=true`. A timestamp in the future lists the beacons that will still be valid at that time, e.g., to plan a maintenance window. To guard against clock or time zone errors, future timestamps are rejected unless `future_ok=true` is set, and the response carries a warning.
+	ValidAt *BeaconQueryValidAt `form:"valid_at,omitempty" json:"valid_at,omitempty"`
+
+	// FutureOk Acknowledge that `valid_at` is intentionally in the future.
+	FutureOk *BeaconQueryFutureOk `form:"future_ok,omitempty" json:"future_ok,omitempty"`
+
+	// All Include beacons regardless of expiration and creation time. Must not be combined with `valid_at`.
+	All *BeaconQueryAll `form:"all,omitempty" json:"all,omitempty"`
+
+	// ExpiredOnly Only include beacons that have expired, i.e., beacons of which an AS entry expired before the current time. This is intended for cleanup tooling. Must not be combined with `all=true` or `valid_at`, which select beacons by validity in a different way.
+	ExpiredOnly *BeaconQueryExpiredOnly `form:"expired_only,omitempty" json:"expired_only,omitempty"`
 
 	// Desc Whether to reverse the sort order (ascending by default).
-	Desc *bool `form:"desc,omitempty" json:"desc,omitempty"`
+	Desc *BeaconQueryDesc `form:"desc,omitempty" json:"desc,omitempty"`
+
+	// Sort Attributes by which results are sorted, as a comma-separated list of `field[:direction]` tokens. Later fields break ties of earlier ones. Supported fields are `expiration`, `timestamp`, `start_isd_as`, `last_updated`, `ingress_interface`, `isd_count` and `hop_count`. The direction is either `asc` (default) or `desc`. The value `start_isd_as` refers to the ISD-AS identifier of the first hop. The value `isd_count` refers to the number of distinct ISDs the hops traverse. The value `hop_count` refers to the number of hops. The value `expiration_time_desc` is a shorthand for `expiration:desc`.
+	Sort *BeaconQuerySort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// SignedWith Signature algorithm of the AS entries. Only beacons with at least one AS entry signed with the given algorithm are returned. If set, the signature algorithms of all AS entries are included in the response.
+	SignedWith *BeaconQuerySignedWith `form:"signed_with,omitempty" json:"signed_with,omitempty"`
+
+	// LoopsOnly Only return beacons that contain a loop, i.e., beacons in which multiple AS entries have the same ISD-AS identifier. Such beacons indicate a misconfiguration.
+	LoopsOnly *BeaconQueryLoopsOnly `form:"loops_only,omitempty" json:"loops_only,omitempty"`
+
+	// Explain Debugging aid. If set, no beacons are returned. Instead, the response describes how the server interpreted the query parameters.
+	Explain *BeaconQueryExplain `form:"explain,omitempty" json:"explain,omitempty"`
+
+	// Expand Comma-separated list of optional fields that are added to each beacon. The value `class` adds the classification of the beacon as core or non-core.
+	Expand *BeaconQueryExpand `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Dedupe Collapse beacons that are listed with the same sequence of hops. The only supported value is `hops`. Of every group of such beacons, only the most recently updated one is listed, annotated with the number of collapsed duplicates.
+	Dedupe *BeaconQueryDedupe `form:"dedupe,omitempty" json:"dedupe,omitempty"`
+
+	// MinMtu Minimum path MTU of the beacons. Only beacons whose path MTU, i.e., the smallest MTU of the AS entries and of the links between them, is at least the given value are returned. If set, the path MTU is included in the response.
+	MinMtu *BeaconQueryMinMtu `form:"min_mtu,omitempty" json:"min_mtu,omitempty"`
+
+	// Snapshot Snapshot token as returned by `/snapshot`. Beacons are evaluated at the time of the snapshot, i.e., their validity and expiry are checked against it. Beacons that were refreshed since the snapshot are listed with their current content. The beacon store does not keep history, thus beacons that were removed since the snapshot are not restored.
+	Snapshot *BeaconQuerySnapshot `form:"snapshot,omitempty" json:"snapshot,omitempty"`
 
-	// Sort Attribute by which results are sorted. The value `start_isd_as` refers to the ISD-AS identifier of the first hop.
-	Sort *GetBeaconsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	// Names Annotate the hops with the names of the local interfaces they are linked to, as derived from the topology of the local AS. Hops that the topology does not resolve are not annotated.
+	Names *BeaconQueryNames `form:"names,omitempty" json:"names,omitempty"`
+
+	// Score Attach a quality score to every beacon. The score is computed from the number of AS entries, the remaining validity and, if announced in the static info extension, the latency and bandwidth of the path, weighted as configured for the service.
+	Score *BeaconQueryScore `form:"score,omitempty" json:"score,omitempty"`
+
+	// Limit Maximum number of beacons that are listed, at most 1000. If set, the response includes the total number of matching beacons and, if more beacons match, a cursor for the next page. Use it together with `cursor` or `offset` to page through the sorted listing.
+	Limit *BeaconQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of matching beacons that are skipped in the sorted listing. If set, the response includes the total number of matching beacons. Mutually exclusive with `cursor`.
+	Offset *BeaconQueryOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Cursor as returned in `next_cursor` of the previous page. The listing continues after the last beacon of the previous page, which is identified by its sort keys and its ID. Thus, beacons that are added, refreshed or removed in between do not shift the pages. The cursor pins the listing to the time of the first page like `snapshot`. The other query parameters, in particular `sort` and `desc`, must be the same as for the first page. Mutually exclusive with `offset`.
+	Cursor *BeaconQueryCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetBeaconParams defines parameters for GetBeacon.
+type GetBeaconParams struct {
+	// IncludeBlob Include the raw beacon in the response as `raw`, such that a separate request to `/beacons/{segment-id}/blob` is not needed. Only applies to the JSON and CBOR representations.
+	IncludeBlob *bool `form:"include_blob,omitempty" json:"include_blob,omitempty"`
+
+	// IncludePeers Include the peer entries of the AS entries in the hops of the beacon and tag every hop with its `type`. Only applies to the JSON and CBOR representations.
+	IncludePeers *bool `form:"include_peers,omitempty" json:"include_peers,omitempty"`
 }
 
-// GetBeaconsParamsSort defines parameters for GetBeacons.
-type GetBeaconsParamsSort string
+// GetCaParams defines parameters for GetCa.
+type GetCaParams struct {
+	// Debug Debugging aid. If set, the response carries a `Server-Timing` header that breaks down the time spent generating the CA policy and extracting the ISD-AS.
+	Debug *bool `form:"debug,omitempty" json:"debug,omitempty"`
+}
 
 // GetCertificatesParams defines parameters for GetCertificates.
 type GetCertificatesParams struct {
 	IsdAs   *IsdAs     `form:"isd_as,omitempty" json:"isd_as,omitempty"`
 	ValidAt *time.Time `form:"valid_at,omitempty" json:"valid_at,omitempty"`
 	All     *bool      `form:"all,omitempty" json:"all,omitempty"`
+
+	// IncludeSuperseded Include chains that were valid before the requested point in time, even if they have expired since. The returned chains are marked as superseded if a newer chain for the same AS is part of the result.
+	IncludeSuperseded *bool `form:"include_superseded,omitempty" json:"include_superseded,omitempty"`
+
+	// ExpiringBetween Start and end of a time window, separated by a comma. Only chains whose AS certificate expires within the window, both ends included, are returned. The start must not be after the end.
+	ExpiringBetween *[]time.Time `form:"expiring_between,omitempty" json:"expiring_between,omitempty"`
+}
+
+// GetHealthParams defines parameters for GetHealth.
+type GetHealthParams struct {
+	// Wait Long-poll duration, e.g. `30s`. If set, the request is held open until the status of a health check changes or the duration elapses, whichever comes first. The duration must not exceed 5 minutes.
+	Wait *string `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// Status Only list the health checks with one of the given statuses. The overall status still reflects all health checks.
+	Status *[]Status `form:"status,omitempty" json:"status,omitempty"`
+
+	// Check Only report the health check with the given name. The overall status and the HTTP status code are derived from that check alone. An unknown name results in a bad request.
+	Check *string `form:"check,omitempty" json:"check,omitempty"`
+
+	// Checks Only evaluate the health checks of the given groups, e.g., `signer` and `trc`. The overall status is derived from the evaluated checks alone. Supported groups are `signer`, `trc`, `ca`, `beacons` and `certificates`. An unknown group results in a bad request.
+	Checks *[]string `form:"checks,omitempty" json:"checks,omitempty"`
+}
+
+// GetHealthChangesParams defines parameters for GetHealthChanges.
+type GetHealthChangesParams struct {
+	// Since Only report checks that changed after this time. Pass the `timestamp` of the previous response to poll for subsequent changes.
+	Since time.Time `form:"since" json:"since"`
+
+	// Wait Long-poll duration, e.g. `30s`. If set and no check changed, the request is held open until a check changes or the duration elapses, whichever comes first. The duration must not exceed 5 minutes.
+	Wait *string `form:"wait,omitempty" json:"wait,omitempty"`
 }
 
 // GetSegmentsParams defines parameters for GetSegments.
@@ -296,6 +1210,30 @@ type GetSegmentsParams struct {
 
 	// EndIsdAs Terminal AS of segment.
 	EndIsdAs *IsdAs `form:"end_isd_as,omitempty" json:"end_isd_as,omitempty"`
+
+	// GroupBy Group the segments by their type. If set to `type`, the response is an object with the up, core and down segments instead of a flat list.
+	GroupBy *GetSegmentsParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetSegmentsParamsGroupBy defines parameters for GetSegments.
+type GetSegmentsParamsGroupBy string
+
+// GetSignerParams defines parameters for GetSigner.
+type GetSignerParams struct {
+	// All List all currently valid signers sorted by expiration, the soonest to expire first, instead of only the last expiring one.
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
+}
+
+// GetSignersParams defines parameters for GetSigners.
+type GetSignersParams struct {
+	// Sort Attributes by which results are sorted, as a comma-separated list of `field[:direction]` tokens. Later fields break ties of earlier ones. Supported fields are `expiration` and `not_before`. The direction is either `asc` (default) or `desc`.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Maximum number of signers to return.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of signers to skip before the first returned signer.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
 // GetTrcsParams defines parameters for GetTrcs.
@@ -304,5 +1242,17 @@ type GetTrcsParams struct {
 	All *bool  `form:"all,omitempty" json:"all,omitempty"`
 }
 
+// GetTrcDiffParams defines parameters for GetTrcDiff.
+type GetTrcDiffParams struct {
+	// A Identifier of the first TRC, in the form isd{isd}-b{base}-s{serial}.
+	A string `form:"a" json:"a"`
+
+	// B Identifier of the second TRC, in the form isd{isd}-b{base}-s{serial}.
+	B string `form:"b" json:"b"`
+}
+
+// ReconcileBeaconsJSONRequestBody defines body for ReconcileBeacons for application/json ContentType.
+type ReconcileBeaconsJSONRequestBody = BeaconReconcileRequest
+
 // SetLogLevelJSONRequestBody defines body for SetLogLevel for application/json ContentType.
 type SetLogLevelJSONRequestBody = LogLevel
@@ -25,6 +25,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
+	"github.com/scionproto/scion/control/mgmtapi"
 	cstrust "github.com/scionproto/scion/control/trust"
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/metrics"
@@ -68,6 +69,8 @@ type Metrics struct {
 	BeaconingRegistrarInternalErrorsTotal  *prometheus.CounterVec
 	CAHealth                               *prometheus.GaugeVec
 	DiscoveryRequestsTotal                 *prometheus.CounterVec
+	MgmtAPIRequestsTotal                   *prometheus.CounterVec
+	MgmtAPIRequestDurationSeconds          *prometheus.HistogramVec
 	PathDBQueriesTotal                     *prometheus.CounterVec
 	RenewalServerRequestsTotal             *prometheus.CounterVec
 	RenewalHandledRequestsTotal            *prometheus.CounterVec
@@ -195,6 +198,21 @@ func NewMetrics() *Metrics {
 			},
 			discovery.Topology{}.RequestsLabels(),
 		),
+		MgmtAPIRequestsTotal: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "control_mgmtapi_requests_total",
+				Help: "Total number of management API requests handled.",
+			},
+			mgmtapi.RequestsLabels(),
+		),
+		MgmtAPIRequestDurationSeconds: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "control_mgmtapi_request_duration_seconds",
+				Help:    "Time to handle a management API request.",
+				Buckets: prom.DefaultLatencyBuckets,
+			},
+			mgmtapi.RequestsLabels(),
+		),
 		PathDBQueriesTotal: promauto.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "pathdb_queries_total",
@@ -435,7 +453,8 @@ func caStatusPage(signer renewal.ChainBuilder) service.StatusPage {
 			NotAfter  time.Time `json:"not_after"`
 		}
 		type Policy struct {
-			ChainLifetime string `json:"chain_lifetime"`
+			ChainLifetime        string `json:"chain_lifetime"`
+			ChainLifetimeSeconds int    `json:"chain_lifetime_seconds"`
 		}
 		rep := struct {
 			Subject      Subject  `json:"subject"`
@@ -446,7 +465,8 @@ func caStatusPage(signer renewal.ChainBuilder) service.StatusPage {
 			Subject:      Subject{IA: ia},
 			SubjectKeyID: fmt.Sprintf("% X", s.Certificate.SubjectKeyId),
 			Policy: Policy{
-				ChainLifetime: s.Validity.String(),
+				ChainLifetime:        s.Validity.String(),
+				ChainLifetimeSeconds: int(s.Validity / time.Second),
 			},
 			CertValidity: Validity{
 				NotBefore: s.Certificate.NotBefore,
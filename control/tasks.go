@@ -352,4 +352,9 @@ type Store interface {
 	UpdatePolicy(ctx context.Context, policy beacon.Policy) error
 	// MaxExpTime returns the segment maximum expiration time for the given policy.
 	MaxExpTime(policyType beacon.PolicyType) uint8
+	// Policies returns the policies that are currently in effect.
+	Policies() []beacon.Policy
+	// SelectedBeacons returns the beacons that are currently selected by the
+	// policies, together with the reason each beacon was selected.
+	SelectedBeacons(ctx context.Context) ([]beacon.Selection, error)
 }
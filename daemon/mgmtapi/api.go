@@ -60,6 +60,7 @@ func (s *Server) GetSegments(
 	p := segapi.GetSegmentsParams{
 		StartIsdAs: params.StartIsdAs,
 		EndIsdAs:   params.EndIsdAs,
+		GroupBy:    (*segapi.GetSegmentsParamsGroupBy)(params.GroupBy),
 	}
 	s.SegmentsServer.GetSegments(w, r, p)
 }
@@ -84,9 +85,11 @@ func (s *Server) GetCertificates(
 ) {
 
 	cppkiParams := cppkiapi.GetCertificatesParams{
-		IsdAs:   params.IsdAs,
-		ValidAt: params.ValidAt,
-		All:     params.All,
+		IsdAs:             params.IsdAs,
+		ValidAt:           params.ValidAt,
+		All:               params.All,
+		IncludeSuperseded: params.IncludeSuperseded,
+		ExpiringBetween:   params.ExpiringBetween,
 	}
 	s.CPPKIServer.GetCertificates(w, r, cppkiParams)
 }
@@ -124,3 +127,8 @@ func (s *Server) GetTrc(w http.ResponseWriter, r *http.Request, isd int, base in
 func (s *Server) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, base int, serial int) {
 	s.CPPKIServer.GetTrcBlob(w, r, isd, base, serial) // nolint - name from published API
 }
+
+// GetTrcDiff compares two TRCs and lists their differences.
+func (s *Server) GetTrcDiff(w http.ResponseWriter, r *http.Request, params GetTrcDiffParams) {
+	s.CPPKIServer.GetTrcDiff(w, r, cppkiapi.GetTrcDiffParams(params))
+}
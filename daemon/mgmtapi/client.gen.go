@@ -127,6 +127,9 @@ type ClientInterface interface {
 	// GetTrcs request
 	GetTrcs(ctx context.Context, params *GetTrcsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetTrcDiff request
+	GetTrcDiff(ctx context.Context, params *GetTrcDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTrc request
 	GetTrc(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -290,6 +293,18 @@ func (c *Client) GetTrcs(ctx context.Context, params *GetTrcsParams, reqEditors
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetTrcDiff(ctx context.Context, params *GetTrcDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrcDiffRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetTrc(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetTrcRequest(c.Server, isd, base, serial)
 	if err != nil {
@@ -384,6 +399,38 @@ func NewGetCertificatesRequest(server string, params *GetCertificatesParams) (*h
 
 		}
 
+		if params.IncludeSuperseded != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_superseded", runtime.ParamLocationQuery, *params.IncludeSuperseded); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiringBetween != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "expiring_between", runtime.ParamLocationQuery, *params.ExpiringBetween); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -638,6 +685,22 @@ func NewGetSegmentsRequest(server string, params *GetSegmentsParams) (*http.Requ
 
 		}
 
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -816,6 +879,63 @@ func NewGetTrcsRequest(server string, params *GetTrcsParams) (*http.Request, err
 	return req, nil
 }
 
+// NewGetTrcDiffRequest generates requests for GetTrcDiff
+func NewGetTrcDiffRequest(server string, params *GetTrcDiffParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trcs/diff")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "a", runtime.ParamLocationQuery, params.A); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "b", runtime.ParamLocationQuery, params.B); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetTrcRequest generates requests for GetTrc
 func NewGetTrcRequest(server string, isd int, base int, serial int) (*http.Request, error) {
 	var err error
@@ -993,6 +1113,9 @@ type ClientWithResponsesInterface interface {
 	// GetTrcsWithResponse request
 	GetTrcsWithResponse(ctx context.Context, params *GetTrcsParams, reqEditors ...RequestEditorFn) (*GetTrcsResponse, error)
 
+	// GetTrcDiffWithResponse request
+	GetTrcDiffWithResponse(ctx context.Context, params *GetTrcDiffParams, reqEditors ...RequestEditorFn) (*GetTrcDiffResponse, error)
+
 	// GetTrcWithResponse request
 	GetTrcWithResponse(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*GetTrcResponse, error)
 
@@ -1159,9 +1282,11 @@ func (r SetLogLevelResponse) StatusCode() int {
 }
 
 type GetSegmentsResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *[]SegmentBrief
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		union json.RawMessage
+	}
 	ApplicationproblemJSON400 *Problem
 }
 
@@ -1231,6 +1356,7 @@ type GetSegmentBlobResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
 	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON406 *Problem
 }
 
 // Status returns HTTPResponse.Status
@@ -1272,6 +1398,30 @@ func (r GetTrcsResponse) StatusCode() int {
 	return 0
 }
 
+type GetTrcDiffResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TRCDiff
+	JSON400                   *BadRequest
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrcDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrcDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetTrcResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1433,6 +1583,15 @@ func (c *ClientWithResponses) GetTrcsWithResponse(ctx context.Context, params *G
 	return ParseGetTrcsResponse(rsp)
 }
 
+// GetTrcDiffWithResponse request returning *GetTrcDiffResponse
+func (c *ClientWithResponses) GetTrcDiffWithResponse(ctx context.Context, params *GetTrcDiffParams, reqEditors ...RequestEditorFn) (*GetTrcDiffResponse, error) {
+	rsp, err := c.GetTrcDiff(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrcDiffResponse(rsp)
+}
+
 // GetTrcWithResponse request returning *GetTrcResponse
 func (c *ClientWithResponses) GetTrcWithResponse(ctx context.Context, isd int, base int, serial int, reqEditors ...RequestEditorFn) (*GetTrcResponse, error) {
 	rsp, err := c.GetTrc(ctx, isd, base, serial, reqEditors...)
@@ -1676,7 +1835,9 @@ func ParseGetSegmentsResponse(rsp *http.Response) (*GetSegmentsResponse, error)
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []SegmentBrief
+		var dest struct {
+			union json.RawMessage
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1689,6 +1850,9 @@ func ParseGetSegmentsResponse(rsp *http.Response) (*GetSegmentsResponse, error)
 		}
 		response.ApplicationproblemJSON400 = &dest
 
+	case rsp.StatusCode == 200:
+		// Content-type (application/cbor) unsupported
+
 	}
 
 	return response, nil
@@ -1755,6 +1919,9 @@ func ParseGetSegmentResponse(rsp *http.Response) (*GetSegmentResponse, error) {
 		}
 		response.ApplicationproblemJSON400 = &dest
 
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-protobuf) unsupported
+
 	}
 
 	return response, nil
@@ -1781,6 +1948,13 @@ func ParseGetSegmentBlobResponse(rsp *http.Response) (*GetSegmentBlobResponse, e
 		}
 		response.ApplicationproblemJSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 406:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON406 = &dest
+
 	}
 
 	return response, nil
@@ -1819,6 +1993,46 @@ func ParseGetTrcsResponse(rsp *http.Response) (*GetTrcsResponse, error) {
 	return response, nil
 }
 
+// ParseGetTrcDiffResponse parses an HTTP response from a GetTrcDiffWithResponse call
+func ParseGetTrcDiffResponse(rsp *http.Response) (*GetTrcDiffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrcDiffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TRCDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetTrcResponse parses an HTTP response from a GetTrcWithResponse call
 func ParseGetTrcResponse(rsp *http.Response) (*GetTrcResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -18,59 +18,87 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaWXPjNhL+K11MHjYV6vKxifWmkTUTVeZwWUq2KrHXBZEtETMkwACgbK1X/30LAEnx",
-	"kkV5JrPO1k7Ng0UCjcaHrxt98NHxeBRzhkxJZ/joCJQxZxLNj1fEv8Y/EpRK//I4U8jMnySOQ+oRRTnr",
-	"fZSc6WfSCzAi+q9vBS6dofNNbye6Z9/K3kwR5hPhT4Tgwtlut67jo/QEjbUwZ6jXBJEuqt+mE7XcMQpF",
-	"l3pd1D9jwWP9xOrqU6koWyVUBujfMRKZMWoTozN0pBKUrZyt61Dp31lpT2k5lf5I6uEyWXxET919ws0d",
-	"CVdcT8QHEsWhFjsZX85GjltfpTiN+gcxsaN/xs30Us9ek5D6VG0Ozfs1G6dx0phRgb4z/L0Ji3znBfEN",
-	"26upfus6iiqz2wL8UDyzfP/czNQ7GAeEsvoZUSkTFIe2VTzmHZZHzargkYlwMw327MrTarfa2ytBtSq1",
-	"DR48azPbHnM7NKpUbD3+s1lE/R0ddtAVBBdQNHiA9ywsp5dlq1qS81PSPyOO6yy5iIhyhk6AD53UvJ46",
-	"uqmPTD8yetas8iceNxwZUyiWxMOSEmcn+Xw9YIXiaOdRRTMzv92CBfyuiApA4ipCpiDgcRNYVm4JqkFn",
-	"uez3h/3hYNB3XCcmSqHQXvSfNzf+952//U46y37n4vZx4J5th989nmzLj777tx73bQHT6eyyM5odAPIt",
-	"X73FNYZ1NMPscdmpv+WrFWUrsK9dB1kSGUeFi2RlMFlq34PmUrh1CztM31RUqGBrxd42YHYl+CLEqOG6",
-	"QEVog6YjCJKIMBBIfLIIEfAhDgkzVx3IGD1NOFAcVEAlcM9LhEDmIfAlqAAhtguCCogCKiHAMF4moZ4R",
-	"csPU4ijCfFjRNQLx11QLYRDwez04FtxD9LvwD0GVQgaUwYStQioDMyvXb8kFIFtRhiikC4lMSBhugHEF",
-	"MqEKfTOCcQYKvYBRj4QgFfmEAQ99FNJI06O1eiH9F/pdp3gAY84Yemb7ioNPFFkQiaBohD7wRDXxgzKp",
-	"CLMmVYX3l+spCFyiRc3ClJFNGnBylPei6wJ2V11YbID4vuYVgaUg1nhyYQK4AJksOrG2LXNihePZxNiF",
-	"d2QDC4REol85IMG5sotSmU+izOrHE+EheNzHMlS9dGDPyzHrGEp/o/gnZB3N5Y4+uI5Br2PRy31cImgn",
-	"R6YxqlBEJbIO6jxA+Gk+vwI7wGgGK2QoiD7/xcaozQVdUQYSxRqFIcXTFC7t7bx/6joReaCRNtzziwvX",
-	"iSizvwb9fpOzTD1KnQEy4EKTM4qI2NTsxhzMf5v0MxTGHn9hZE1oqNdsOhD7QO9wSZJQnyFZ8EQNFyFh",
-	"nxy3DfcTRv9IMNxUjaCIB3CmB1j2mSj8QRVwW1MffRhdTbvwIY55SuaiJVnvRRlcvx53fvix/4ML1Hgn",
-	"hlQFKECgx6MImW/nLnSAlylqANd4xZwypV8T6yM7+XH43Eu08dl1GBewCvnCHIndX0q3yjG3M54jTKQa",
-	"9ll7yajYdD/M7JVbvx/wIaaC2JN73CngE4XGepvoEPDYhhQKo4NRgg5Gcgo5RAiyMa7zcLZgVbYxZEik",
-	"uktirZbfXlH9XCoSxW2nNEWGOyFuEa2KTikqhVBnNp5+eA9xMeA5ECWmO94TcyPz747M6o4FGdlKBQ1R",
-	"jXmeWWK6mRKrB02OUSoi1N1nxZImMi+KcYsw5BrXAvRnY1+L0Rdn5/7ZmX8wRk/nHwgoy1WB+hFnj8v4",
-	"m9EQoZRkdZi0eXBZ32Mx/y5t88cLeHUBZxcwPoGT1/r/xRguL6F/CScjOP8BRhdwOYEfJ+bVObw+hf4F",
-	"DPpwOSgiI2Piod8pA1TFYH49ru+cJCrggmrPusY7ktZlWjmYnO1VF+Nx8aVElc6jqdpy0NDm1+MvVPQw",
-	"RlGobey26TbBWFa+YCnz6/Eho5hfj59dAEg3XFe+ZqztFLGULWuhI/Q7lkQLW1542h1R6bdIfSUKSsIm",
-	"oaf14fXU13FLSlXlVeBvcha7Tf9aYEp534yrO7JUFQWdk/7JSac/6PTP5v2L4fnF8PT0t6J5PnlXapkL",
-	"XHKBNaGDZwqtwFNYwS1soYBJtmOIUVDu10HZbtMcuuYjs0h2dDXNgzB7C1wSjCyrShezfazHa3NCIa2c",
-	"frffHWg8eIyMxNQZOqfdfvfEVh0CA3+vUP8xD1aoGm5NKpWNZE3eocINEE/bZb18JG2MTATCJ8bvWRrX",
-	"3jAdBAsemmSGetgFnQIJlEmowCNMB7BLGioUNv2xRY0uvE6EDncjLtC9YZyhGRwTKYFATISiXhISkUa6",
-	"OuCmEQJRcB9QL7BK73S8YamSWj/jeIBIoCxOVBdGsOA8RMIyffJAXXEQqBLBgIThDSti5oLAFRF+iFKm",
-	"YQUV6aHr3zoXMUTo3uiD09Q3QdfUd4bOG1TjIv76YASJUKGQzvD3R4dq9P9IUGjvaAvku6pUu+p9Ho40",
-	"SzMg3BFVktfOIpoFkjAsyUqnpdA62+2tW+5YnPT7R7UqWl1/hYpv7Q6sNzAMv3lDMdTcoGdPKpjmQN8f",
-	"11PJilwNykyZJWapo2Iz75Ip1nV1HUVWmjiOF8efqHOrp5YsvPdohnaov91r7G9wzwLGGRFT/GKQloEP",
-	"s3oPqbUH2pEm08opulklEmzL8rxG/9n0OrhK05nVytovjjd7T/U41vQWIV88gzrIPK49KZFwNXkHi41C",
-	"CVrW80j1Smvxoon10Ikx6ixpWIlBOvrfq8mb6XsYT67n09fT8Wg+MU9v2GhWJFK3271h5s3k/WXD6CdF",
-	"jUfHiHJaUNoc11+H11bdPeTmbElXBRrXuWZHHDxyhQ+qF4dp67R26+WXZW1Xs8TzUMplEsKHbPECuE1Y",
-	"5ar0Ck3+MhpXgjJlq5HzD+/egt1oYsXr+MpU8HJIeKTDSYtJFovuQ2Rquzp/LTxeEUk90FvTAY3GICYr",
-	"BFPyzUuzhajU9nCk3ItSyFe9vGG2D6q81/YnXkX5Gl8NS21pYaUpWMPIdeKkAZRZBRQj/xX3N18Fj6yV",
-	"WVx/dxNs/6dOadbmlDST02Jii6SvXoHck+Q15XayKbkzYxURyjQpkPkwmlVrsjBlMkbPqkCZT9fUT0i4",
-	"U8EGDjoxBNsZRh/WFO+7TbHDLNttLWioHIrRKu2o82Vjhbjawm/KgiqV3qNTtUq3EEVEGQnhCaVOMqVO",
-	"9ipVqjcfp9JXSdpKTYMj0raIKC/QhG9gavflZnAN2haMNX1UsdbeY/pXlsL5GKJq6Nlemud71oF7qmxt",
-	"xMbd2ePpZd14rKCs6XbAfOY7A4bpZd7wLCzdhekyNek4UbAmYYJApe0wo9COhQEpCMn6nh5nkvrGgxCI",
-	"BS7pg/EeJAx3BCg7KWJcg1bf1y6JSi0nkahdrvYe5l192oJI9IGztJaTeVOtii0qURthDU5MHpMpk26W",
-	"eKrgpiDLZvAhDrmPznBJQoluU66yO9lnZyulHphUG+MZJDUuosGGzxqKj03tJgPhSzAk1zn/2hooFNrz",
-	"zuw3GNk3sEWDftLUGi3afTp9LjzVrCK7z2vq8p+67erW+iJZ+OXCrWzfTdFWndeFtKD7YvPaw+3f9vdF",
-	"u+JNw4p7qzdPsa+5RvOXY2CLQs7VaP4TzCZv3k3ez9OCigHxiqgga6aXKzANM5xWnH3RNZh9+u4jqRJe",
-	"i/QjJAqlSoXPRSIVXHOuYFysbdh0AIkX6OB9T3pyfAuqCx+MPiQMN64JNebX4zylSdFAHyiTColp+JgP",
-	"vwp6c4ay0Uzmevft7KPeASoF8Hlw3fBFX6X7n9mC9nzOy27h5B37IzKBdNlFiOagup+fT+c01PL2lBM1",
-	"j3tU+o9U+tvO4lEHkNuOfLQN821Lj7uP2nuq4XPhtaqAW7Lsd6NPfkSQM6QsU2+wndBBa5kWrHZSm75f",
-	"+DPjivn1uIl18+tx98vU1VKCPY9fx1zr+0iWXe3ZTW8SG3PD72Vf6x7M/xn4zLhifj1Og4PfPo7uP3wc",
-	"/f3dfHI/rcQSu1FOI0WrMcPn03Rva8V+dLTOuJCI0Bk6gVLxsNd7DLhU2+FjzIXa9khMe+uB+fpLUO2v",
-	"DWJ6SPnjbPOxt3m8dR09tfz6dDA4P9GmeZtrU+X/2BQ9zcc0+GA/tV5sUmtIAwFzP2cNQ1sjrdfgJmsU",
-	"G2WqDAJD85W+4s0Vp2oke6S08dXVz1OIiDJ8LOpmcN7ebv8TAAD//yDXdgQCOgAA",
+	"H4sIAAAAAAAC/+x8bXPbNrbwX8Fw90M7S8mS7WxrfVNkp9W0SfzY6nZm6zw2SB5JaEiABUDbur7673cO",
+	"wHdCFuVk0/TO7fRDRAIHB+f9jX7yQpGkggPXyps8eRJUKrgC8+M1ja7gjwyUxl+h4Bq4+SdN05iFVDPB",
+	"j35XguMzFa4hofivv0tYehPvb0cV6CP7Vh1da8ojKqMLKYX0ttut70WgQslSBOZN8Ewi80Pxbb4R4c5A",
+	"arbEcwF/plKk+MTiGjGlGV9lTK0huuU0MWv0JgVv4iktGV95W99jKrqlah+WcxVNFS5XWfA7hPr2I2xu",
+	"abwSuBEeaZLGCPZidn499fzuKfVtLNpLE7v6J9jMz3H3PY1ZxPRm375/FeuQTkgzJiHyJr+5aFHevAbe",
+	"cb0O6h98TzNtblsjP6nzrLy/MDvxBrM1ZbzLI6ZUBnLftepsrmh50K4WPQoQfoHBjluFiHavu72WDJaO",
+	"C+7ltdlt2dyPGm1RPGB9ClJBBAanppL9uga9Bkko4fAAMr/4Ukii10AUTYBMrwk8MqXVkLzn8YakEhRw",
+	"TdiSVJDtRkUeQEKhtRANK7IFQsRA+WeRahZ5fpeVNcA1rhr+kPBFvJ2fN7V8SV+d0NEp9XxvKWRCtTfx",
+	"1vA4yNX9OVGaR8DxEcjqtMpK/CjSrggFlEcPLNLrLtdeF68I4+SngOkjRSjnIuMhRPjMME9TzULC+FIQ",
+	"eNTAFRMVaxnXIJc0BCKW5sFapD55YHpdcT4ByhlfEarIXUw18HBzNyTToGA/04QpwoWuDkeOlwQbj8x/",
+	"5YXxzBVII/LF8Q0Knx471x5mqXNMu1T72b5A+iQsjpmCUPDos1BuSN4ISSgnjK8kKFUt8nMq4cIcM6LX",
+	"UmQrS+jpNdHC/AtaO0uY0ANkjkrM+MdDOHQ6fFWT5UhkQQyVgPIsCSwLEp116fl28Uv9XEL1M5JFHXRG",
+	"y8K1ZKCKpQpWCXB9kJCdfueUmsLxN3F+h3JdIC1CGtfQ1etnboBI4C0hIlqY60Qg2T1EZClFYlZpkYpY",
+	"rDZN+NPrrt0sDSShPCLMLi+3S1AivgfVxKVxaS+Q48FyORrdjm7H49FgXMP5mHwTrlkcfeuyNCmAvG0o",
+	"X5M+8xLM/Ly4B+5BM2CYzHjj2fSa3FmYRknvWndFnTHv75CGqnGHExfXarB6K7yF0r7JlYib/ONNCbsL",
+	"hYS7Qo/aOlbsxEVGR1E2hGQrxinyrQbKJ3e51t8Zdt5B8UNCk4Wqjk9L8oVxw5VG+BaWpR1TNYFq4Eib",
+	"3DFospaq2TUG8OYZWTTM4VmCHhav7aGVNlfBF8U/EJT3ocbG6lVL2NpOu4g6K+mruelLqtcFQZE8Lp9s",
+	"Gd7wyFYJJqPJeDxC5KjWIJH7///mJvrH4Jvf6GA5Gpx9eBr7p9vJt0/H2+ajb/8b1/295rrn1+eD6fUe",
+	"f/2zWP0M9xB3nXZcPG65HrFaGSaZ1xWdIwiylaHJUuBjkws1qJu/eZ62FuwHB80upQhiSBxZEmjKHJhO",
+	"yTpLKCcSaESDGAg8pjHlJsMjKoUQ4xrrspgiIgwzKYFXCpPaA0tJXEOcLrMYd6D4amisQhlfsXsgNLpn",
+	"CISTtXjAxakUIUA0JL9KpjWgZyUXfBUztTa7SvzQxABfMQ4glU8yldE43hiHoTKGuooruOBEQ7jmDFVI",
+	"afoR1iKOQCoDDVcb7WL/1XIv3kxwDqG5vhYkopoGVAHRLIGIiEy75INxpSl32dcp+eVqTiQswVLNkqkQ",
+	"NqvmJZV3UtcnMFwNSbAhNIpMhEaWklrlKYFJIiRRWTBIUbe0qAMgiPKQvKUbEgDJlPFqdQZJIbQ9lKly",
+	"U2FCRSZDNI1Ryykd5QuPwpJmAyPSf9PiI/AByvIAGTcw1BtY6pXhRybZoKSMM5nWVGeqS9TFGsiPi8Ul",
+	"sQsMZmQFHKSx1cHGWlhjvYkCeQ8yj+aeE+HG3V6NTnwvoY8sQcV9dXbmewnj9tfYHeLmFqUrAWotJApn",
+	"klC56eiNYcyfLfTXII0+/sLpPWUxDWInQyrPu6RZjDykgcj0JIgp/+j5fWQ/4+yPDOJNWwnq9CACfVYu",
+	"fab49KhrdLtnmIBOL+dD8j5NRS7MdU2y1otxcvVmNvju+9F3RQjNgRm/KyEUSQI8snsDwOguR9QQHOmV",
+	"CsY1vqbWRg5KdkQizFD57DlcSLKKRWBYYu9XJg8NNvdTngNUpF3tsPpSiKLLP1xbl9v1D/CYMkkt555q",
+	"KQLVYLTXJQ4Y4uFqpiHZG75hzluKkEelpBv83aNIZlG2pZOYKn2bpYhW1B/RlEoFtw9UYnrrMCi511QE",
+	"eCgyrkFCRB7WDFkNoTAmt3cCQ+O4vtBUSAwUiAheLwYN8QaFoaRbJRX5xg0Zk2/qwc63E5IwpRARDCaX",
+	"DOJot4ZW5EWKKE2TtC+xXKWXCohfl5MWN3J5qAV517P5+3ckrYd6e8owOa93FNmAR4fmCoeKF/CVqwDz",
+	"s3neZnojLXW5BKWp1Ieh7KJ/A4xfJ0OJcacC9mLad4pgwemr6PQ02lsEy/fvCaXzVer1ZpE7kyaPTSbS",
+	"16Y0xMUh/ZF44J8NWJZ+JlAtFmeoVnkCZhB+VoMUWUmRpXmYg3BdrGz0Wrp6VDxuCrlZTRJQiq72W4Yy",
+	"d+meXu9qNGTp+zPy+oycnpHZMTl+g/+fzcj5ORmdk+MpefUdmZ6R8wvy/YV59Yq8OSGjMzIekfNxXfxU",
+	"SkOIBk0pbAva4mrWvTnN9FpIho77Hm5p3u3qxdNW+aGSC2TdZwLV4Ierh7XXmi2uZp+plWQsT61jVF3T",
+	"d5GxiXxNhBdXs32WZ3E1e3FbJb9wF/mOReyHyJ/cajy8Z1jE5JWWSVhlMZWDe6F36MYnC0dueJztxh1d",
+	"xiZLkBlh/7ZikzGzNeUrB3toD2Fp9RiDQ7e0CEE9hPFhL8rqnC0d8k0jZ5euvtGmTVQ2syLby0CZbgSS",
+	"h12+Y8kMXffh0+wZIQyTLTVkgXDzDjGP2HIJkgSgHwAs8ourmXoh2jnrHchLSMT9y4i5ZFLpz0rLtpQY",
+	"Nlc4VqTe1WVnyzzpUxXlHoSh3A792CFgvR1G0HtlTW8PJJTVgq3v/ZEJmSU9Nv8/s7Diel/LtbiaFcar",
+	"2OzU3NZtauw4P5wF8/MuA7B4eJs31iZPe/IFpqIe/VEFktHYBdTRYulW5T2/gVQbXstIu6L5xqUbHHLL",
+	"X4mf8zrBgVd41uS2mH64PlQur4dK7PSPu3H8V02Am6hxoW/pUrdY6h2Pjo8Ho/FgdLoYnU1enU1OTv5d",
+	"D4afLXwgzACWeU7VADp+IdDWTWsn+LUr1KSouDFJQTIRdcVou80bIh3TXZQlp5fzsqJmU6JzCokNFRqZ",
+	"kn2M6zEQAaksnNFwNBwjPUQKnKbMm3gnw9Hw2LaQ1ob8R22ztgLtKAQwZXvGtois4w2hIUbB3ZGTmrP5",
+	"yMUDz4uUNxwrmlLEpjLNsO+P9WwJKos1CSknAXqk2Bahgg2xHaoheZNJvQaZCAn+DRcczOKUKkUoSanU",
+	"LMSwLy9bomtjCRCqsZAVWo9dw/GG50gifsaqEqoI42mGxSySj+8U+JRVVy2IBJ1JjmWuG16nmU8krKiM",
+	"YlBFeYzJnOn4GwvLRhCGN8g4FH1TR5pH3sT7AXTdTxjGSJqABqm8yW9PHkPq/5GBxLjSRt5Vi7HfBGJZ",
+	"YXFDM0S4pboBr59GuAHSOG7Aak9Gbf22dM15GGdRU35M/dAyyOqZ7deUEwUNdvsE7oHnEwYbsqb3pqGH",
+	"ykoU45WwIQurKS6UgYRKHHagqj7lxZZ7JsWYMqKX8zuXYhNGufhlr3dbHdCgT9lVWNJYgd+DXtcaz8bo",
+	"E3hk2+NG6B8Yj8SDTxSgFOU9IYrV14TmHfFifm0tlLlIXX0txWykm4eIBcRA6DUepkh+mcg31Csoaulr",
+	"CnYkyZQ2TZcAiLGKBhLwvPeSxiKC8rIuehk8GF/d5vFHg1plnNrPEyT0cW53HJtWVvWjHUgrvbGFPyET",
+	"b/vBb04GH49GB40E9wqna5OV3VB667tssHAM+Zls+vRZBPOmyz8Om10uuuoOZObc6mZjctm2+hruoour",
+	"72m6Umb6Ik0/Mu8Dbm14oaMns3TAou1Oh/QD7DjAqCo13XZO8vHG/ZZ3h+FFL1nJZYGVVw8FtMygryUu",
+	"Z2E/Wbz2nuLiWWdc86uTm51cPUxqjoJYBC8QHeC2XUUVubx4S4KNxiQoFsEOocoDGMNGElJpGl+Uk4sF",
+	"XRnznNjBg5CG67xXLTiQhPFMw5DMlzccETHLjUdRqpoanC8H7wSHwVuqwzVZA41A+kTXj1xTdcPzYYCT",
+	"0Wne7SaBiHoEG6+RRl+12D8OUkgGSxa3ovgB/vf64of5OzK7uFrM38xn08WFeXrDp9d1MR8OhzfcvLl4",
+	"d+5Y/Syo2fQQUF4PhTPC5Pme5ab9xgNlYzCzsbGjikPDNXY/IybBBtx5yIGQhq44q5awoGA5+h1cY1qi",
+	"6aqAFTrxfB46wj8ZnbqnVHA7iZidvbA1HxuHkVLeHzDsVirDkYy/jBkq+Oe0RYIv2apmdbrKZ1fs1QEN",
+	"j/oojfMvSp5hQftW11kYglI4h/a+OLxGXBetSlSOat8+NalxKRnXdlpl8f7tz8ReNLPgMWWDYZ0kIsEM",
+	"1dKkSG93UWTOzdTfX4ser6nKp+ZlYmmQ0hUQMxJUju7UEl0746fUTirFYnVUDlTuIlU5i/kfjBzKM74Y",
+	"LVHT4tbQaIdGvpdmDqJct4hi4L8W0eaL0KMYda2fX7nG7f8qLl334RJKctGs319HcnX4nXUjV7lIuepF",
+	"ukxAi8R4et0ZV5pzlUJYfP4QsXsWZTSuULBxXiLM7BJODkNE7hk8DF3BVDHT0Y2iXOl6PnEtls45mvaI",
+	"tysvbs3DHFz9aflpkAnj5suNnUgdF0gd70SqMZXziSj9gCMedYapnLFM5gOjc0TUDCfe4YO7VkBsPgkg",
+	"tsBaNeyy1LcfOJh+HYpWCZ5xpYHmNZRlTDWJmdpZyDEjKLfBpnHTYsYd8amVvkuvdFjMGwZ2RKWCLzi8",
+	"X9rY/DOM8/TaXEwpbY1WP2+p/mT0dlZJEkyb0GA5LM3w6y2YOLCtGdv8UcvaHj3l/yoqJhHEoB0z2efm",
+	"+Y5zKn2xaW7xeH7eNX4WUM6OfeZvUelz/Wur2tFGr61JTjONVd/M6LKZIDf1Q8oJrQEp5ppDwRWLjAeg",
+	"JJWwZI9GyXEWtRSAppOhxrQj+qZoyRTCyRSgy0Trb951t2HrMCKC59ao8IaIiu0z5N8ujY9N2aBAJr8s",
+	"DXXNzZCiIuUshjaT74qzL06/G5OeRZVTMWPiHdbJkdI5hyoNCb8GRfK9V18aAw0SPee1/cai+NMOdYV+",
+	"VtWcGu0/X62qPbXeqvx8pgt/SH5FXb6bhiGkekJaRRUptAiyZe48C44yVfVHqBVmSR9IsboYkCxc5nMR",
+	"UdcifJWSfpgf7nGwt99dvgRKxbImrLIDEjBOTZyyPyPuanItkf16KzH7x7r7e8h+1WHHiTvLw3+2vrlL",
+	"uX85netR772cLn4k1xc/vL14t8jrroZR+F1tjkmrUOvY4X1R/TLS9lW4ydPRP78kBti9qAIg1AyISAIR",
+	"oyaZM4GXytJUSPNVdm/Fb9VgmxqvZdij+hBTDUrnwBcSG9ZXQmgyq5c2bTUAaLjG3H1HdeLwoRb8eg/B",
+	"42dzvolUcbSuWFwNONRSUzO0WcNbcFBOe7DA2/czBN2ZEneb3fHB57ON85cOhXyRhns5cX9Auz0/Fr9/",
+	"tAO8n1xOK8WwmKl0dBNQjo+ifLbVKcwzkaQojjicuU+QMSeK81OZvOG1OVsrsfVGaj4Wo03PslF/QTg3",
+	"vDvxbGHYuXtiZ1zNkXjH++YYHALHKa54YxCCqBzasTjt6FsuZGhmaPekmvPaR+HL5oyzX049C5kQpqIn",
+	"pqLtIHjCzG47UE92HHS7q/ZDn/WQlbNiKjo9HgTjgTp2j0ztw7gacf9UlIODUT7xPrV+dZj3KOa3Hern",
+	"nENef4oO4pbTL+kAp5rEQK29LrhrbH0kwP6pH/MHx9qur67Y+yzEbqHoGeDushm79bDXzIB1Jz2EzzXq",
+	"vfWdMPGC/YCOe8O0xOoH1TWi/R9WDpdUme81PkvjLZfHl8nXIVnULiErMqkisTKVM5NQ/RVnaxYy7D1T",
+	"83/68cIEcHE1y7O4f/8+fXj/+/SfbxcXD/NW0let8pwK9JXO4RSY/RmTN59uR3YOx2zNtzb3hTpkMvYm",
+	"3lrrdHJ09LQWSm8nT5gFbo9oyo7ux+ZjSskw5DaswSXNP79i/pyLebz1PdzafH0yHr86Rkp9KLFxRM/5",
+	"FxM49mz+mEqwyc1VnsupYaUHeZe7G79d3IPcaCMaEmL7N9OEu+fUrrocCG12efnTHONyo5J13Aydtx+2",
+	"/zMAydENhNtYAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -7,6 +7,14 @@ import (
 	"time"
 )
 
+// Defines values for HopType.
+const (
+	Core    HopType = "core"
+	Egress  HopType = "egress"
+	Ingress HopType = "ingress"
+	Peer    HopType = "peer"
+)
+
 // Defines values for LogLevelLevel.
 const (
 	Debug LogLevelLevel = "debug"
@@ -14,6 +22,11 @@ const (
 	Info  LogLevelLevel = "info"
 )
 
+// Defines values for GetSegmentsParamsGroupBy.
+const (
+	Type GetSegmentsParamsGroupBy = "type"
+)
+
 // Certificate defines model for Certificate.
 type Certificate struct {
 	DistinguishedName string       `json:"distinguished_name"`
@@ -31,10 +44,13 @@ type Chain struct {
 
 // ChainBrief defines model for ChainBrief.
 type ChainBrief struct {
-	Id       ChainID  `json:"id"`
-	Issuer   IsdAs    `json:"issuer"`
-	Subject  IsdAs    `json:"subject"`
-	Validity Validity `json:"validity"`
+	Id      ChainID `json:"id"`
+	Issuer  IsdAs   `json:"issuer"`
+	Subject IsdAs   `json:"subject"`
+
+	// Superseded Whether a newer chain for the same AS exists. Only present if superseded chains were requested.
+	Superseded *bool    `json:"superseded,omitempty"`
+	Validity   Validity `json:"validity"`
 }
 
 // ChainID defines model for ChainID.
@@ -42,10 +58,31 @@ type ChainID = string
 
 // Hop defines model for Hop.
 type Hop struct {
+	// Bandwidth Bandwidth in Kbit/s announced in the static info extension for the interface of the hop, with the same meaning as `latency`. Absent if it is not announced.
+	Bandwidth *int  `json:"bandwidth,omitempty"`
 	Interface int   `json:"interface"`
 	IsdAs     IsdAs `json:"isd_as"`
+
+	// Latency Latency in milliseconds announced in the static info extension for the interface of the hop. For an ingress interface, it is the latency through the AS to the egress interface. For an egress interface, it is the latency of the link. Absent if it is not announced.
+	Latency *float64 `json:"latency,omitempty"`
+
+	// Mtu MTU of the link at the interface of the hop, as announced in the AS entries of the segment. Absent if it is not announced.
+	Mtu *int `json:"mtu,omitempty"`
+
+	// Name Name of the local interface that the interface of the hop is linked to, as derived from the topology of the local AS. Only present if requested and if the topology resolves the interface.
+	Name *string `json:"name,omitempty"`
+
+	// PeerInterface Interface ID of the peering link in the peering AS `peer_isd_as`. Only present for `peer` hops.
+	PeerInterface *int   `json:"peer_interface,omitempty"`
+	PeerIsdAs     *IsdAs `json:"peer_isd_as,omitempty"`
+
+	// Type Role of the hop in the segment. `core` is the egress interface of the core AS that originated the segment, `ingress` and `egress` are the interfaces of the hop entries of the other AS entries, and `peer` is the local interface of a peering link that is announced in a peer entry. Only present if requested.
+	Type *HopType `json:"type,omitempty"`
 }
 
+// HopType Role of the hop in the segment. `core` is the egress interface of the core AS that originated the segment, `ingress` and `egress` are the interfaces of the hop entries of the other AS entries, and `peer` is the local interface of a peering link that is announced in a peer entry. Only present if requested.
+type HopType string
+
 // IsdAs defines model for IsdAs.
 type IsdAs = string
 
@@ -82,7 +119,10 @@ type Segment struct {
 	Hops        []Hop     `json:"hops"`
 	Id          SegmentID `json:"id"`
 	LastUpdated time.Time `json:"last_updated"`
-	Timestamp   time.Time `json:"timestamp"`
+
+	// ParseWarnings Problems encountered while decoding the AS entries of the segment. Absent if all AS entries were decoded completely.
+	ParseWarnings *[]string `json:"parse_warnings,omitempty"`
+	Timestamp     time.Time `json:"timestamp"`
 }
 
 // SegmentBrief defines model for SegmentBrief.
@@ -98,6 +138,13 @@ type SegmentBrief struct {
 // SegmentID defines model for SegmentID.
 type SegmentID = string
 
+// SegmentsByType defines model for SegmentsByType.
+type SegmentsByType struct {
+	Core []SegmentBrief `json:"core"`
+	Down []SegmentBrief `json:"down"`
+	Up   []SegmentBrief `json:"up"`
+}
+
 // StandardError defines model for StandardError.
 type StandardError struct {
 	// Error Error message
@@ -121,6 +168,42 @@ type TRCBrief struct {
 	Id TRCID `json:"id"`
 }
 
+// TRCCertificate defines model for TRCCertificate.
+type TRCCertificate struct {
+	DistinguishedName string       `json:"distinguished_name"`
+	IsdAs             *IsdAs       `json:"isd_as,omitempty"`
+	SubjectKeyId      SubjectKeyID `json:"subject_key_id"`
+	Type              string       `json:"type"`
+	Validity          Validity     `json:"validity"`
+}
+
+// TRCCertificateChange defines model for TRCCertificateChange.
+type TRCCertificateChange struct {
+	A TRCCertificate `json:"a"`
+	B TRCCertificate `json:"b"`
+}
+
+// TRCCertificatesDiff defines model for TRCCertificatesDiff.
+type TRCCertificatesDiff struct {
+	// Added Certificates that are only in the second TRC.
+	Added []TRCCertificate `json:"added"`
+
+	// Changed Certificates with the same type and distinguished name that differ between the TRCs.
+	Changed []TRCCertificateChange `json:"changed"`
+
+	// Removed Certificates that are only in the first TRC.
+	Removed []TRCCertificate `json:"removed"`
+}
+
+// TRCDiff defines model for TRCDiff.
+type TRCDiff struct {
+	A            TRCID               `json:"a"`
+	B            TRCID               `json:"b"`
+	Certificates TRCCertificatesDiff `json:"certificates"`
+	Quorum       *TRCQuorumChange    `json:"quorum,omitempty"`
+	Validity     *TRCValidityChange  `json:"validity,omitempty"`
+}
+
 // TRCID defines model for TRCID.
 type TRCID struct {
 	BaseNumber   int `json:"base_number"`
@@ -128,6 +211,18 @@ type TRCID struct {
 	SerialNumber int `json:"serial_number"`
 }
 
+// TRCQuorumChange defines model for TRCQuorumChange.
+type TRCQuorumChange struct {
+	A int `json:"a"`
+	B int `json:"b"`
+}
+
+// TRCValidityChange defines model for TRCValidityChange.
+type TRCValidityChange struct {
+	A Validity `json:"a"`
+	B Validity `json:"b"`
+}
+
 // Validity defines model for Validity.
 type Validity struct {
 	NotAfter  time.Time `json:"not_after"`
@@ -142,6 +237,12 @@ type GetCertificatesParams struct {
 	IsdAs   *IsdAs     `form:"isd_as,omitempty" json:"isd_as,omitempty"`
 	ValidAt *time.Time `form:"valid_at,omitempty" json:"valid_at,omitempty"`
 	All     *bool      `form:"all,omitempty" json:"all,omitempty"`
+
+	// IncludeSuperseded Include chains that were valid before the requested point in time, even if they have expired since. The returned chains are marked as superseded if a newer chain for the same AS is part of the result.
+	IncludeSuperseded *bool `form:"include_superseded,omitempty" json:"include_superseded,omitempty"`
+
+	// ExpiringBetween Start and end of a time window, separated by a comma. Only chains whose AS certificate expires within the window, both ends included, are returned. The start must not be after the end.
+	ExpiringBetween *[]time.Time `form:"expiring_between,omitempty" json:"expiring_between,omitempty"`
 }
 
 // GetSegmentsParams defines parameters for GetSegments.
@@ -151,13 +252,28 @@ type GetSegmentsParams struct {
 
 	// EndIsdAs Terminal AS of segment.
 	EndIsdAs *IsdAs `form:"end_isd_as,omitempty" json:"end_isd_as,omitempty"`
+
+	// GroupBy Group the segments by their type. If set to `type`, the response is an object with the up, core and down segments instead of a flat list.
+	GroupBy *GetSegmentsParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
 }
 
+// GetSegmentsParamsGroupBy defines parameters for GetSegments.
+type GetSegmentsParamsGroupBy string
+
 // GetTrcsParams defines parameters for GetTrcs.
 type GetTrcsParams struct {
 	Isd *[]int `form:"isd,omitempty" json:"isd,omitempty"`
 	All *bool  `form:"all,omitempty" json:"all,omitempty"`
 }
 
+// GetTrcDiffParams defines parameters for GetTrcDiff.
+type GetTrcDiffParams struct {
+	// A Identifier of the first TRC, in the form isd{isd}-b{base}-s{serial}.
+	A string `form:"a" json:"a"`
+
+	// B Identifier of the second TRC, in the form isd{isd}-b{base}-s{serial}.
+	B string `form:"b" json:"b"`
+}
+
 // SetLogLevelJSONRequestBody defines body for SetLogLevel for application/json ContentType.
 type SetLogLevelJSONRequestBody = LogLevel
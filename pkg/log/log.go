@@ -153,6 +153,22 @@ type httpLevel struct {
 	a zap.AtomicLevel
 }
 
+// Level returns the current logging level.
+func (l httpLevel) Level() string {
+	return l.a.Level().String()
+}
+
+// Levels returns the logging levels that can be set at runtime, ordered from
+// the most to the least verbose. These are all the levels that ServeHTTP
+// accepts.
+func (l httpLevel) Levels() []string {
+	var levels []string
+	for lvl := zapcore.DebugLevel; lvl <= zapcore.FatalLevel; lvl++ {
+		levels = append(levels, lvl.String())
+	}
+	return levels
+}
+
 // ServeHTTP is an endpoint that can report on or change the current logging
 // level.
 //
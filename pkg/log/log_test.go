@@ -16,6 +16,8 @@
 package log_test
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -99,9 +101,28 @@ func TestLog(t *testing.T) {
 	require.NoError(t, err)
 	lines := strings.Split(string(data), "\n")
 	require.Len(t, lines, 3)
-	assert.Equal(t, `INFO	            log/log_test.go:91	msg1	{"key1": "val1"}`,
+	assert.Equal(t, `INFO	            log/log_test.go:93	msg1	{"key1": "val1"}`,
 		lines[0][len(common.TimeFmt)+1:])
 	assert.Equal(t,
-		`DEBUG	            log/log_test.go:93	msg2	{"key2": "val2", "key3": "val3"}`,
+		`DEBUG	            log/log_test.go:95	msg2	{"key2": "val2", "key3": "val3"}`,
 		lines[1][len(common.TimeFmt)+1:])
 }
+
+func TestConsoleLevel(t *testing.T) {
+	cfg := log.Config{Console: log.ConsoleConfig{Level: "error"}}
+	require.NoError(t, log.Setup(cfg))
+	assert.Equal(t, "error", log.ConsoleLevel.Level())
+	assert.Equal(t,
+		[]string{"debug", "info", "warn", "error", "dpanic", "panic", "fatal"},
+		log.ConsoleLevel.Levels(),
+	)
+	// Every advertised level can be set.
+	for _, level := range log.ConsoleLevel.Levels() {
+		req := httptest.NewRequest(http.MethodPut, "/log/level",
+			strings.NewReader(`{"level":"`+level+`"}`))
+		rr := httptest.NewRecorder()
+		log.ConsoleLevel.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code, level)
+		assert.Equal(t, level, log.ConsoleLevel.Level())
+	}
+}
@@ -86,6 +86,20 @@ func (c ChainBuilder) CreateChain(ctx context.Context,
 	return chain, nil
 }
 
+// PreviewChain creates the certificate chain that CreateChain would issue for
+// the CSR with the latest available CA policy. In contrast to CreateChain, the
+// chain is not accounted as signed. It is meant for inspection only and must
+// not be handed out.
+func (c ChainBuilder) PreviewChain(ctx context.Context,
+	csr *x509.CertificateRequest) ([]*x509.Certificate, error) {
+
+	policy, err := c.PolicyGen.Generate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return policy.CreateChain(csr)
+}
+
 func (c ChainBuilder) incSignedChains(result string) {
 	if c.SignedChains != nil {
 		metrics.CounterInc(c.SignedChains(result))
@@ -0,0 +1,246 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/scionproto/scion/pkg/private/serrors"
+)
+
+// CBORContentType is the media type of CBOR encoded responses.
+const CBORContentType = "application/cbor"
+
+// CBOR major types, see RFC 8949, Section 3.1.
+const (
+	cborUnsigned byte = 0 << 5
+	cborNegative byte = 1 << 5
+	cborText     byte = 3 << 5
+	cborArray    byte = 4 << 5
+	cborMap      byte = 5 << 5
+	cborSimple   byte = 7 << 5
+)
+
+// AcceptsCBOR indicates whether the client negotiated a CBOR encoded response
+// with the Accept header. CBOR is only selected if it is listed explicitly and
+// preferred over JSON, such that JSON remains the default.
+func AcceptsCBOR(r *http.Request) bool {
+	return AcceptsMediaType(r, CBORContentType)
+}
+
+// AcceptsMediaType indicates whether the client negotiated a response of the
+// given media type with the Accept header. The media type is only selected if
+// it is listed explicitly and preferred over JSON, such that JSON remains the
+// default.
+func AcceptsMediaType(r *http.Request, mediaType string) bool {
+	var wantedQ, jsonQ float64
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			rangeType, params, err := mime.ParseMediaType(mediaRange)
+			if err != nil {
+				continue
+			}
+			q := 1.0
+			if raw, ok := params["q"]; ok {
+				if q, err = strconv.ParseFloat(raw, 64); err != nil {
+					continue
+				}
+			}
+			switch rangeType {
+			case mediaType:
+				wantedQ = max(wantedQ, q)
+			case "application/json":
+				jsonQ = max(jsonQ, q)
+			}
+		}
+	}
+	return wantedQ > 0 && wantedQ > jsonQ
+}
+
+// NegotiateMediaType selects the offered media type that the client prefers
+// according to the Accept header. Exact media ranges take precedence over
+// wildcard ranges, and ties are resolved in the order of the offers. If the
+// request has no Accept header, the first offer is selected. It reports false
+// if the client accepts none of the offers.
+func NegotiateMediaType(r *http.Request, offers ...string) (string, bool) {
+	accepts := r.Header.Values("Accept")
+	if len(accepts) == 0 && len(offers) > 0 {
+		return offers[0], true
+	}
+	best, bestQ := "", 0.0
+	for _, offer := range offers {
+		// specificity is 0 for */*, 1 for type/* and 2 for an exact match.
+		q, specificity := 0.0, -1
+		for _, accept := range accepts {
+			for _, mediaRange := range strings.Split(accept, ",") {
+				rangeType, params, err := mime.ParseMediaType(mediaRange)
+				if err != nil {
+					continue
+				}
+				var rangeSpecificity int
+				switch {
+				case rangeType == offer:
+					rangeSpecificity = 2
+				case strings.HasSuffix(rangeType, "/*") &&
+					strings.HasPrefix(offer, strings.TrimSuffix(rangeType, "*")):
+					rangeSpecificity = 1
+				case rangeType == "*/*":
+					rangeSpecificity = 0
+				default:
+					continue
+				}
+				if rangeSpecificity < specificity {
+					continue
+				}
+				rangeQ := 1.0
+				if raw, ok := params["q"]; ok {
+					if rangeQ, err = strconv.ParseFloat(raw, 64); err != nil {
+						continue
+					}
+				}
+				if rangeSpecificity > specificity {
+					q, specificity = rangeQ, rangeSpecificity
+				} else {
+					q = max(q, rangeQ)
+				}
+			}
+		}
+		if q > bestQ {
+			best, bestQ = offer, q
+		}
+	}
+	return best, bestQ > 0
+}
+
+// WriteCBOR writes the CBOR encoding of v to the writer and sets the content
+// type accordingly. If v cannot be encoded, nothing is written.
+func WriteCBOR(w http.ResponseWriter, v any) error {
+	raw, err := MarshalCBOR(v)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", CBORContentType)
+	_, err = w.Write(raw)
+	return err
+}
+
+// MarshalCBOR returns the CBOR encoding (RFC 8949) of v. The value is encoded
+// with the same structure and field names as its JSON encoding. Map keys are
+// sorted according to the core deterministic encoding requirements.
+func MarshalCBOR(v any) ([]byte, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return appendCBOR(nil, generic)
+}
+
+// appendCBOR appends the CBOR encoding of a value as decoded from JSON.
+func appendCBOR(b []byte, v any) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(b, cborSimple|22), nil
+	case bool:
+		if v {
+			return append(b, cborSimple|21), nil
+		}
+		return append(b, cborSimple|20), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			if i < 0 {
+				return appendCBORHead(b, cborNegative, uint64(-(i + 1))), nil
+			}
+			return appendCBORHead(b, cborUnsigned, uint64(i)), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, cborSimple|27)
+		return binary.BigEndian.AppendUint64(b, math.Float64bits(f)), nil
+	case string:
+		b = appendCBORHead(b, cborText, uint64(len(v)))
+		return append(b, v...), nil
+	case []any:
+		b = appendCBORHead(b, cborArray, uint64(len(v)))
+		for _, elem := range v {
+			var err error
+			if b, err = appendCBOR(b, elem); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		// Core deterministic encoding sorts the keys by the bytewise
+		// lexicographic order of their encoding. For text strings, this is
+		// the order by length first, because the head encodes the length.
+		slices.SortFunc(keys, func(a, b string) int {
+			if c := cmp.Compare(len(a), len(b)); c != 0 {
+				return c
+			}
+			return strings.Compare(a, b)
+		})
+		b = appendCBORHead(b, cborMap, uint64(len(v)))
+		for _, key := range keys {
+			var err error
+			if b, err = appendCBOR(b, key); err != nil {
+				return nil, err
+			}
+			if b, err = appendCBOR(b, v[key]); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	default:
+		return nil, serrors.New("unsupported type", "type", fmt.Sprintf("%T", v))
+	}
+}
+
+// appendCBORHead appends the initial bytes of a data item of the given major
+// type with argument n.
+func appendCBORHead(b []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(b, major|byte(n))
+	case n <= math.MaxUint8:
+		return append(b, major|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, major|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(b, major|26), uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, major|27), n)
+	}
+}
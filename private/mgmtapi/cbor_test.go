@@ -0,0 +1,166 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi_test
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/scionproto/scion/private/mgmtapi"
+)
+
+func TestMarshalCBOR(t *testing.T) {
+	// The expected encodings are taken from RFC 8949, Appendix A.
+	testCases := map[string]struct {
+		Value    any
+		Expected string
+	}{
+		"zero":           {Value: 0, Expected: "00"},
+		"small":          {Value: 23, Expected: "17"},
+		"one byte":       {Value: 24, Expected: "1818"},
+		"two bytes":      {Value: 1000, Expected: "1903e8"},
+		"four bytes":     {Value: 1000000, Expected: "1a000f4240"},
+		"eight bytes":    {Value: 1000000000000, Expected: "1b000000e8d4a51000"},
+		"negative":       {Value: -1, Expected: "20"},
+		"large negative": {Value: -1000, Expected: "3903e7"},
+		"float":          {Value: 1.1, Expected: "fb3ff199999999999a"},
+		"false":          {Value: false, Expected: "f4"},
+		"true":           {Value: true, Expected: "f5"},
+		"null":           {Value: nil, Expected: "f6"},
+		"empty string":   {Value: "", Expected: "60"},
+		"string":         {Value: "IETF", Expected: "6449455446"},
+		"unicode string": {Value: "ü", Expected: "62c3bc"},
+		"long string": {
+			Value:    "abcdefghijklmnopqrstuvwx",
+			Expected: "7818" + hex.EncodeToString([]byte("abcdefghijklmnopqrstuvwx")),
+		},
+		"empty array":  {Value: []int{}, Expected: "80"},
+		"nested array": {Value: []any{1, []int{2, 3}}, Expected: "8201820203"},
+		"empty map":    {Value: map[string]int{}, Expected: "a0"},
+		"map": {
+			Value:    map[string]any{"a": 1, "b": []int{2, 3}},
+			Expected: "a26161016162820203",
+		},
+		"map key order": {
+			Value:    map[string]int{"bb": 1, "c": 2},
+			Expected: "a261630262626201",
+		},
+		"struct": {
+			Value: struct {
+				A int    `json:"a"`
+				B *int   `json:"b,omitempty"`
+				C []bool `json:"c"`
+			}{A: 1},
+			Expected: "a26161016163f6",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			raw, err := api.MarshalCBOR(tc.Value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, hex.EncodeToString(raw))
+		})
+	}
+}
+
+func TestAcceptsCBOR(t *testing.T) {
+	testCases := map[string]struct {
+		Accept   []string
+		Expected bool
+	}{
+		"no header":     {Expected: false},
+		"json":          {Accept: []string{"application/json"}, Expected: false},
+		"cbor":          {Accept: []string{"application/cbor"}, Expected: true},
+		"wildcard":      {Accept: []string{"*/*"}, Expected: false},
+		"cbor and json": {Accept: []string{"application/cbor, application/json"}, Expected: false},
+		"cbor preferred": {
+			Accept:   []string{"application/json;q=0.5, application/cbor"},
+			Expected: true,
+		},
+		"json preferred": {
+			Accept:   []string{"application/cbor;q=0.5, application/json"},
+			Expected: false,
+		},
+		"cbor rejected": {Accept: []string{"application/cbor;q=0"}, Expected: false},
+		"multiple headers": {
+			Accept:   []string{"application/json;q=0.1", "application/cbor"},
+			Expected: true,
+		},
+		"malformed": {Accept: []string{"application/cbor;q=high"}, Expected: false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for _, accept := range tc.Accept {
+				req.Header.Add("Accept", accept)
+			}
+			assert.Equal(t, tc.Expected, api.AcceptsCBOR(req))
+		})
+	}
+}
+
+func TestNegotiateMediaType(t *testing.T) {
+	offers := []string{"application/x-pem-file", "application/x-protobuf"}
+	testCases := map[string]struct {
+		Accept     []string
+		Expected   string
+		Acceptable bool
+	}{
+		"no header": {Expected: "application/x-pem-file", Acceptable: true},
+		"exact": {
+			Accept:     []string{"application/x-protobuf"},
+			Expected:   "application/x-protobuf",
+			Acceptable: true,
+		},
+		"wildcard": {
+			Accept:     []string{"*/*"},
+			Expected:   "application/x-pem-file",
+			Acceptable: true,
+		},
+		"type wildcard": {
+			Accept:     []string{"application/*;q=0.5, application/x-pem-file;q=0.1"},
+			Expected:   "application/x-protobuf",
+			Acceptable: true,
+		},
+		"preferred": {
+			Accept:     []string{"application/x-pem-file;q=0.5", "application/x-protobuf"},
+			Expected:   "application/x-protobuf",
+			Acceptable: true,
+		},
+		"rejected": {
+			Accept:     []string{"*/*, application/x-pem-file;q=0"},
+			Expected:   "application/x-protobuf",
+			Acceptable: true,
+		},
+		"unsupported": {Accept: []string{"application/json"}},
+		"malformed":   {Accept: []string{"application/x-protobuf;q=high"}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for _, accept := range tc.Accept {
+				req.Header.Add("Accept", accept)
+			}
+			mediaType, ok := api.NegotiateMediaType(req, offers...)
+			assert.Equal(t, tc.Acceptable, ok)
+			assert.Equal(t, tc.Expected, mediaType)
+		})
+	}
+}
@@ -16,12 +16,16 @@ package api
 
 import (
 	"bytes"
+	"crypto/x509"
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"net/http"
+	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/scionproto/scion/pkg/addr"
@@ -34,6 +38,10 @@ import (
 	"github.com/scionproto/scion/private/trust"
 )
 
+// blobMaxAge is the duration for which clients may cache TRC and certificate
+// chain blobs without revalidating them.
+const blobMaxAge = time.Minute
+
 type Server struct {
 	TrustDB storage.TrustDB
 }
@@ -70,6 +78,26 @@ func (s *Server) GetCertificates(
 	if params.All != nil && *params.All {
 		q.Validity = cppki.Validity{}
 	}
+	includeSuperseded := params.IncludeSuperseded != nil && *params.IncludeSuperseded
+	// Superseded chains are no longer valid at the reference time. Fetch all
+	// chains and only drop the ones that are not yet valid.
+	notAfter := q.Validity.NotAfter
+	if includeSuperseded {
+		q.Validity = cppki.Validity{}
+	}
+	var expiringFrom, expiringUntil time.Time
+	if params.ExpiringBetween != nil {
+		switch window := *params.ExpiringBetween; {
+		case len(window) != 2:
+			errs = append(errs, serrors.New("expected start and end of window",
+				"parameter", "expiring_between", "values", len(window)))
+		case window[0].After(window[1]):
+			errs = append(errs, serrors.New("start of window is after its end",
+				"parameter", "expiring_between"))
+		default:
+			expiringFrom, expiringUntil = window[0], window[1]
+		}
+	}
 	if err := errs.ToError(); err != nil {
 		Error(w, Problem{
 			Detail: api.StringRef(err.Error()),
@@ -89,6 +117,21 @@ func (s *Server) GetCertificates(
 		})
 		return
 	}
+	if includeSuperseded && !notAfter.IsZero() {
+		chains = slices.DeleteFunc(chains, func(chain []*x509.Certificate) bool {
+			return chain[0].NotBefore.After(notAfter)
+		})
+	}
+	if params.ExpiringBetween != nil {
+		chains = slices.DeleteFunc(chains, func(chain []*x509.Certificate) bool {
+			notAfter := chain[0].NotAfter
+			return notAfter.Before(expiringFrom) || notAfter.After(expiringUntil)
+		})
+	}
+	var latest map[addr.IA][]*x509.Certificate
+	if includeSuperseded {
+		latest = latestChains(chains)
+	}
 	results := make([]ChainBrief, 0, len(chains))
 	for _, chain := range chains {
 		subject, err := cppki.ExtractIA(chain[0].Subject)
@@ -99,7 +142,7 @@ func (s *Server) GetCertificates(
 		if err != nil {
 			continue
 		}
-		results = append(results, ChainBrief{
+		brief := ChainBrief{
 			Id:      fmt.Sprintf("%x", truststorage.ChainID(chain)),
 			Issuer:  issuer.String(),
 			Subject: subject.String(),
@@ -107,7 +150,12 @@ func (s *Server) GetCertificates(
 				NotAfter:  chain[0].NotAfter,
 				NotBefore: chain[0].NotBefore,
 			},
-		})
+		}
+		if includeSuperseded {
+			superseded := latest[subject][0] != chain[0]
+			brief.Superseded = &superseded
+		}
+		results = append(results, brief)
 	}
 	enc := json.NewEncoder(w)
 	enc.SetIndent("", "    ")
@@ -122,6 +170,31 @@ func (s *Server) GetCertificates(
 	}
 }
 
+// latestChains returns the most recent chain per subject AS. Chains are
+// ordered by the start of their validity period, ties are broken by the
+// serial number of the AS certificate.
+func latestChains(chains [][]*x509.Certificate) map[addr.IA][]*x509.Certificate {
+	latest := make(map[addr.IA][]*x509.Certificate)
+	for _, chain := range chains {
+		ia, err := cppki.ExtractIA(chain[0].Subject)
+		if err != nil {
+			continue
+		}
+		cur, ok := latest[ia]
+		if !ok || newerCertificate(chain[0], cur[0]) {
+			latest[ia] = chain
+		}
+	}
+	return latest
+}
+
+func newerCertificate(a, b *x509.Certificate) bool {
+	if !a.NotBefore.Equal(b.NotBefore) {
+		return a.NotBefore.After(b.NotBefore)
+	}
+	return a.SerialNumber.Cmp(b.SerialNumber) > 0
+}
+
 // GetCertificate lists the certificate chain for a given ChainID
 func (s *Server) GetCertificate(w http.ResponseWriter, r *http.Request, chainID ChainID) {
 	w.Header().Set("Content-Type", "application/json")
@@ -153,7 +226,7 @@ func (s *Server) GetCertificate(w http.ResponseWriter, r *http.Request, chainID
 	issuer, _ := cppki.ExtractIA(chain[1].Subject)
 	result := Chain{
 		Subject: Certificate{
-			DistinguishedName: chain[0].Subject.String(),
+			DistinguishedName: api.DistinguishedName(chain[0].Subject),
 			IsdAs:             subject.String(),
 			SubjectKeyAlgo:    chain[0].PublicKeyAlgorithm.String(),
 			SubjectKeyId:      fmt.Sprintf("% X", chain[0].SubjectKeyId),
@@ -163,7 +236,7 @@ func (s *Server) GetCertificate(w http.ResponseWriter, r *http.Request, chainID
 			},
 		},
 		Issuer: Certificate{
-			DistinguishedName: chain[1].Subject.String(),
+			DistinguishedName: api.DistinguishedName(chain[1].Subject),
 			IsdAs:             issuer.String(),
 			SubjectKeyAlgo:    chain[1].PublicKeyAlgorithm.String(),
 			SubjectKeyId:      fmt.Sprintf("% X", chain[1].SubjectKeyId),
@@ -190,8 +263,6 @@ func (s *Server) GetCertificate(w http.ResponseWriter, r *http.Request, chainID
 // GetCertificateBlob generates a certificate chain blob response encoded as PEM
 // for a given chainId.
 func (s *Server) GetCertificateBlob(w http.ResponseWriter, r *http.Request, chainID ChainID) {
-	w.Header().Set("Content-Type", "application/x-pem-file")
-
 	id, err := hex.DecodeString(chainID)
 	if err != nil {
 		Error(w, Problem{
@@ -225,7 +296,7 @@ func (s *Server) GetCertificateBlob(w http.ResponseWriter, r *http.Request, chai
 			return
 		}
 	}
-	_, _ = w.Write(buf.Bytes())
+	writePEMBlob(w, r, buf.Bytes())
 }
 
 func (s *Server) GetTrcs(
@@ -348,8 +419,6 @@ func (s *Server) GetTrc(w http.ResponseWriter, r *http.Request, isd int, base in
 
 // GetTrcBlob gets the trc encoded pem blob.
 func (s *Server) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, base int, serial int) {
-	w.Header().Set("Content-Type", "application/x-pem-file")
-
 	db := s.TrustDB
 	trc, err := db.SignedTRC(r.Context(), cppki.TRCID{
 		ISD:    addr.ISD(isd),
@@ -374,7 +443,111 @@ func (s *Server) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, bas
 		})
 		return
 	}
-	if err := pem.Encode(w, &pem.Block{Type: "TRC", Bytes: trc.TRC.Raw}); err != nil {
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: "TRC", Bytes: trc.TRC.Raw}); err != nil {
+		Error(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+		return
+	}
+	writePEMBlob(w, r, buf.Bytes())
+}
+
+// writePEMBlob writes a PEM encoded TRC or certificate chain. The blob carries a
+// strong ETag, and the response has status 304 and no body if the ETag is passed
+// in the If-None-Match header. TRCs and certificate chains never change for a
+// given ID, but clients are only allowed to cache them for blobMaxAge such that
+// entries that are removed from the trust database do not linger.
+func writePEMBlob(w http.ResponseWriter, r *http.Request, blob []byte) {
+	etag := api.ContentETag(blob)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(blobMaxAge.Seconds())))
+	if api.ETagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-pem-file")
+	_, _ = w.Write(blob)
+}
+
+// trcIDPattern matches TRC identifiers in the form used by the TRC paths, e.g.,
+// isd1-b1-s2.
+var trcIDPattern = regexp.MustCompile(`^isd(\d+)-b(\d+)-s(\d+)$`)
+
+// GetTrcDiff compares two TRCs and lists their differences.
+func (s *Server) GetTrcDiff(w http.ResponseWriter, r *http.Request, params GetTrcDiffParams) {
+	var errs serrors.List
+	a, err := parseTRCID(params.A)
+	if err != nil {
+		errs = append(errs, serrors.Wrap("parsing a", err))
+	}
+	b, err := parseTRCID(params.B)
+	if err != nil {
+		errs = append(errs, serrors.Wrap("parsing b", err))
+	}
+	if err := errs.ToError(); err != nil {
+		Error(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusBadRequest,
+			Title:  "malformed query parameters",
+			Type:   api.StringRef(api.BadRequest),
+		})
+		return
+	}
+	trcs := make([]cppki.TRC, 0, 2)
+	for _, id := range []cppki.TRCID{a, b} {
+		trc, err := s.TrustDB.SignedTRC(r.Context(), id)
+		if err != nil {
+			Error(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "error getting trc",
+				Type:   api.StringRef(api.InternalError),
+			})
+			return
+		}
+		if trc.IsZero() {
+			Error(w, Problem{
+				Status: http.StatusNotFound,
+				Title: fmt.Sprintf("trc with isd %d, base %d, serial %d does not exist",
+					id.ISD, id.Base, id.Serial),
+				Type: api.StringRef(api.NotFound),
+			})
+			return
+		}
+		trcs = append(trcs, trc.TRC)
+	}
+
+	rep := TRCDiff{
+		A:            trcID(trcs[0].ID),
+		B:            trcID(trcs[1].ID),
+		Certificates: diffTRCCertificates(trcs[0].Certificates, trcs[1].Certificates),
+	}
+	if trcs[0].Quorum != trcs[1].Quorum {
+		rep.Quorum = &TRCQuorumChange{A: trcs[0].Quorum, B: trcs[1].Quorum}
+	}
+	if !trcs[0].Validity.NotBefore.Equal(trcs[1].Validity.NotBefore) ||
+		!trcs[0].Validity.NotAfter.Equal(trcs[1].Validity.NotAfter) {
+
+		rep.Validity = &TRCValidityChange{
+			A: Validity{
+				NotAfter:  trcs[0].Validity.NotAfter,
+				NotBefore: trcs[0].Validity.NotBefore,
+			},
+			B: Validity{
+				NotAfter:  trcs[1].Validity.NotAfter,
+				NotBefore: trcs[1].Validity.NotBefore,
+			},
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(rep); err != nil {
 		Error(w, Problem{
 			Detail: api.StringRef(err.Error()),
 			Status: http.StatusInternalServerError,
@@ -385,6 +558,98 @@ func (s *Server) GetTrcBlob(w http.ResponseWriter, r *http.Request, isd int, bas
 	}
 }
 
+// parseTRCID parses a TRC identifier in the form isd{isd}-b{base}-s{serial}.
+func parseTRCID(raw string) (cppki.TRCID, error) {
+	m := trcIDPattern.FindStringSubmatch(raw)
+	if m == nil {
+		return cppki.TRCID{}, serrors.New("invalid TRC identifier", "input", raw,
+			"expected", "isd{isd}-b{base}-s{serial}")
+	}
+	isd, err := addr.ParseISD(m[1])
+	if err != nil {
+		return cppki.TRCID{}, err
+	}
+	base, err := strconv.ParseUint(m[2], 10, 64)
+	if err != nil {
+		return cppki.TRCID{}, serrors.Wrap("parsing base number", err)
+	}
+	serial, err := strconv.ParseUint(m[3], 10, 64)
+	if err != nil {
+		return cppki.TRCID{}, serrors.Wrap("parsing serial number", err)
+	}
+	return cppki.TRCID{
+		ISD:    isd,
+		Base:   scrypto.Version(base),
+		Serial: scrypto.Version(serial),
+	}, nil
+}
+
+func trcID(id cppki.TRCID) TRCID {
+	return TRCID{
+		Isd:          int(id.ISD),
+		BaseNumber:   int(id.Base),
+		SerialNumber: int(id.Serial),
+	}
+}
+
+// diffTRCCertificates compares the certificates of two TRCs. Certificates are
+// matched by their type and distinguished name. The results are in the order
+// of the certificates in the respective TRC.
+func diffTRCCertificates(a, b []*x509.Certificate) TRCCertificatesDiff {
+	key := func(c *x509.Certificate) string {
+		ct, _ := cppki.ValidateCert(c)
+		return ct.String() + " " + c.Subject.String()
+	}
+	inA := make(map[string]*x509.Certificate, len(a))
+	for _, c := range a {
+		inA[key(c)] = c
+	}
+	inB := make(map[string]*x509.Certificate, len(b))
+	for _, c := range b {
+		inB[key(c)] = c
+	}
+	diff := TRCCertificatesDiff{
+		Added:   []TRCCertificate{},
+		Removed: []TRCCertificate{},
+		Changed: []TRCCertificateChange{},
+	}
+	for _, c := range b {
+		old, ok := inA[key(c)]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, trcCertificate(c))
+		case !old.Equal(c):
+			diff.Changed = append(diff.Changed, TRCCertificateChange{
+				A: trcCertificate(old),
+				B: trcCertificate(c),
+			})
+		}
+	}
+	for _, c := range a {
+		if _, ok := inB[key(c)]; !ok {
+			diff.Removed = append(diff.Removed, trcCertificate(c))
+		}
+	}
+	return diff
+}
+
+func trcCertificate(c *x509.Certificate) TRCCertificate {
+	ct, _ := cppki.ValidateCert(c)
+	cert := TRCCertificate{
+		DistinguishedName: api.DistinguishedName(c.Subject),
+		SubjectKeyId:      fmt.Sprintf("% X", c.SubjectKeyId),
+		Type:              ct.String(),
+		Validity: Validity{
+			NotAfter:  c.NotAfter,
+			NotBefore: c.NotBefore,
+		},
+	}
+	if ia, err := cppki.ExtractIA(c.Subject); err == nil {
+		cert.IsdAs = api.StringRef(ia.String())
+	}
+	return cert
+}
+
 // Error creates an detailed error response.
 func Error(w http.ResponseWriter, p Problem) {
 	w.Header().Set("Content-Type", "application/problem+json")
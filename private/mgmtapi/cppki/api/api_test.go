@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,7 @@ import (
 	"github.com/scionproto/scion/pkg/scrypto/cppki"
 	"github.com/scionproto/scion/private/storage/mock_storage"
 	truststorage "github.com/scionproto/scion/private/storage/trust"
+	"github.com/scionproto/scion/private/trust"
 )
 
 var update = xtest.UpdateGoldenFiles()
@@ -247,6 +249,93 @@ func TestAPI(t *testing.T) {
 			RequestURL:   "/trcs/isd1-b1-s1/blob",
 			Status:       200,
 		},
+		"trc diff": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				sto := mock_storage.NewMockTrustDB(ctrl)
+				s := &Server{TrustDB: sto}
+
+				chain, err := cppki.ReadPEMCerts(filepath.Join("testdata", "signer-chain.crt"))
+				require.NoError(t, err)
+				renewed := *chain[0]
+				renewed.NotAfter = renewed.NotAfter.Add(24 * time.Hour)
+				renewed.Raw = bytes.Repeat([]byte{0x11}, 6)
+				validity := cppki.Validity{
+					NotBefore: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					NotAfter:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				}
+
+				sto.EXPECT().SignedTRC(gomock.Any(), cppki.TRCID{
+					ISD:    addr.ISD(1),
+					Serial: scrypto.Version(1),
+					Base:   scrypto.Version(1),
+				}).Return(
+					cppki.SignedTRC{
+						TRC: cppki.TRC{
+							ID:           cppki.TRCID{ISD: 1, Serial: 1, Base: 1},
+							Validity:     validity,
+							Quorum:       1,
+							Certificates: []*x509.Certificate{chain[0]},
+						},
+					}, nil,
+				)
+				sto.EXPECT().SignedTRC(gomock.Any(), cppki.TRCID{
+					ISD:    addr.ISD(1),
+					Serial: scrypto.Version(2),
+					Base:   scrypto.Version(1),
+				}).Return(
+					cppki.SignedTRC{
+						TRC: cppki.TRC{
+							ID:           cppki.TRCID{ISD: 1, Serial: 2, Base: 1},
+							Validity:     validity,
+							Quorum:       2,
+							Certificates: []*x509.Certificate{&renewed, chain[1]},
+						},
+					}, nil,
+				)
+				return Handler(s)
+			},
+			ResponseFile: "testdata/trc-diff.json",
+			RequestURL:   "/trcs/diff?a=isd1-b1-s1&b=isd1-b1-s2",
+			Status:       200,
+		},
+		"trc diff inexistent": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				sto := mock_storage.NewMockTrustDB(ctrl)
+				s := &Server{TrustDB: sto}
+				sto.EXPECT().SignedTRC(gomock.Any(), cppki.TRCID{
+					ISD:    addr.ISD(1),
+					Serial: scrypto.Version(1),
+					Base:   scrypto.Version(1),
+				}).Return(
+					cppki.SignedTRC{
+						TRC: cppki.TRC{
+							ID: cppki.TRCID{ISD: 1, Serial: 1, Base: 1},
+						},
+					}, nil,
+				)
+				sto.EXPECT().SignedTRC(gomock.Any(), cppki.TRCID{
+					ISD:    addr.ISD(1),
+					Serial: scrypto.Version(2),
+					Base:   scrypto.Version(1),
+				}).Return(
+					cppki.SignedTRC{}, nil,
+				)
+				return Handler(s)
+			},
+			ResponseFile: "testdata/trc-diff-inexistent.json",
+			RequestURL:   "/trcs/diff?a=isd1-b1-s1&b=isd1-b1-s2",
+			Status:       404,
+		},
+		"trc diff malformed": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				sto := mock_storage.NewMockTrustDB(ctrl)
+				s := &Server{TrustDB: sto}
+				return Handler(s)
+			},
+			ResponseFile: "testdata/trc-diff-malformed.json",
+			RequestURL:   "/trcs/diff?a=isd1-b1&b=1-ff00:0:110",
+			Status:       http.StatusBadRequest,
+		},
 		"certificates": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				db := mock_storage.NewMockTrustDB(ctrl)
@@ -264,6 +353,57 @@ func TestAPI(t *testing.T) {
 			RequestURL:   "/certificates",
 			Status:       200,
 		},
+		"certificates include superseded": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				db := mock_storage.NewMockTrustDB(ctrl)
+				s := &Server{TrustDB: db}
+
+				chain, err := cppki.ReadPEMCerts(filepath.Join("testdata", "signer-chain.crt"))
+				require.NoError(t, err)
+				older := *chain[0]
+				older.NotBefore = older.NotBefore.Add(-24 * time.Hour)
+				older.NotAfter = older.NotAfter.Add(-24 * time.Hour)
+
+				db.EXPECT().Chains(gomock.Any(), trust.ChainQuery{}).Return(
+					[][]*x509.Certificate{{&older, chain[1]}, chain}, nil,
+				)
+				return Handler(s)
+			},
+			ResponseFile: "testdata/certificates-superseded.json",
+			RequestURL:   "/certificates?include_superseded=true",
+			Status:       200,
+		},
+		"certificates expiring between": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				db := mock_storage.NewMockTrustDB(ctrl)
+				s := &Server{TrustDB: db}
+
+				chain, err := cppki.ReadPEMCerts(filepath.Join("testdata", "signer-chain.crt"))
+				require.NoError(t, err)
+				later := *chain[0]
+				later.NotAfter = later.NotAfter.Add(30 * 24 * time.Hour)
+
+				db.EXPECT().Chains(gomock.Any(), trust.ChainQuery{}).Return(
+					[][]*x509.Certificate{chain, {&later, chain[1]}}, nil,
+				)
+				return Handler(s)
+			},
+			ResponseFile: "testdata/certificates-expiring.json",
+			RequestURL: "/certificates?all=true" +
+				"&expiring_between=2022-02-01T00:00:00Z,2022-02-20T00:00:00Z",
+			Status: 200,
+		},
+		"certificates expiring between unordered": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				db := mock_storage.NewMockTrustDB(ctrl)
+				s := &Server{TrustDB: db}
+				return Handler(s)
+			},
+			ResponseFile: "testdata/certificates-expiring-unordered.json",
+			RequestURL: "/certificates" +
+				"?expiring_between=2022-02-20T00:00:00Z,2022-02-01T00:00:00Z",
+			Status: http.StatusBadRequest,
+		},
 		"certificates malformed": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				db := mock_storage.NewMockTrustDB(ctrl)
@@ -360,3 +500,51 @@ func TestAPI(t *testing.T) {
 		})
 	}
 }
+
+// TestBlobETag tests that the TRC and certificate chain blobs carry an ETag and
+// that a request with a matching If-None-Match header is answered with 304.
+func TestBlobETag(t *testing.T) {
+	chain, err := cppki.ReadPEMCerts(filepath.Join("testdata", "signer-chain.crt"))
+	require.NoError(t, err)
+	trcID := cppki.TRCID{ISD: 1, Serial: 1, Base: 1}
+
+	testCases := map[string]string{
+		"trc blob":         "/trcs/isd1-b1-s1/blob",
+		"certificate blob": "/certificates/aabbcc/blob",
+	}
+	for name, url := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := mock_storage.NewMockTrustDB(ctrl)
+			db.EXPECT().SignedTRC(gomock.Any(), trcID).AnyTimes().Return(
+				cppki.SignedTRC{TRC: cppki.TRC{ID: trcID, Raw: []byte{0x11}}}, nil,
+			)
+			db.EXPECT().Chain(gomock.Any(), gomock.Any()).AnyTimes().Return(chain, nil)
+			h := Handler(&Server{TrustDB: db})
+
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code)
+			etag := rr.Header().Get("ETag")
+			require.NotEmpty(t, etag)
+			assert.Equal(t, "max-age=60", rr.Header().Get("Cache-Control"))
+			assert.Equal(t, "application/x-pem-file", rr.Header().Get("Content-Type"))
+
+			req = httptest.NewRequest(http.MethodGet, url, nil)
+			req.Header.Set("If-None-Match", etag)
+			rr = httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+			assert.Equal(t, http.StatusNotModified, rr.Code)
+			assert.Equal(t, etag, rr.Header().Get("ETag"))
+			assert.Empty(t, rr.Body.String())
+
+			req = httptest.NewRequest(http.MethodGet, url, nil)
+			req.Header.Set("If-None-Match", `"stale"`)
+			rr = httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.NotEmpty(t, rr.Body.String())
+		})
+	}
+}
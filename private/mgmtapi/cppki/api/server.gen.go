@@ -25,6 +25,9 @@ type ServerInterface interface {
 	// List the TRCs
 	// (GET /trcs)
 	GetTrcs(w http.ResponseWriter, r *http.Request, params GetTrcsParams)
+	// Compare two TRCs
+	// (GET /trcs/diff)
+	GetTrcDiff(w http.ResponseWriter, r *http.Request, params GetTrcDiffParams)
 	// Get the TRC
 	// (GET /trcs/isd{isd}-b{base}-s{serial})
 	GetTrc(w http.ResponseWriter, r *http.Request, isd int, base int, serial int)
@@ -61,6 +64,12 @@ func (_ Unimplemented) GetTrcs(w http.ResponseWriter, r *http.Request, params Ge
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Compare two TRCs
+// (GET /trcs/diff)
+func (_ Unimplemented) GetTrcDiff(w http.ResponseWriter, r *http.Request, params GetTrcDiffParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the TRC
 // (GET /trcs/isd{isd}-b{base}-s{serial})
 func (_ Unimplemented) GetTrc(w http.ResponseWriter, r *http.Request, isd int, base int, serial int) {
@@ -115,6 +124,22 @@ func (siw *ServerInterfaceWrapper) GetCertificates(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Optional query parameter "include_superseded" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_superseded", r.URL.Query(), &params.IncludeSuperseded)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_superseded", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "expiring_between" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "expiring_between", r.URL.Query(), &params.ExpiringBetween)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expiring_between", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetCertificates(w, r, params)
 	}))
@@ -214,6 +239,56 @@ func (siw *ServerInterfaceWrapper) GetTrcs(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetTrcDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetTrcDiff(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrcDiffParams
+
+	// ------------- Required query parameter "a" -------------
+
+	if paramValue := r.URL.Query().Get("a"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "a"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "a", r.URL.Query(), &params.A)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "a", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "b" -------------
+
+	if paramValue := r.URL.Query().Get("b"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "b"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "b", r.URL.Query(), &params.B)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "b", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrcDiff(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetTrc operation middleware
 func (siw *ServerInterfaceWrapper) GetTrc(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -427,6 +502,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/trcs", wrapper.GetTrcs)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trcs/diff", wrapper.GetTrcDiff)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/trcs/isd{isd}-b{base}-s{serial}", wrapper.GetTrc)
 	})
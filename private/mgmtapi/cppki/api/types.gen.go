@@ -24,10 +24,13 @@ type Chain struct {
 
 // ChainBrief defines model for ChainBrief.
 type ChainBrief struct {
-	Id       ChainID  `json:"id"`
-	Issuer   IsdAs    `json:"issuer"`
-	Subject  IsdAs    `json:"subject"`
-	Validity Validity `json:"validity"`
+	Id      ChainID `json:"id"`
+	Issuer  IsdAs   `json:"issuer"`
+	Subject IsdAs   `json:"subject"`
+
+	// Superseded Whether a newer chain for the same AS exists. Only present if superseded chains were requested.
+	Superseded *bool    `json:"superseded,omitempty"`
+	Validity   Validity `json:"validity"`
 }
 
 // ChainID defines model for ChainID.
@@ -77,6 +80,42 @@ type TRCBrief struct {
 	Id TRCID `json:"id"`
 }
 
+// TRCCertificate defines model for TRCCertificate.
+type TRCCertificate struct {
+	DistinguishedName string       `json:"distinguished_name"`
+	IsdAs             *IsdAs       `json:"isd_as,omitempty"`
+	SubjectKeyId      SubjectKeyID `json:"subject_key_id"`
+	Type              string       `json:"type"`
+	Validity          Validity     `json:"validity"`
+}
+
+// TRCCertificateChange defines model for TRCCertificateChange.
+type TRCCertificateChange struct {
+	A TRCCertificate `json:"a"`
+	B TRCCertificate `json:"b"`
+}
+
+// TRCCertificatesDiff defines model for TRCCertificatesDiff.
+type TRCCertificatesDiff struct {
+	// Added Certificates that are only in the second TRC.
+	Added []TRCCertificate `json:"added"`
+
+	// Changed Certificates with the same type and distinguished name that differ between the TRCs.
+	Changed []TRCCertificateChange `json:"changed"`
+
+	// Removed Certificates that are only in the first TRC.
+	Removed []TRCCertificate `json:"removed"`
+}
+
+// TRCDiff defines model for TRCDiff.
+type TRCDiff struct {
+	A            TRCID               `json:"a"`
+	B            TRCID               `json:"b"`
+	Certificates TRCCertificatesDiff `json:"certificates"`
+	Quorum       *TRCQuorumChange    `json:"quorum,omitempty"`
+	Validity     *TRCValidityChange  `json:"validity,omitempty"`
+}
+
 // TRCID defines model for TRCID.
 type TRCID struct {
 	BaseNumber   int `json:"base_number"`
@@ -84,6 +123,18 @@ type TRCID struct {
 	SerialNumber int `json:"serial_number"`
 }
 
+// TRCQuorumChange defines model for TRCQuorumChange.
+type TRCQuorumChange struct {
+	A int `json:"a"`
+	B int `json:"b"`
+}
+
+// TRCValidityChange defines model for TRCValidityChange.
+type TRCValidityChange struct {
+	A Validity `json:"a"`
+	B Validity `json:"b"`
+}
+
 // Validity defines model for Validity.
 type Validity struct {
 	NotAfter  time.Time `json:"not_after"`
@@ -98,6 +149,12 @@ type GetCertificatesParams struct {
 	IsdAs   *IsdAs     `form:"isd_as,omitempty" json:"isd_as,omitempty"`
 	ValidAt *time.Time `form:"valid_at,omitempty" json:"valid_at,omitempty"`
 	All     *bool      `form:"all,omitempty" json:"all,omitempty"`
+
+	// IncludeSuperseded Include chains that were valid before the requested point in time, even if they have expired since. The returned chains are marked as superseded if a newer chain for the same AS is part of the result.
+	IncludeSuperseded *bool `form:"include_superseded,omitempty" json:"include_superseded,omitempty"`
+
+	// ExpiringBetween Start and end of a time window, separated by a comma. Only chains whose AS certificate expires within the window, both ends included, are returned. The start must not be after the end.
+	ExpiringBetween *[]time.Time `form:"expiring_between,omitempty" json:"expiring_between,omitempty"`
 }
 
 // GetTrcsParams defines parameters for GetTrcs.
@@ -105,3 +162,12 @@ type GetTrcsParams struct {
 	Isd *[]int `form:"isd,omitempty" json:"isd,omitempty"`
 	All *bool  `form:"all,omitempty" json:"all,omitempty"`
 }
+
+// GetTrcDiffParams defines parameters for GetTrcDiff.
+type GetTrcDiffParams struct {
+	// A Identifier of the first TRC, in the form isd{isd}-b{base}-s{serial}.
+	A string `form:"a" json:"a"`
+
+	// B Identifier of the second TRC, in the form isd{isd}-b{base}-s{serial}.
+	B string `form:"b" json:"b"`
+}
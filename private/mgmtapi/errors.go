@@ -21,4 +21,18 @@ const (
 	Forbidden      = "/problems/forbidden"
 	NotFound       = "/problems/not-found"
 	NotImplemented = "/problems/not-implemented"
+	// CANotConfigured indicates that the service is not configured to act as
+	// a CA. It is distinct from NotImplemented so that clients can tell a
+	// missing capability apart from a missing feature.
+	CANotConfigured = "/problems/ca-not-configured"
+	// NotAcceptable indicates that none of the media types that the client
+	// accepts can be produced.
+	NotAcceptable = "/problems/not-acceptable"
+	// ServiceUnavailable indicates that the service is temporarily unable to
+	// handle the request, e.g., because it is overloaded. The request can be
+	// retried later.
+	ServiceUnavailable = "/problems/service-unavailable"
+	// PayloadTooLarge indicates that the request body exceeds the size limit of
+	// the service. The request must not be retried unchanged.
+	PayloadTooLarge = "/problems/payload-too-large"
 )
@@ -0,0 +1,46 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/scionproto/scion/private/mgmtapi"
+)
+
+func TestETagMatches(t *testing.T) {
+	etag := api.ContentETag([]byte("blob"))
+	assert.NotEqual(t, etag, api.ContentETag([]byte("other blob")))
+
+	testCases := map[string]struct {
+		Header string
+		Match  bool
+	}{
+		"empty":    {Header: "", Match: false},
+		"exact":    {Header: etag, Match: true},
+		"weak":     {Header: "W/" + etag, Match: true},
+		"wildcard": {Header: "*", Match: true},
+		"list":     {Header: `"other", ` + etag, Match: true},
+		"mismatch": {Header: `"other"`, Match: false},
+		"unquoted": {Header: etag[1 : len(etag)-1], Match: false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.Match, api.ETagMatches(tc.Header, etag))
+		})
+	}
+}
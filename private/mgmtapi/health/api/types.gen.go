@@ -51,3 +51,18 @@ type Status string
 
 // BadRequest defines model for BadRequest.
 type BadRequest = StandardError
+
+// GetHealthParams defines parameters for GetHealth.
+type GetHealthParams struct {
+	// Wait Long-poll duration, e.g. `30s`. If set, the request is held open until the status of a health check changes or the duration elapses, whichever comes first. The duration must not exceed 5 minutes.
+	Wait *string `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// Status Only list the health checks with one of the given statuses. The overall status still reflects all health checks.
+	Status *[]Status `form:"status,omitempty" json:"status,omitempty"`
+
+	// Check Only report the health check with the given name. The overall status and the HTTP status code are derived from that check alone. An unknown name results in a bad request.
+	Check *string `form:"check,omitempty" json:"check,omitempty"`
+
+	// Checks Only evaluate the health checks of the given groups, e.g., `signer` and `trc`. The overall status is derived from the evaluated checks alone. Supported groups are `signer`, `trc`, `ca`, `beacons` and `certificates`. An unknown group results in a bad request.
+	Checks *[]string `form:"checks,omitempty" json:"checks,omitempty"`
+}
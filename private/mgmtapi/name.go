@@ -0,0 +1,104 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// attributeTypeNames are the short names of the attribute types that are
+// rendered by name in distinguished names, keyed by their OID.
+var attributeTypeNames = map[string]string{
+	"2.5.4.6":  "C",
+	"2.5.4.10": "O",
+	"2.5.4.11": "OU",
+	"2.5.4.3":  "CN",
+	"2.5.4.5":  "SERIALNUMBER",
+	"2.5.4.7":  "L",
+	"2.5.4.8":  "ST",
+	"2.5.4.9":  "STREET",
+	"2.5.4.17": "POSTALCODE",
+}
+
+// DistinguishedName renders the name in the string representation of
+// distinguished names (RFC 4514), with the attributes in the same order as
+// pkix.Name.String. Attributes of other types than the ones that pkix.Name
+// parses into named fields, e.g., the ISD-AS, are rendered as the hex encoding
+// of their DER value. Unlike pkix.Name.String, the representation does not
+// depend on the Go version.
+func DistinguishedName(name pkix.Name) string {
+	var rdns pkix.RDNSequence
+	// Like pkix.Name.String, surface the parsed attributes that have no named
+	// field if there are no extra names, such that they end up at the end of
+	// the string.
+	if name.ExtraNames == nil {
+		for _, atv := range name.Names {
+			if _, ok := attributeTypeNames[atv.Type.String()]; ok {
+				continue
+			}
+			rdns = append(rdns, []pkix.AttributeTypeAndValue{atv})
+		}
+	}
+	rdns = append(rdns, name.ToRDNSequence()...)
+
+	var b strings.Builder
+	for i := len(rdns) - 1; i >= 0; i-- {
+		if i != len(rdns)-1 {
+			b.WriteByte(',')
+		}
+		for j, atv := range rdns[i] {
+			if j != 0 {
+				b.WriteByte('+')
+			}
+			writeAttribute(&b, atv)
+		}
+	}
+	return b.String()
+}
+
+// writeAttribute writes the attribute as type=value, escaping the special
+// characters of the value.
+func writeAttribute(b *strings.Builder, atv pkix.AttributeTypeAndValue) {
+	oid := atv.Type.String()
+	name, ok := attributeTypeNames[oid]
+	if !ok {
+		if der, err := asn1.Marshal(atv.Value); err == nil {
+			b.WriteString(oid + "=#" + hex.EncodeToString(der))
+			return
+		}
+		name = oid
+	}
+	b.WriteString(name + "=")
+	value := fmt.Sprint(atv.Value)
+	for i, c := range value {
+		escape := false
+		switch c {
+		case ',', '+', '"', '\\', '<', '>', ';':
+			escape = true
+		case ' ':
+			escape = i == 0 || i == len(value)-1
+		case '#':
+			escape = i == 0
+		}
+		if escape {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi_test
+
+import (
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/scionproto/scion/pkg/scrypto/cppki"
+	api "github.com/scionproto/scion/private/mgmtapi"
+)
+
+func TestDistinguishedName(t *testing.T) {
+	testCases := map[string]struct {
+		Name     pkix.Name
+		Expected string
+	}{
+		"extra names": {
+			Name: pkix.Name{
+				Country:    []string{"CH"},
+				Locality:   []string{"Zürich"},
+				CommonName: "1-ff00:0:110 AS Certificate",
+				ExtraNames: []pkix.AttributeTypeAndValue{
+					{Type: cppki.OIDNameIA, Value: "1-ff00:0:110"},
+				},
+			},
+			Expected: "1.3.6.1.4.1.55324.1.2.1=#130c312d666630303a303a313130," +
+				"CN=1-ff00:0:110 AS Certificate,L=Zürich,C=CH",
+		},
+		"parsed names": {
+			Name: pkix.Name{
+				Country:    []string{"CH"},
+				CommonName: "1-ff00:0:110 AS Certificate",
+				Names: []pkix.AttributeTypeAndValue{
+					{Type: []int{2, 5, 4, 6}, Value: "CH"},
+					{Type: []int{2, 5, 4, 3}, Value: "1-ff00:0:110 AS Certificate"},
+					{Type: cppki.OIDNameIA, Value: "1-ff00:0:110"},
+				},
+			},
+			Expected: "CN=1-ff00:0:110 AS Certificate,C=CH," +
+				"1.3.6.1.4.1.55324.1.2.1=#130c312d666630303a303a313130",
+		},
+		"escaped": {
+			Name: pkix.Name{
+				Organization: []string{"#ACME, Inc. "},
+			},
+			Expected: `O=\#ACME\, Inc.\ `,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, api.DistinguishedName(tc.Name))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// Problem is the problem details object (RFC 7807) that the management APIs
+// respond with. It has the same fields as the Problem types that are generated
+// for the individual APIs, such that it can be converted to them.
+type Problem struct {
+	Detail   *string `json:"detail,omitempty"`
+	Instance *string `json:"instance,omitempty"`
+	Status   int     `json:"status"`
+	Title    string  `json:"title"`
+	Type     *string `json:"type,omitempty"`
+}
+
+// QueryProblem describes the failure of a database query that ran with the
+// given context. A query that ran into the deadline of its context is reported
+// with status 503, such that the client can retry it later. Other errors are
+// reported with status 500 and the given title.
+func QueryProblem(ctx context.Context, err error, title string) Problem {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return Problem{
+			Detail: StringRef(err.Error()),
+			Status: http.StatusServiceUnavailable,
+			Title:  "database query timed out",
+			Type:   StringRef(ServiceUnavailable),
+		}
+	}
+	return Problem{
+		Detail: StringRef(err.Error()),
+		Status: http.StatusInternalServerError,
+		Title:  title,
+		Type:   StringRef(InternalError),
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Anapaya Systems
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgmtapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/scionproto/scion/private/mgmtapi"
+)
+
+func TestQueryProblem(t *testing.T) {
+	expired, cancel := context.WithDeadline(context.Background(), time.Time{})
+	defer cancel()
+
+	testCases := map[string]struct {
+		Ctx    context.Context
+		Err    error
+		Status int
+		Title  string
+		Type   string
+	}{
+		"failure": {
+			Ctx:    context.Background(),
+			Err:    errors.New("disk on fire"),
+			Status: http.StatusInternalServerError,
+			Title:  "error getting beacons",
+			Type:   api.InternalError,
+		},
+		"deadline error": {
+			Ctx:    context.Background(),
+			Err:    fmt.Errorf("querying: %w", context.DeadlineExceeded),
+			Status: http.StatusServiceUnavailable,
+			Title:  "database query timed out",
+			Type:   api.ServiceUnavailable,
+		},
+		"expired context": {
+			Ctx:    expired,
+			Err:    errors.New("interrupted"),
+			Status: http.StatusServiceUnavailable,
+			Title:  "database query timed out",
+			Type:   api.ServiceUnavailable,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := api.QueryProblem(tc.Ctx, tc.Err, "error getting beacons")
+			assert.Equal(t, tc.Status, p.Status)
+			assert.Equal(t, tc.Title, p.Title)
+			assert.Equal(t, tc.Type, *p.Type)
+			assert.Equal(t, tc.Err.Error(), *p.Detail)
+		})
+	}
+}
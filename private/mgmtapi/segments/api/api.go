@@ -17,22 +17,36 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"net/http"
 	"sort"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
 
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/private/serrors"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
+	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+	"github.com/scionproto/scion/pkg/scrypto/signed"
 	seg "github.com/scionproto/scion/pkg/segment"
+	"github.com/scionproto/scion/pkg/segment/extensions/digest"
 	api "github.com/scionproto/scion/private/mgmtapi"
 	"github.com/scionproto/scion/private/pathdb/query"
 )
 
+// ProtobufContentType is the media type of a path segment that is encoded as
+// the raw protobuf message.
+const ProtobufContentType = "application/x-protobuf"
+
+// PEMContentType is the media type of a path segment that is encoded as the
+// raw protobuf message in a PEM block.
+const PEMContentType = "application/x-pem-file"
+
 type SegmentStore interface {
 	Get(context.Context, *query.Params) (query.Results, error)
 	DeleteSegment(ctx context.Context, partialID string) error
@@ -60,6 +74,9 @@ func (s *Server) GetSegments(w http.ResponseWriter, r *http.Request, params GetS
 			errs = append(errs, serrors.Wrap("invalid end ISD_AS", err))
 		}
 	}
+	if params.GroupBy != nil && *params.GroupBy != Type {
+		errs = append(errs, serrors.New("unknown value for parameter", "group_by", *params.GroupBy))
+	}
 	if err := errs.ToError(); err != nil {
 		Error(w, Problem{
 			Detail: api.StringRef(err.Error()),
@@ -71,23 +88,23 @@ func (s *Server) GetSegments(w http.ResponseWriter, r *http.Request, params GetS
 	}
 	res, err := s.Segments.Get(r.Context(), &q)
 	if err != nil {
-		Error(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "error getting segments",
-			Type:   api.StringRef(api.InternalError),
-		})
+		Error(w, Problem(api.QueryProblem(r.Context(), err, "error getting segments")))
 		return
 	}
 	sort.Sort(res)
-	rep := make([]*SegmentBrief, 0, len(res))
-	for _, segRes := range res {
-		rep = append(rep, &SegmentBrief{
-			Id:         SegID(segRes.Seg),
-			StartIsdAs: segRes.Seg.FirstIA().String(),
-			EndIsdAs:   segRes.Seg.LastIA().String(),
-			Length:     len(segRes.Seg.ASEntries),
-		})
+	var rep any
+	if params.GroupBy != nil {
+		rep = groupByType(res)
+	} else {
+		briefs := make([]*SegmentBrief, 0, len(res))
+		for _, segRes := range res {
+			briefs = append(briefs, segmentBrief(segRes.Seg))
+		}
+		rep = briefs
+	}
+	if api.AcceptsCBOR(r) {
+		writeCBOR(w, rep)
+		return
 	}
 	enc := json.NewEncoder(w)
 	enc.SetIndent("", "    ")
@@ -102,9 +119,74 @@ func (s *Server) GetSegments(w http.ResponseWriter, r *http.Request, params GetS
 	}
 }
 
+// groupByType groups the segments by the type with which they are stored.
+func groupByType(res query.Results) SegmentsByType {
+	rep := SegmentsByType{
+		Up:   []SegmentBrief{},
+		Core: []SegmentBrief{},
+		Down: []SegmentBrief{},
+	}
+	for _, segRes := range res {
+		brief := *segmentBrief(segRes.Seg)
+		switch segRes.Type {
+		case seg.TypeUp:
+			rep.Up = append(rep.Up, brief)
+		case seg.TypeCore:
+			rep.Core = append(rep.Core, brief)
+		case seg.TypeDown:
+			rep.Down = append(rep.Down, brief)
+		}
+	}
+	return rep
+}
+
+func segmentBrief(s *seg.PathSegment) *SegmentBrief {
+	return &SegmentBrief{
+		Id:         SegID(s),
+		StartIsdAs: s.FirstIA().String(),
+		EndIsdAs:   s.LastIA().String(),
+		Length:     len(s.ASEntries),
+	}
+}
+
+// decodeSegmentID decodes a hex-encoded segment ID. Segments are looked up by
+// their full ID, hence IDs of any other length are rejected before querying the
+// segment store.
+func decodeSegmentID(segmentID SegmentID) ([]byte, error) {
+	id, err := hex.DecodeString(segmentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(id) != sha256.Size {
+		return nil, serrors.New("invalid segment ID length",
+			"length", len(id), "expected", sha256.Size)
+	}
+	return id, nil
+}
+
+// validateSegmentIDPrefix checks that the segment ID is a hex encoded prefix
+// of a segment ID. The empty prefix is rejected, because it matches every
+// segment.
+func validateSegmentIDPrefix(segmentID SegmentID) error {
+	if segmentID == "" {
+		return serrors.New("segment ID is required")
+	}
+	if len(segmentID) > hex.EncodedLen(sha256.Size) {
+		return serrors.New("invalid segment ID length",
+			"length", len(segmentID), "max", hex.EncodedLen(sha256.Size))
+	}
+	for i, c := range segmentID {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return serrors.New("invalid hex character in segment ID",
+				"position", i, "character", string(c))
+		}
+	}
+	return nil
+}
+
 // GetSegment gets a segments details specified by its ID.
 func (s *Server) GetSegment(w http.ResponseWriter, r *http.Request, segmentID SegmentID) {
-	id, err := hex.DecodeString(segmentID)
+	id, err := decodeSegmentID(segmentID)
 	if err != nil {
 		Error(w, Problem{
 			Detail: api.StringRef(err.Error()),
@@ -117,12 +199,7 @@ func (s *Server) GetSegment(w http.ResponseWriter, r *http.Request, segmentID Se
 	q := query.Params{SegIDs: [][]byte{id}}
 	resp, err := s.Segments.Get(r.Context(), &q)
 	if err != nil {
-		Error(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "error getting segments",
-			Type:   api.StringRef(api.InternalError),
-		})
+		Error(w, Problem(api.QueryProblem(r.Context(), err, "error getting segments")))
 		return
 	}
 	if len(resp) != 1 {
@@ -138,6 +215,21 @@ func (s *Server) GetSegment(w http.ResponseWriter, r *http.Request, segmentID Se
 		return
 	}
 	segRes := resp[0]
+	if api.AcceptsMediaType(r, ProtobufContentType) {
+		raw, err := proto.Marshal(seg.PathSegmentToPB(segRes.Seg))
+		if err != nil {
+			Error(w, Problem{
+				Detail: api.StringRef(err.Error()),
+				Status: http.StatusInternalServerError,
+				Title:  "unable to marshal response",
+				Type:   api.StringRef(api.InternalError),
+			})
+			return
+		}
+		w.Header().Set("Content-Type", ProtobufContentType)
+		_, _ = w.Write(raw)
+		return
+	}
 	var hops []Hop
 	for i, as := range segRes.Seg.ASEntries {
 		if i != 0 {
@@ -160,6 +252,13 @@ func (s *Server) GetSegment(w http.ResponseWriter, r *http.Request, segmentID Se
 		LastUpdated: segRes.LastUpdate.UTC(),
 		Hops:        hops,
 	}
+	if warnings := ParseWarnings(segRes.Seg); len(warnings) != 0 {
+		rep.ParseWarnings = &warnings
+	}
+	if api.AcceptsCBOR(r) {
+		writeCBOR(w, rep)
+		return
+	}
 
 	enc := json.NewEncoder(w)
 	enc.SetIndent("", "    ")
@@ -174,32 +273,57 @@ func (s *Server) GetSegment(w http.ResponseWriter, r *http.Request, segmentID Se
 	}
 }
 
+// writeCBOR writes the CBOR encoded response for clients that negotiated CBOR
+// instead of JSON.
+func writeCBOR(w http.ResponseWriter, rep any) {
+	if err := api.WriteCBOR(w, rep); err != nil {
+		Error(w, Problem{
+			Detail: api.StringRef(err.Error()),
+			Status: http.StatusInternalServerError,
+			Title:  "unable to marshal response",
+			Type:   api.StringRef(api.InternalError),
+		})
+	}
+}
+
+// DeleteSegment deletes the segments whose ID starts with the given ID. This
+// allows deleting segments by their logging ID, which is a prefix of the full
+// segment ID.
 func (s *Server) DeleteSegment(w http.ResponseWriter, r *http.Request, segmentId SegmentID) {
-	if segmentId == "" {
+	if err := validateSegmentIDPrefix(segmentId); err != nil {
 		Error(w, Problem{
+			Detail: api.StringRef(err.Error()),
 			Status: http.StatusBadRequest,
-			Title:  "segment ID is required",
+			Title:  "malformed query parameters",
 			Type:   api.StringRef(api.BadRequest),
 		})
 		return
 	}
 	if err := s.Segments.DeleteSegment(r.Context(), segmentId); err != nil {
-		Error(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "unable to delete segment",
-			Type:   api.StringRef(api.InternalError),
-		})
+		Error(w, Problem(api.QueryProblem(r.Context(), err, "unable to delete segment")))
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
 // GetSegmentBlob gets a segment (specified by its ID) as a pem encoded blob.
+// If the client only accepts protobuf, the raw protobuf message is returned.
 func (s *Server) GetSegmentBlob(w http.ResponseWriter, r *http.Request, segmentID SegmentID) {
-	w.Header().Set("Content-Type", "application/x-pem-file")
+	contentType, ok := api.NegotiateMediaType(r, PEMContentType, ProtobufContentType)
+	if !ok {
+		Error(w, Problem{
+			Detail: api.StringRef(fmt.Sprintf(
+				"supported media types are %s and %s", PEMContentType, ProtobufContentType,
+			)),
+			Status: http.StatusNotAcceptable,
+			Title:  "unsupported media type",
+			Type:   api.StringRef(api.NotAcceptable),
+		})
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
 
-	id, err := hex.DecodeString(segmentID)
+	id, err := decodeSegmentID(segmentID)
 	if err != nil {
 		Error(w, Problem{
 			Detail: api.StringRef(err.Error()),
@@ -212,12 +336,7 @@ func (s *Server) GetSegmentBlob(w http.ResponseWriter, r *http.Request, segmentI
 	q := query.Params{SegIDs: [][]byte{id}}
 	resp, err := s.Segments.Get(r.Context(), &q)
 	if err != nil {
-		Error(w, Problem{
-			Detail: api.StringRef(err.Error()),
-			Status: http.StatusInternalServerError,
-			Title:  "error getting segments",
-			Type:   api.StringRef(api.InternalError),
-		})
+		Error(w, Problem(api.QueryProblem(r.Context(), err, "error getting segments")))
 		return
 	}
 	if len(resp) != 1 {
@@ -244,6 +363,10 @@ func (s *Server) GetSegmentBlob(w http.ResponseWriter, r *http.Request, segmentI
 		})
 		return
 	}
+	if contentType == ProtobufContentType {
+		_, _ = w.Write(bytes)
+		return
+	}
 	b := &pem.Block{
 		Type:  "PATH SEGMENT",
 		Bytes: bytes,
@@ -263,6 +386,62 @@ func (s *Server) GetSegmentBlob(w http.ResponseWriter, r *http.Request, segmentI
 // SegID makes a hex encoded string of the segment id.
 func SegID(s *seg.PathSegment) string { return fmt.Sprintf("%x", s.ID()) }
 
+// ParseWarnings decodes the signed AS entries of the segment again and
+// reports all information that could not be decoded completely, and was thus
+// dropped from the parsed segment. AS entries without a signed representation
+// are skipped.
+func ParseWarnings(s *seg.PathSegment) []string {
+	var warnings []string
+	for i, as := range s.ASEntries {
+		if as.Signed == nil {
+			continue
+		}
+		for _, w := range asEntryWarnings(as.Signed) {
+			warnings = append(warnings, fmt.Sprintf("AS entry %d (%s): %s", i, as.Local, w))
+		}
+	}
+	return warnings
+}
+
+func asEntryWarnings(sm *cryptopb.SignedMessage) []string {
+	body, err := signed.ExtractUnverifiedBody(sm)
+	if err != nil {
+		return []string{fmt.Sprintf("extracting signed body: %s", err)}
+	}
+	var entry cppb.ASEntrySignedBody
+	if err := proto.Unmarshal(body, &entry); err != nil {
+		return []string{fmt.Sprintf("decoding signed body: %s", err)}
+	}
+	var warnings []string
+	if len(entry.ProtoReflect().GetUnknown()) != 0 {
+		warnings = append(warnings, "unknown fields in signed body")
+	}
+	if entry.HopEntry == nil || entry.HopEntry.HopField == nil {
+		warnings = append(warnings, "missing hop field")
+	}
+	for i, peer := range entry.PeerEntries {
+		if peer == nil || peer.HopField == nil {
+			warnings = append(warnings, fmt.Sprintf("missing hop field in peer entry %d", i))
+		}
+	}
+	ext := entry.Extensions
+	if ext == nil {
+		return warnings
+	}
+	if len(ext.ProtoReflect().GetUnknown()) != 0 {
+		warnings = append(warnings, "unknown extensions")
+	}
+	if ext.StaticInfo != nil && len(ext.StaticInfo.ProtoReflect().GetUnknown()) != 0 {
+		warnings = append(warnings, "unknown fields in static info extension")
+	}
+	if d := ext.Digests; d != nil && d.Epic != nil && len(d.Epic.Digest) != digest.DigestLength {
+		warnings = append(warnings, fmt.Sprintf(
+			"EPIC digest has length %d, expected %d", len(d.Epic.Digest), digest.DigestLength,
+		))
+	}
+	return warnings
+}
+
 // Error creates an detailed error response.
 func Error(w http.ResponseWriter, p Problem) {
 	w.Header().Set("Content-Type", "application/problem+json")
@@ -25,13 +25,16 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/scionproto/scion/pkg/addr"
 	"github.com/scionproto/scion/pkg/private/serrors"
 	"github.com/scionproto/scion/pkg/private/xtest"
 	"github.com/scionproto/scion/pkg/private/xtest/graph"
+	cppb "github.com/scionproto/scion/pkg/proto/control_plane"
 	cryptopb "github.com/scionproto/scion/pkg/proto/crypto"
+	"github.com/scionproto/scion/pkg/scrypto/signed"
 	seg "github.com/scionproto/scion/pkg/segment"
 	"github.com/scionproto/scion/pkg/segment/mock_seg"
 	"github.com/scionproto/scion/pkg/slayers/path"
@@ -108,6 +111,34 @@ func TestAPI(t *testing.T) {
 			RequestURL:   "/segments?start_isd_as=1-ff00:0:110&end_isd_as=1-ff00:0:112",
 			Status:       200,
 		},
+		"segments grouped by type": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				seg := mock_api.NewMockSegmentStore(ctrl)
+				s := &Server{
+					Segments: seg,
+				}
+				dbresult := createSegs(t, graph.NewSigner())
+				seg.EXPECT().Get(gomock.Any(), &query.Params{}).AnyTimes().Return(
+					dbresult, nil,
+				)
+				return Handler(s)
+			},
+			ResponseFile: "testdata/segments-by-type.json",
+			RequestURL:   "/segments?group_by=type",
+			Status:       200,
+		},
+		"segments unknown group": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				seg := mock_api.NewMockSegmentStore(ctrl)
+				s := &Server{
+					Segments: seg,
+				}
+				return Handler(s)
+			},
+			ResponseFile: "testdata/segments-unknown-group.json",
+			RequestURL:   "/segments?group_by=origin",
+			Status:       400,
+		},
 		"segments malformed query parameters": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				seg := mock_api.NewMockSegmentStore(ctrl)
@@ -139,6 +170,26 @@ func TestAPI(t *testing.T) {
 			RequestURL:   "/segments/" + id1,
 			Status:       200,
 		},
+		"segment parse warnings": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				seg := mock_api.NewMockSegmentStore(ctrl)
+				q := query.Params{
+					SegIDs: [][]byte{xtest.MustParseHexString(id1)},
+				}
+				s := &Server{
+					Segments: seg,
+				}
+				dbresult := createSegs(t, graph.NewSigner())[:1]
+				corruptASEntry(t, &dbresult[0].Seg.ASEntries[1])
+				seg.EXPECT().Get(gomock.Any(), &q).AnyTimes().Return(
+					dbresult, nil,
+				)
+				return Handler(s)
+			},
+			ResponseFile: "testdata/segments-by-id-parse-warnings.json",
+			RequestURL:   "/segments/" + id1,
+			Status:       200,
+		},
 		"segment invalid id": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				seg := mock_api.NewMockSegmentStore(ctrl)
@@ -161,6 +212,18 @@ func TestAPI(t *testing.T) {
 			RequestURL:   "/segments/r",
 			Status:       400,
 		},
+		"segment invalid id length": {
+			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
+				seg := mock_api.NewMockSegmentStore(ctrl)
+				s := &Server{
+					Segments: seg,
+				}
+				return Handler(s)
+			},
+			ResponseFile: "testdata/segments-by-id-invalid-length.json",
+			RequestURL:   "/segments/" + id1[:8],
+			Status:       400,
+		},
 		"segment blob": {
 			Handler: func(t *testing.T, ctrl *gomock.Controller) http.Handler {
 				seg := mock_api.NewMockSegmentStore(ctrl)
@@ -258,6 +321,84 @@ func TestAPI(t *testing.T) {
 	}
 }
 
+func TestGetSegmentProtobuf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	segs := mock_api.NewMockSegmentStore(ctrl)
+	dbresult := createSegs(t, graph.NewSigner())[:1]
+	segs.EXPECT().Get(gomock.Any(), gomock.Any()).Return(dbresult, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/segments/"+SegID(dbresult[0].Seg), nil)
+	req.Header.Set("Accept", ProtobufContentType)
+	rr := httptest.NewRecorder()
+	Handler(&Server{Segments: segs}).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, ProtobufContentType, rr.Header().Get("Content-Type"))
+
+	expected, err := proto.Marshal(seg.PathSegmentToPB(dbresult[0].Seg))
+	require.NoError(t, err)
+	assert.Equal(t, expected, rr.Body.Bytes())
+}
+
+func TestDeleteSegment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	segs := mock_api.NewMockSegmentStore(ctrl)
+	id := SegID(createSegs(t, graph.NewSigner())[0].Seg)
+	segs.EXPECT().DeleteSegment(gomock.Any(), id)
+	segs.EXPECT().DeleteSegment(gomock.Any(), id[:24])
+	handler := Handler(&Server{Segments: segs})
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/segments/"+id, nil))
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+
+	// The logging ID deletes all segments with a matching prefix.
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/segments/"+id[:24], nil))
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+
+	for name, segmentID := range map[string]string{
+		"non-hex":  "xyz",
+		"too long": id + "00",
+	} {
+		t.Run(name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr,
+				httptest.NewRequest(http.MethodDelete, "/segments/"+segmentID, nil))
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestGetSegmentBlobProtobuf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	segs := mock_api.NewMockSegmentStore(ctrl)
+	dbresult := createSegs(t, graph.NewSigner())[:1]
+	segs.EXPECT().Get(gomock.Any(), gomock.Any()).Return(dbresult, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/segments/"+SegID(dbresult[0].Seg)+"/blob", nil)
+	req.Header.Set("Accept", ProtobufContentType)
+	rr := httptest.NewRecorder()
+	Handler(&Server{Segments: segs}).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+	assert.Equal(t, ProtobufContentType, rr.Header().Get("Content-Type"))
+
+	expected, err := proto.Marshal(seg.PathSegmentToPB(dbresult[0].Seg))
+	require.NoError(t, err)
+	assert.Equal(t, expected, rr.Body.Bytes())
+}
+
+func TestGetSegmentBlobNotAcceptable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	segs := mock_api.NewMockSegmentStore(ctrl)
+
+	req := httptest.NewRequest(http.MethodGet, "/segments/"+id1+"/blob", nil)
+	req.Header.Set("Accept", "application/json")
+	rr := httptest.NewRecorder()
+	Handler(&Server{Segments: segs}).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusNotAcceptable, rr.Code)
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+}
+
 func createSegs(t *testing.T, signer seg.Signer) query.Results {
 	asEntry1 := seg.ASEntry{
 		Local: addr.MustParseIA("1-ff00:0:110"),
@@ -313,3 +454,24 @@ func createSegs(t *testing.T, signer seg.Signer) query.Results {
 		},
 	}
 }
+
+// corruptASEntry replaces the signed body of the AS entry with one that
+// contains an unknown field and a truncated EPIC digest.
+func corruptASEntry(t *testing.T, entry *seg.ASEntry) {
+	body, err := signed.ExtractUnverifiedBody(entry.Signed)
+	require.NoError(t, err)
+	var pb cppb.ASEntrySignedBody
+	require.NoError(t, proto.Unmarshal(body, &pb))
+	pb.Extensions = &cppb.PathSegmentExtensions{
+		Digests: &cppb.DigestExtension{
+			Epic: &cppb.DigestExtension_Digest{Digest: []byte{0x01, 0x02}},
+		},
+	}
+	raw, err := proto.Marshal(&pb)
+	require.NoError(t, err)
+	raw = protowire.AppendTag(raw, 1000, protowire.VarintType)
+	raw = protowire.AppendVarint(raw, 1)
+	hdrAndBody := &cryptopb.HeaderAndBodyInternal{Body: raw}
+	entry.Signed.HeaderAndBody, err = proto.Marshal(hdrAndBody)
+	require.NoError(t, err)
+}
@@ -203,6 +203,22 @@ func NewGetSegmentsRequest(server string, params *GetSegmentsParams) (*http.Requ
 
 		}
 
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -373,9 +389,11 @@ type ClientWithResponsesInterface interface {
 }
 
 type GetSegmentsResponse struct {
-	Body                      []byte
-	HTTPResponse              *http.Response
-	JSON200                   *[]SegmentBrief
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		union json.RawMessage
+	}
 	ApplicationproblemJSON400 *Problem
 }
 
@@ -445,6 +463,7 @@ type GetSegmentBlobResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
 	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON406 *Problem
 }
 
 // Status returns HTTPResponse.Status
@@ -514,7 +533,9 @@ func ParseGetSegmentsResponse(rsp *http.Response) (*GetSegmentsResponse, error)
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []SegmentBrief
+		var dest struct {
+			union json.RawMessage
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -527,6 +548,9 @@ func ParseGetSegmentsResponse(rsp *http.Response) (*GetSegmentsResponse, error)
 		}
 		response.ApplicationproblemJSON400 = &dest
 
+	case rsp.StatusCode == 200:
+		// Content-type (application/cbor) unsupported
+
 	}
 
 	return response, nil
@@ -593,6 +617,9 @@ func ParseGetSegmentResponse(rsp *http.Response) (*GetSegmentResponse, error) {
 		}
 		response.ApplicationproblemJSON400 = &dest
 
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-protobuf) unsupported
+
 	}
 
 	return response, nil
@@ -619,6 +646,13 @@ func ParseGetSegmentBlobResponse(rsp *http.Response) (*GetSegmentBlobResponse, e
 		}
 		response.ApplicationproblemJSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 406:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON406 = &dest
+
 	}
 
 	return response, nil
@@ -7,12 +7,46 @@ import (
 	"time"
 )
 
+// Defines values for HopType.
+const (
+	Core    HopType = "core"
+	Egress  HopType = "egress"
+	Ingress HopType = "ingress"
+	Peer    HopType = "peer"
+)
+
+// Defines values for GetSegmentsParamsGroupBy.
+const (
+	Type GetSegmentsParamsGroupBy = "type"
+)
+
 // Hop defines model for Hop.
 type Hop struct {
+	// Bandwidth Bandwidth in Kbit/s announced in the static info extension for the interface of the hop, with the same meaning as `latency`. Absent if it is not announced.
+	Bandwidth *int  `json:"bandwidth,omitempty"`
 	Interface int   `json:"interface"`
 	IsdAs     IsdAs `json:"isd_as"`
+
+	// Latency Latency in milliseconds announced in the static info extension for the interface of the hop. For an ingress interface, it is the latency through the AS to the egress interface. For an egress interface, it is the latency of the link. Absent if it is not announced.
+	Latency *float64 `json:"latency,omitempty"`
+
+	// Mtu MTU of the link at the interface of the hop, as announced in the AS entries of the segment. Absent if it is not announced.
+	Mtu *int `json:"mtu,omitempty"`
+
+	// Name Name of the local interface that the interface of the hop is linked to, as derived from the topology of the local AS. Only present if requested and if the topology resolves the interface.
+	Name *string `json:"name,omitempty"`
+
+	// PeerInterface Interface ID of the peering link in the peering AS `peer_isd_as`. Only present for `peer` hops.
+	PeerInterface *int   `json:"peer_interface,omitempty"`
+	PeerIsdAs     *IsdAs `json:"peer_isd_as,omitempty"`
+
+	// Type Role of the hop in the segment. `core` is the egress interface of the core AS that originated the segment, `ingress` and `egress` are the interfaces of the hop entries of the other AS entries, and `peer` is the local interface of a peering link that is announced in a peer entry. Only present if requested.
+	Type *HopType `json:"type,omitempty"`
 }
 
+// HopType Role of the hop in the segment. `core` is the egress interface of the core AS that originated the segment, `ingress` and `egress` are the interfaces of the hop entries of the other AS entries, and `peer` is the local interface of a peering link that is announced in a peer entry. Only present if requested.
+type HopType string
+
 // IsdAs defines model for IsdAs.
 type IsdAs = string
 
@@ -40,7 +74,10 @@ type Segment struct {
 	Hops        []Hop     `json:"hops"`
 	Id          SegmentID `json:"id"`
 	LastUpdated time.Time `json:"last_updated"`
-	Timestamp   time.Time `json:"timestamp"`
+
+	// ParseWarnings Problems encountered while decoding the AS entries of the segment. Absent if all AS entries were decoded completely.
+	ParseWarnings *[]string `json:"parse_warnings,omitempty"`
+	Timestamp     time.Time `json:"timestamp"`
 }
 
 // SegmentBrief defines model for SegmentBrief.
@@ -56,6 +93,13 @@ type SegmentBrief struct {
 // SegmentID defines model for SegmentID.
 type SegmentID = string
 
+// SegmentsByType defines model for SegmentsByType.
+type SegmentsByType struct {
+	Core []SegmentBrief `json:"core"`
+	Down []SegmentBrief `json:"down"`
+	Up   []SegmentBrief `json:"up"`
+}
+
 // GetSegmentsParams defines parameters for GetSegments.
 type GetSegmentsParams struct {
 	// StartIsdAs Start ISD-AS of segment.
@@ -63,4 +107,10 @@ type GetSegmentsParams struct {
 
 	// EndIsdAs Terminal AS of segment.
 	EndIsdAs *IsdAs `form:"end_isd_as,omitempty" json:"end_isd_as,omitempty"`
+
+	// GroupBy Group the segments by their type. If set to `type`, the response is an object with the up, core and down segments instead of a flat list.
+	GroupBy *GetSegmentsParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
 }
+
+// GetSegmentsParamsGroupBy defines parameters for GetSegments.
+type GetSegmentsParamsGroupBy string
@@ -39,6 +39,10 @@ type QueryParams struct {
 	// it is treated as prefix in the matching process.
 	// Beacons are returned irrespective of their segment ID if SegIDs is empty.
 	SegIDs [][]byte
+	// SegIDPrefix is the hex encoded prefix of the segment ID that beacons need
+	// to match. Unlike SegIDs, it can end in half a byte.
+	// Beacons are returned irrespective of their segment ID if SegIDPrefix is empty.
+	SegIDPrefix string
 	// StartsAt defines the list of ISD-AS IDs that beacons need to match at least one of.
 	// Zero entries in any IA (ISD or AS or both) function as wildcards.
 	// Beacons are returned irrespective of their start AS if StartsAt is empty.
@@ -56,6 +60,25 @@ type QueryParams struct {
 	ValidAt time.Time
 }
 
+// Stats summarizes the beacons that match a query.
+type Stats struct {
+	// Total is the number of matching beacons.
+	Total int
+	// Usages maps the usage of the beacons, i.e., the combination of all
+	// usages a beacon is allowed in, to the number of beacons with it.
+	Usages map[beacon.Usage]int
+	// IngressInterfaces maps the ingress interfaces to the number of beacons
+	// received on them.
+	IngressInterfaces map[uint16]int
+	// Origins maps the ISD-AS of the first AS entry to the number of beacons
+	// originated by it.
+	Origins map[addr.IA]int
+	// Oldest and Newest are the earliest and the latest creation time of the
+	// beacons, in second precision. They are zero if no beacon matches.
+	Oldest time.Time
+	Newest time.Time
+}
+
 type Beacon struct {
 	Beacon      beacon.Beacon
 	Usage       beacon.Usage
@@ -67,4 +90,13 @@ type BeaconAPI interface {
 	GetBeacons(context.Context, *QueryParams) ([]Beacon, error)
 	// DeleteBeacon removes all beacons that have the prefix of the specified segment ID.
 	DeleteBeacon(ctx context.Context, partialID string) error
+	// DeleteBeacons removes all beacons matching the parameters specified.
+	// The return value indicates the number of beacons that were removed.
+	DeleteBeacons(ctx context.Context, params *QueryParams) (int, error)
+	// Stats summarizes all beacons matching the parameters specified without
+	// loading the beacons themselves.
+	Stats(ctx context.Context, params *QueryParams) (Stats, error)
+	// CountBeacons returns the number of beacons in the database, including
+	// expired beacons that were not cleaned up yet.
+	CountBeacons(ctx context.Context) (int, error)
 }
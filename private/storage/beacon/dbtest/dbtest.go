@@ -16,6 +16,7 @@ package dbtest
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"time"
@@ -57,6 +58,9 @@ func Run(t *testing.T, db TestableDB) {
 
 func run(t *testing.T, db TestableDB) {
 	t.Run("GetBeacons", func(t *testing.T) { testGetBeacons(t, db) })
+	t.Run("CountBeacons", func(t *testing.T) { testCountBeacons(t, db) })
+	t.Run("DeleteBeacons", func(t *testing.T) { testDeleteBeacons(t, db) })
+	t.Run("Stats", func(t *testing.T) { testStats(t, db) })
 	t.Run("DeleteExpired should delete expired segments", func(t *testing.T) {
 		if _, ok := db.(interface{ IgnoreCleanable() }); ok {
 			t.Skip("Ignoring beacon cleaning test")
@@ -87,6 +91,83 @@ func run(t *testing.T, db TestableDB) {
 	})
 }
 
+func testCountBeacons(t *testing.T, db TestableDB) {
+	ctx, cancelF := context.WithTimeout(context.Background(), timeout)
+	defer cancelF()
+	db.Prepare(t, ctx)
+
+	count, err := db.CountBeacons(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	dbtest.InsertBeacon(t, db, dbtest.Info3, 12, 10, beaconlib.UsageProp)
+	dbtest.InsertBeacon(t, db, dbtest.Info2, 13, 20, beaconlib.UsageProp)
+	count, err = db.CountBeacons(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func testDeleteBeacons(t *testing.T, db TestableDB) {
+	ctx, cancelF := context.WithTimeout(context.Background(), timeout)
+	defer cancelF()
+	db.Prepare(t, ctx)
+
+	dbtest.InsertBeacon(t, db, dbtest.Info3, 12, 10, beaconlib.UsageProp)
+	dbtest.InsertBeacon(t, db, dbtest.Info2, 13, 20, beaconlib.UsageProp)
+	deleted, err := db.DeleteBeacons(ctx, &beacon.QueryParams{IngressInterfaces: []uint16{14}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted, "Deleted")
+	deleted, err = db.DeleteBeacons(ctx, &beacon.QueryParams{IngressInterfaces: []uint16{12}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted, "Deleted")
+	count, err := db.CountBeacons(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	deleted, err = db.DeleteBeacons(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted, "Deleted")
+	count, err = db.CountBeacons(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func testStats(t *testing.T, db TestableDB) {
+	ctx, cancelF := context.WithTimeout(context.Background(), timeout)
+	defer cancelF()
+	db.Prepare(t, ctx)
+
+	stats, err := db.Stats(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, stats.Total)
+	assert.True(t, stats.Oldest.IsZero())
+
+	b1 := dbtest.InsertBeacon(t, db, dbtest.Info3, 12, 10, beaconlib.UsageProp)
+	b2 := dbtest.InsertBeacon(t, db, dbtest.Info2, 13, 20, beaconlib.UsageProp)
+	b3 := dbtest.InsertBeacon(t, db, dbtest.Info1, 12, 30, beaconlib.UsageUpReg)
+	stats, err = db.Stats(ctx, nil)
+	require.NoError(t, err)
+	expected := beacon.Stats{
+		Total: 3,
+		Usages: map[beaconlib.Usage]int{
+			beaconlib.UsageProp:  2,
+			beaconlib.UsageUpReg: 1,
+		},
+		IngressInterfaces: map[uint16]int{12: 2, 13: 1},
+		Origins:           map[addr.IA]int{},
+		Oldest:            time.Unix(10, 0),
+		Newest:            time.Unix(30, 0),
+	}
+	for _, b := range []beaconlib.Beacon{b1, b2, b3} {
+		expected.Origins[b.Segment.FirstIA()]++
+	}
+	assert.Equal(t, expected, stats)
+
+	stats, err = db.Stats(ctx, &beacon.QueryParams{IngressInterfaces: []uint16{13}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Total)
+	assert.Equal(t, time.Unix(20, 0), stats.Oldest)
+	assert.Equal(t, time.Unix(20, 0), stats.Newest)
+}
+
 func testGetBeacons(t *testing.T, db TestableDB) {
 	// Beacons in results are sorted from newest (3) to oldest (1).
 	usages := []beaconlib.Usage{
@@ -153,6 +234,24 @@ func testGetBeacons(t *testing.T, db TestableDB) {
 			},
 			Expected: results[:2],
 		},
+		"Filter by SegID prefix with half a byte": {
+			PrepareDB: func(t *testing.T, ctx context.Context, db beaconlib.DB) {
+				insertBeacons(t, db)
+			},
+			Params: beacon.QueryParams{
+				SegIDPrefix: hex.EncodeToString(results[0].Beacon.Segment.ID())[:7],
+			},
+			Expected: results[:1],
+		},
+		"Empty result for non-existing SegID prefix": {
+			PrepareDB: func(t *testing.T, ctx context.Context, db beaconlib.DB) {
+				insertBeacons(t, db)
+			},
+			Params: beacon.QueryParams{
+				SegIDPrefix: "0123456789abcdef0",
+			},
+			Expected: []beacon.Beacon{},
+		},
 		"Empty result for non-existing start IA": {
 			PrepareDB: func(t *testing.T, ctx context.Context, db beaconlib.DB) {
 				insertBeacons(t, db)
@@ -136,6 +136,44 @@ func (d *db) DeleteBeacon(ctx context.Context, partialID string) error {
 	return err
 }
 
+func (d *db) DeleteBeacons(
+	ctx context.Context,
+	q *storagebeacon.QueryParams,
+) (int, error) {
+
+	var ret int
+	var err error
+	d.metrics.Observe(ctx, "delete_beacons", func(ctx context.Context) (string, error) {
+		ret, err = d.db.DeleteBeacons(ctx, q)
+		return dblib.ErrToMetricLabel(err), err
+	})
+	return ret, err
+}
+
+func (d *db) Stats(
+	ctx context.Context,
+	q *storagebeacon.QueryParams,
+) (storagebeacon.Stats, error) {
+
+	var ret storagebeacon.Stats
+	var err error
+	d.metrics.Observe(ctx, "beacon_stats", func(ctx context.Context) (string, error) {
+		ret, err = d.db.Stats(ctx, q)
+		return dblib.ErrToMetricLabel(err), err
+	})
+	return ret, err
+}
+
+func (d *db) CountBeacons(ctx context.Context) (int, error) {
+	var ret int
+	var err error
+	d.metrics.Observe(ctx, "count_beacons", func(ctx context.Context) (string, error) {
+		ret, err = d.db.CountBeacons(ctx)
+		return dblib.ErrToMetricLabel(err), err
+	})
+	return ret, err
+}
+
 func (d *db) Close() error {
 	return d.db.Close()
 }
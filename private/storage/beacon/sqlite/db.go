@@ -249,11 +249,94 @@ func (e *executor) DeleteBeacon(ctx context.Context, partialID string) error {
 	return err
 }
 
+func (e *executor) CountBeacons(ctx context.Context) (int, error) {
+	e.RLock()
+	defer e.RUnlock()
+	var count int
+	if err := e.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM Beacons`).Scan(&count); err != nil {
+		return 0, db.NewReadError("counting beacons", err)
+	}
+	return count, nil
+}
+
+func (e *executor) DeleteBeacons(
+	ctx context.Context,
+	params *storagebeacon.QueryParams,
+) (int, error) {
+
+	where, args := buildWhere(params)
+	return e.deleteInTx(ctx, func(tx *sql.Tx) (sql.Result, error) {
+		return tx.ExecContext(ctx, "DELETE FROM Beacons"+where, args...)
+	})
+}
+
+func (e *executor) Stats(
+	ctx context.Context,
+	params *storagebeacon.QueryParams,
+) (storagebeacon.Stats, error) {
+
+	e.RLock()
+	defer e.RUnlock()
+	where, args := buildWhere(params)
+	query := `SELECT StartIsd, StartAs, InIntfID, Usage, COUNT(*), MIN(InfoTime), MAX(InfoTime)
+		FROM Beacons` + where + `
+		GROUP BY StartIsd, StartAs, InIntfID, Usage`
+	rows, err := e.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return storagebeacon.Stats{}, db.NewReadError("summarizing beacons", err)
+	}
+	defer rows.Close()
+	stats := storagebeacon.Stats{
+		Usages:            make(map[beacon.Usage]int),
+		IngressInterfaces: make(map[uint16]int),
+		Origins:           make(map[addr.IA]int),
+	}
+	var oldest, newest int64
+	for rows.Next() {
+		var isd, as, minInfoTime, maxInfoTime int64
+		var inIfID uint16
+		var usage, count int
+		err := rows.Scan(&isd, &as, &inIfID, &usage, &count, &minInfoTime, &maxInfoTime)
+		if err != nil {
+			return storagebeacon.Stats{}, db.NewReadError("summarizing beacons", err)
+		}
+		ia, err := addr.IAFrom(addr.ISD(isd), addr.AS(as))
+		if err != nil {
+			return storagebeacon.Stats{}, serrors.Wrap("parsing start ISD-AS", err)
+		}
+		if stats.Total == 0 || minInfoTime < oldest {
+			oldest = minInfoTime
+		}
+		if stats.Total == 0 || maxInfoTime > newest {
+			newest = maxInfoTime
+		}
+		stats.Total += count
+		stats.Usages[beacon.Usage(usage)] += count
+		stats.IngressInterfaces[inIfID] += count
+		stats.Origins[ia] += count
+	}
+	if err := rows.Err(); err != nil {
+		return storagebeacon.Stats{}, db.NewReadError("summarizing beacons", err)
+	}
+	if stats.Total > 0 {
+		stats.Oldest, stats.Newest = time.Unix(oldest, 0), time.Unix(newest, 0)
+	}
+	return stats, nil
+}
+
 func (e *executor) buildQuery(params *storagebeacon.QueryParams) (string, []any) {
+	where, args := buildWhere(params)
+	query := "SELECT DISTINCT RowID, LastUpdated, Usage, Beacon, InIntfID FROM Beacons" + where
+	query += "\n" + "ORDER BY LastUpdated DESC"
+	return query, args
+}
+
+// buildWhere builds the WHERE clause that matches the beacons selected by the
+// parameters. The clause is empty if the parameters select all beacons.
+func buildWhere(params *storagebeacon.QueryParams) (string, []any) {
 	var args []any
-	query := "SELECT DISTINCT RowID, LastUpdated, Usage, Beacon, InIntfID FROM Beacons"
 	if params == nil {
-		return query, args
+		return "", args
 	}
 	where := []string{}
 	if len(params.SegIDs) > 0 {
@@ -264,6 +347,10 @@ func (e *executor) buildQuery(params *storagebeacon.QueryParams) (string, []any)
 		}
 		where = append(where, fmt.Sprintf("(%s)", strings.Join(subQ, " OR ")))
 	}
+	if params.SegIDPrefix != "" {
+		where = append(where, "hex(SegID) LIKE ?")
+		args = append(args, params.SegIDPrefix+"%")
+	}
 	if len(params.StartsAt) > 0 {
 		subQ := []string{}
 		for _, as := range params.StartsAt {
@@ -312,12 +399,10 @@ func (e *executor) buildQuery(params *storagebeacon.QueryParams) (string, []any)
 		args = append(args, params.ValidAt.Unix())
 		args = append(args, params.ValidAt.Unix())
 	}
-	// Assemble the query.
-	if len(where) > 0 {
-		query += "\n" + fmt.Sprintf("WHERE %s", strings.Join(where, " AND\n"))
+	if len(where) == 0 {
+		return "", args
 	}
-	query += "\n" + "ORDER BY LastUpdated DESC"
-	return query, args
+	return "\n" + fmt.Sprintf("WHERE %s", strings.Join(where, " AND\n")), args
 }
 
 // getBeaconMeta gets the metadata for existing beacons.
@@ -15,12 +15,15 @@
 package topology
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"reflect"
+	"slices"
 	"sync"
 
 	"github.com/scionproto/scion/pkg/addr"
@@ -83,7 +86,7 @@ func NewLoader(cfg LoaderCfg) (*Loader, error) {
 		cfg:         cfg,
 		subscribers: make(map[*Subscription]chan struct{}),
 	}
-	if err := l.reload(); err != nil {
+	if _, err := l.reload(); err != nil {
 		return nil, err
 	}
 	return l, nil
@@ -96,7 +99,7 @@ func (l *Loader) Run(ctx context.Context) error {
 	for {
 		select {
 		case <-l.cfg.Reload:
-			if err := l.reload(); err != nil {
+			if _, err := l.reload(); err != nil {
 				log.FromCtx(ctx).Error("Failed to reload topology file",
 					"file", l.cfg.File, "err", err)
 			} else {
@@ -239,6 +242,13 @@ func (l *Loader) HandleHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Reload reloads the topology file immediately and describes the changes that
+// were applied. A topology that can't be parsed or doesn't validate is
+// rejected with an error, in which case the current topology stays active.
+func (l *Loader) Reload() ([]string, error) {
+	return l.reload()
+}
+
 // Subscription is a subscription for topology updates. It should be Closed
 // whenever it's no longer used. When the context of the Loader is cancelled the
 // Subscription will no longer be served, but the subscription channel is not
@@ -282,11 +292,11 @@ func (l *Loader) notifyAllLocked() {
 	}
 }
 
-func (l *Loader) reload() error {
+func (l *Loader) reload() ([]string, error) {
 	newTopo, err := l.load()
 	if err != nil {
 		metrics.CounterInc(l.cfg.Metrics.ReadErrors)
-		return serrors.Wrap("loading topology", err)
+		return nil, serrors.Wrap("loading topology", err)
 	}
 
 	l.mtx.Lock()
@@ -299,14 +309,17 @@ func (l *Loader) reload() error {
 
 	if err := l.validate(newTopo.Writable(), old); err != nil {
 		metrics.CounterInc(l.cfg.Metrics.ValidationErrors)
-		return serrors.Wrap("validating update", err)
+		return nil, serrors.Wrap("validating update", err)
 	}
 	l.topo = newTopo
 	metrics.CounterInc(l.cfg.Metrics.Updates)
 	metrics.GaugeSetCurrentTime(l.cfg.Metrics.LastUpdate)
 
 	l.notifyAllLocked()
-	return nil
+	if old == nil {
+		return nil, nil
+	}
+	return topologyChanges(old, newTopo.Writable()), nil
 }
 
 func (l *Loader) load() (Topology, error) {
@@ -323,3 +336,64 @@ func (l *Loader) validate(new, old *RWTopology) error {
 	}
 	return l.cfg.Validator.Validate(new, old)
 }
+
+// topologyChanges describes the differences between the old and the new
+// topology. Only the parts that may change during a reload are compared.
+func topologyChanges(old, new *RWTopology) []string {
+	var changes []string
+	changes = append(changes, mapChanges("interface", old.IFInfoMap, new.IFInfoMap,
+		func(a, b IFInfo) bool { return reflect.DeepEqual(a, b) })...)
+	changes = append(changes, mapChanges("border router", old.BR, new.BR,
+		func(a, b BRInfo) bool { return a.InternalAddr == b.InternalAddr })...)
+	for _, svc := range []struct {
+		name     string
+		old, new IDAddrMap
+	}{
+		{"control service", old.CS, new.CS},
+		{"discovery service", old.DS, new.DS},
+		{"hidden segment lookup service", old.HiddenSegmentLookup, new.HiddenSegmentLookup},
+		{
+			"hidden segment registration service",
+			old.HiddenSegmentRegistration, new.HiddenSegmentRegistration,
+		},
+	} {
+		changes = append(changes, mapChanges(svc.name, svc.old, svc.new,
+			func(a, b TopoAddr) bool { return reflect.DeepEqual(a, b) })...)
+	}
+	changes = append(changes, mapChanges("gateway", old.SIG, new.SIG,
+		func(a, b GatewayInfo) bool { return reflect.DeepEqual(a, b) })...)
+	return changes
+}
+
+// mapChanges describes the entries that were added, removed or modified
+// between the old and the new map, in the order of their keys.
+func mapChanges[K cmp.Ordered, V any](
+	kind string,
+	old, new map[K]V,
+	equal func(a, b V) bool,
+) []string {
+	keys := make([]K, 0, len(old)+len(new))
+	for k := range old {
+		keys = append(keys, k)
+	}
+	for k := range new {
+		if _, ok := old[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	var changes []string
+	for _, k := range keys {
+		o, inOld := old[k]
+		n, inNew := new[k]
+		switch {
+		case !inOld:
+			changes = append(changes, fmt.Sprintf("%s %v added", kind, k))
+		case !inNew:
+			changes = append(changes, fmt.Sprintf("%s %v removed", kind, k))
+		case !equal(o, n):
+			changes = append(changes, fmt.Sprintf("%s %v modified", kind, k))
+		}
+	}
+	return changes
+}
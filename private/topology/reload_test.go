@@ -198,6 +198,48 @@ func TestLoader(t *testing.T) {
 		testBasicTopo(t, l)
 		assert.Equal(t, xtest.MustParseUDPAddr(t, "10.0.0.1:42"), l.UnderlayNextHop(11))
 	})
+	t.Run("explicit reload reports changes", func(t *testing.T) {
+		l, err := topology.NewLoader(topology.LoaderCfg{
+			File: "testdata/basic.json",
+		})
+		require.NoError(t, err)
+
+		changes, err := l.Reload()
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+
+		file := modifiedTopo(t, func(topo *jsontopo.Topology) {
+			topo.BorderRouters["br1-ff00:0:311-2"].InternalAddr = "10.0.0.1:42"
+			delete(topo.BorderRouters["br1-ff00:0:311-1"].Interfaces, 8)
+			delete(topo.DiscoveryService, "ds1-ff00:0:311-2")
+		})
+		topology.SetFile(l, file)
+		changes, err = l.Reload()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"interface 8 removed",
+			"interface 11 modified",
+			"border router br1-ff00:0:311-2 modified",
+			"discovery service ds1-ff00:0:311-2 removed",
+		}, changes)
+		assert.ElementsMatch(t, []uint16{1, 3, 11}, l.IfIDs())
+	})
+	t.Run("explicit invalid reload is rejected", func(t *testing.T) {
+		l, err := topology.NewLoader(topology.LoaderCfg{
+			File:      "testdata/basic.json",
+			Validator: &topology.DefaultValidator{},
+		})
+		require.NoError(t, err)
+
+		file := modifiedTopo(t, func(topo *jsontopo.Topology) {
+			topo.IA = "1-ff00:0:312"
+		})
+		topology.SetFile(l, file)
+		changes, err := l.Reload()
+		assert.Error(t, err)
+		assert.Nil(t, changes)
+		testBasicTopo(t, l)
+	})
 	t.Run("test subscription", func(t *testing.T) {
 		reloadCh := make(chan struct{})
 		l, err := topology.NewLoader(topology.LoaderCfg{